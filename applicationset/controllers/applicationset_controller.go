@@ -50,6 +50,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/applicationset/utils"
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/settings"
 
 	argov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	argoutil "github.com/argoproj/argo-cd/v2/util/argo"
@@ -78,6 +79,7 @@ type ApplicationSetReconciler struct {
 	Recorder             record.EventRecorder
 	Generators           map[string]generators.Generator
 	ArgoDB               db.ArgoDB
+	SettingsMgr          *settings.SettingsManager
 	KubeClientset        kubernetes.Interface
 	Policy               argov1alpha1.ApplicationsSyncPolicy
 	EnablePolicyOverride bool
@@ -155,6 +157,20 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 	parametersGenerated = true
 
+	desiredApplications, expiryRequeueAfter, err := r.handleExpiredApplications(ctx, logCtx, &applicationSetInfo, desiredApplications)
+	if err != nil {
+		_ = r.setApplicationSetStatusCondition(ctx,
+			&applicationSetInfo,
+			argov1alpha1.ApplicationSetCondition{
+				Type:    argov1alpha1.ApplicationSetConditionErrorOccurred,
+				Message: err.Error(),
+				Reason:  argov1alpha1.ApplicationSetReasonDeleteApplicationError,
+				Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+			}, parametersGenerated,
+		)
+		return ctrl.Result{}, err
+	}
+
 	validateErrors, err := r.validateGeneratedApplications(ctx, desiredApplications, applicationSetInfo)
 	if err != nil {
 		// While some generators may return an error that requires user intervention,
@@ -332,6 +348,9 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		// Ensure that the request is requeued if there are validation errors.
 		requeueAfter = ReconcileRequeueOnValidationError
 	}
+	if expiryRequeueAfter > 0 && (requeueAfter == 0 || expiryRequeueAfter < requeueAfter) {
+		requeueAfter = expiryRequeueAfter
+	}
 
 	logCtx.WithField("requeueAfter", requeueAfter).Info("end reconcile in ", time.Since(startReconcile))
 
@@ -0,0 +1,149 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-cd/v2/applicationset/utils"
+	"github.com/argoproj/argo-cd/v2/common"
+	argov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	argoutil "github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+// ReconcileRequeueOnDeferredExpiry is the delay after which the deletion of expired applications is retried while Argo CD
+// or their project is in maintenance mode
+const ReconcileRequeueOnDeferredExpiry = time.Minute * 3
+
+// getExpiredApplicationNames returns the names of the applications recorded as expired in the ApplicationSet annotation
+func getExpiredApplicationNames(applicationSet *argov1alpha1.ApplicationSet) map[string]bool {
+	names := map[string]bool{}
+	for _, name := range strings.Split(applicationSet.GetAnnotations()[common.AnnotationApplicationSetExpiredApplications], ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// handleExpiredApplications deletes the generated applications whose time to live has passed, and removes the expired
+// applications from the desired applications so that they are not created again. Since the time to live is counted
+// from the creation of an application, the expired applications are recorded in an annotation of the ApplicationSet for
+// as long as the generators produce them. Expired applications are only deleted if the sync policy of the ApplicationSet
+// allows deleting applications, and their resources are kept if the policy preserves resources on deletion. Returns the remaining desired applications and the duration after which the
+// ApplicationSet must be reconciled again to delete the next expiring application, or zero if there is none.
+func (r *ApplicationSetReconciler) handleExpiredApplications(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application) ([]argov1alpha1.Application, time.Duration, error) {
+	if !utils.DefaultPolicy(applicationSet.Spec.SyncPolicy, r.Policy, r.EnablePolicyOverride).AllowDelete() {
+		logCtx.Debug("ApplicationSet policy does not allow to delete, expired applications are kept")
+		return desiredApplications, 0, nil
+	}
+	preserveResources := applicationSet.Spec.SyncPolicy != nil && applicationSet.Spec.SyncPolicy.PreserveResourcesOnDeletion
+
+	current, err := r.getCurrentApplications(ctx, *applicationSet)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error getting current applications: %w", err)
+	}
+
+	expired := getExpiredApplicationNames(applicationSet)
+	var requeueAfter time.Duration
+	minRequeueAfter := func(d time.Duration) {
+		if requeueAfter == 0 || d < requeueAfter {
+			requeueAfter = d
+		}
+	}
+	for _, app := range current {
+		if app.DeletionTimestamp != nil {
+			continue
+		}
+		expiresAt, err := argoutil.GetAppExpiration(&app)
+		if err != nil || expiresAt == nil {
+			continue
+		}
+		if remaining := time.Until(*expiresAt); remaining > 0 {
+			minRequeueAfter(remaining)
+			continue
+		}
+		appLog := logCtx.WithField("app", app.QualifiedName())
+		deferred, err := r.isMaintenanceModeEnabled(ctx, &app)
+		if err != nil {
+			return nil, 0, err
+		}
+		if deferred {
+			appLog.Info("Deferring deletion of expired application because of maintenance mode")
+			minRequeueAfter(ReconcileRequeueOnDeferredExpiry)
+			continue
+		}
+		expired[app.Name] = true
+		if !preserveResources && !app.CascadedDeletion() {
+			app.SetCascadedDeletion(argov1alpha1.ResourcesFinalizerName)
+			if err := r.Client.Update(ctx, &app); err != nil {
+				return nil, 0, fmt.Errorf("error setting finalizer of expired application %s: %w", app.Name, err)
+			}
+		}
+		if err := r.Client.Delete(ctx, &app); err != nil {
+			return nil, 0, fmt.Errorf("error deleting expired application %s: %w", app.Name, err)
+		}
+		r.Recorder.Eventf(applicationSet, corev1.EventTypeNormal, "Deleted", "Deleted expired Application %q", app.Name)
+		appLog.Infof("Deleted application which expired at %s", expiresAt.UTC().Format(time.RFC3339))
+	}
+
+	var remaining []argov1alpha1.Application
+	var stillExpired []string
+	for _, app := range desiredApplications {
+		if expired[app.Name] {
+			stillExpired = append(stillExpired, app.Name)
+			continue
+		}
+		remaining = append(remaining, app)
+	}
+	sort.Strings(stillExpired)
+	if err := r.setExpiredApplicationNames(ctx, applicationSet, stillExpired); err != nil {
+		return nil, 0, err
+	}
+	return remaining, requeueAfter, nil
+}
+
+// setExpiredApplicationNames records the names of the expired applications in the ApplicationSet annotation
+func (r *ApplicationSetReconciler) setExpiredApplicationNames(ctx context.Context, applicationSet *argov1alpha1.ApplicationSet, names []string) error {
+	value := strings.Join(names, ",")
+	if applicationSet.GetAnnotations()[common.AnnotationApplicationSetExpiredApplications] == value {
+		return nil
+	}
+	if value == "" {
+		delete(applicationSet.Annotations, common.AnnotationApplicationSetExpiredApplications)
+	} else {
+		if applicationSet.Annotations == nil {
+			applicationSet.Annotations = map[string]string{}
+		}
+		applicationSet.Annotations[common.AnnotationApplicationSetExpiredApplications] = value
+	}
+	if err := r.Client.Update(ctx, applicationSet); err != nil {
+		return fmt.Errorf("error recording expired applications: %w", err)
+	}
+	return nil
+}
+
+// isMaintenanceModeEnabled returns whether Argo CD or the project of the application is in maintenance mode, during
+// which expired applications are not deleted
+func (r *ApplicationSetReconciler) isMaintenanceModeEnabled(ctx context.Context, app *argov1alpha1.Application) (bool, error) {
+	if r.SettingsMgr != nil {
+		enabled, _, err := r.SettingsMgr.GetMaintenanceMode()
+		if err != nil {
+			return false, fmt.Errorf("error getting maintenance mode settings: %w", err)
+		}
+		if enabled {
+			return true, nil
+		}
+	}
+	appProject := &argov1alpha1.AppProject{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: app.Spec.Project, Namespace: r.ArgoCDNamespace}, appProject); err != nil {
+		return false, fmt.Errorf("error getting project %s: %w", app.Spec.Project, err)
+	}
+	return argoutil.IsProjectInMaintenanceMode(appProject), nil
+}
@@ -0,0 +1,155 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	crtclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestHandleExpiredApplications(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	newApp := func(name string, age time.Duration, ttl string) v1alpha1.Application {
+		app := v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "argocd",
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+			},
+			Spec: v1alpha1.ApplicationSpec{Project: "default"},
+		}
+		if ttl != "" {
+			app.Annotations = map[string]string{common.AnnotationKeyTTL: ttl}
+		}
+		return app
+	}
+	appNames := func(apps []v1alpha1.Application) []string {
+		var names []string
+		for _, app := range apps {
+			names = append(names, app.Name)
+		}
+		return names
+	}
+	newReconciler := func(t *testing.T, appSet *v1alpha1.ApplicationSet, project *v1alpha1.AppProject, apps ...v1alpha1.Application) (*ApplicationSetReconciler, crtclient.Client) {
+		t.Helper()
+		initObjs := []crtclient.Object{appSet, project}
+		for i := range apps {
+			require.NoError(t, controllerutil.SetControllerReference(appSet, &apps[i], scheme))
+			initObjs = append(initObjs, &apps[i])
+		}
+		client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjs...).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
+		return &ApplicationSetReconciler{
+			Client:          client,
+			Scheme:          scheme,
+			Recorder:        record.NewFakeRecorder(10),
+			ArgoCDNamespace: "argocd",
+			Policy:          v1alpha1.ApplicationsSyncPolicySync,
+		}, client
+	}
+	newAppSet := func(expired string) *v1alpha1.ApplicationSet {
+		appSet := &v1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "previews", Namespace: "argocd"}}
+		if expired != "" {
+			appSet.Annotations = map[string]string{common.AnnotationApplicationSetExpiredApplications: expired}
+		}
+		return appSet
+	}
+	project := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"}}
+
+	t.Run("expired applications are deleted and not created again", func(t *testing.T) {
+		appSet := newAppSet("pr-1,pr-closed")
+		desired := []v1alpha1.Application{newApp("pr-1", 0, "1h"), newApp("pr-2", 0, "1h"), newApp("pr-3", 0, "1h"), newApp("main", 0, "")}
+		r, client := newReconciler(t, appSet, project, newApp("pr-2", 2*time.Hour, "1h"), newApp("pr-3", 0, "2h"), newApp("main", 2*time.Hour, ""))
+
+		remaining, requeueAfter, err := r.handleExpiredApplications(context.Background(), log.NewEntry(log.StandardLogger()), appSet, desired)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"pr-3", "main"}, appNames(remaining))
+		assert.Greater(t, requeueAfter, time.Hour)
+		assert.LessOrEqual(t, requeueAfter, 2*time.Hour)
+
+		updated := &v1alpha1.ApplicationSet{}
+		require.NoError(t, client.Get(context.Background(), crtclient.ObjectKeyFromObject(appSet), updated))
+		assert.Equal(t, "pr-1,pr-2", updated.Annotations[common.AnnotationApplicationSetExpiredApplications])
+
+		deleted := &v1alpha1.Application{}
+		err = client.Get(context.Background(), crtclient.ObjectKey{Namespace: "argocd", Name: "pr-2"}, deleted)
+		require.NoError(t, err)
+		assert.NotNil(t, deleted.DeletionTimestamp)
+		assert.True(t, deleted.CascadedDeletion())
+	})
+
+	t.Run("record is cleared once applications are no longer generated", func(t *testing.T) {
+		appSet := newAppSet("pr-1")
+		r, client := newReconciler(t, appSet, project)
+
+		remaining, _, err := r.handleExpiredApplications(context.Background(), log.NewEntry(log.StandardLogger()), appSet, []v1alpha1.Application{newApp("main", 0, "")})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"main"}, appNames(remaining))
+
+		updated := &v1alpha1.ApplicationSet{}
+		require.NoError(t, client.Get(context.Background(), crtclient.ObjectKeyFromObject(appSet), updated))
+		assert.NotContains(t, updated.Annotations, common.AnnotationApplicationSetExpiredApplications)
+	})
+
+	t.Run("deletion is deferred during maintenance mode", func(t *testing.T) {
+		appSet := newAppSet("")
+		maintenanceProject := project.DeepCopy()
+		maintenanceProject.Annotations = map[string]string{common.AnnotationKeyMaintenanceMode: "true"}
+		r, client := newReconciler(t, appSet, maintenanceProject, newApp("pr-2", 2*time.Hour, "1h"))
+
+		remaining, requeueAfter, err := r.handleExpiredApplications(context.Background(), log.NewEntry(log.StandardLogger()), appSet, []v1alpha1.Application{newApp("pr-2", 0, "1h")})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"pr-2"}, appNames(remaining))
+		assert.Equal(t, ReconcileRequeueOnDeferredExpiry, requeueAfter)
+
+		app := &v1alpha1.Application{}
+		require.NoError(t, client.Get(context.Background(), crtclient.ObjectKey{Namespace: "argocd", Name: "pr-2"}, app))
+		assert.Nil(t, app.DeletionTimestamp)
+	})
+	t.Run("applications are kept if the policy does not allow to delete", func(t *testing.T) {
+		appSet := newAppSet("")
+		policy := v1alpha1.ApplicationsSyncPolicyCreateOnly
+		appSet.Spec.SyncPolicy = &v1alpha1.ApplicationSetSyncPolicy{ApplicationsSync: &policy}
+		r, client := newReconciler(t, appSet, project, newApp("pr-2", 2*time.Hour, "1h"))
+		r.EnablePolicyOverride = true
+
+		remaining, requeueAfter, err := r.handleExpiredApplications(context.Background(), log.NewEntry(log.StandardLogger()), appSet, []v1alpha1.Application{newApp("pr-2", 0, "1h")})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"pr-2"}, appNames(remaining))
+		assert.Zero(t, requeueAfter)
+
+		app := &v1alpha1.Application{}
+		require.NoError(t, client.Get(context.Background(), crtclient.ObjectKey{Namespace: "argocd", Name: "pr-2"}, app))
+		assert.Nil(t, app.DeletionTimestamp)
+	})
+
+	t.Run("resources are preserved if the policy preserves them on deletion", func(t *testing.T) {
+		appSet := newAppSet("")
+		appSet.Spec.SyncPolicy = &v1alpha1.ApplicationSetSyncPolicy{PreserveResourcesOnDeletion: true}
+		expiredApp := newApp("pr-2", 2*time.Hour, "1h")
+		expiredApp.Finalizers = []string{"example.com/keep"}
+		r, client := newReconciler(t, appSet, project, expiredApp)
+
+		remaining, _, err := r.handleExpiredApplications(context.Background(), log.NewEntry(log.StandardLogger()), appSet, []v1alpha1.Application{newApp("pr-2", 0, "1h")})
+		require.NoError(t, err)
+		assert.Empty(t, remaining)
+
+		app := &v1alpha1.Application{}
+		require.NoError(t, client.Get(context.Background(), crtclient.ObjectKey{Namespace: "argocd", Name: "pr-2"}, app))
+		assert.NotNil(t, app.DeletionTimestamp)
+		assert.False(t, app.CascadedDeletion())
+	})
+}
@@ -3,13 +3,10 @@ package webhook
 import (
 	"context"
 	"fmt"
-	"html"
-	"net/http"
 	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
-	"sync"
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
@@ -18,6 +15,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/applicationset/generators"
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	reposervercache "github.com/argoproj/argo-cd/v2/reposerver/cache"
 	argosettings "github.com/argoproj/argo-cd/v2/util/settings"
 	"github.com/argoproj/argo-cd/v2/util/webhook"
 
@@ -27,20 +25,16 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-const payloadQueueSize = 50000
-
 type WebhookHandler struct {
-	sync.WaitGroup // for testing
-	namespace      string
-	github         *github.Webhook
-	gitlab         *gitlab.Webhook
-	azuredevops    *azuredevops.Webhook
-	client         client.Client
-	generators     map[string]generators.Generator
-	queue          chan interface{}
+	*webhook.Dispatcher
+	namespace  string
+	client     client.Client
+	generators map[string]generators.Generator
+	repoCache  *reposervercache.Cache
 }
 
 type gitGeneratorInfo struct {
+	WebURL      string
 	Revision    string
 	TouchedHead bool
 	RepoRegexp  *regexp.Regexp
@@ -68,56 +62,24 @@ type prGeneratorGitlabInfo struct {
 	APIHostname string
 }
 
-func NewWebhookHandler(namespace string, webhookParallelism int, argocdSettingsMgr *argosettings.SettingsManager, client client.Client, generators map[string]generators.Generator) (*WebhookHandler, error) {
+func NewWebhookHandler(namespace string, webhookParallelism int, argocdSettingsMgr *argosettings.SettingsManager, client client.Client, generators map[string]generators.Generator, repoCache *reposervercache.Cache) (*WebhookHandler, error) {
 	// register the webhook secrets stored under "argocd-secret" for verifying incoming payloads
 	argocdSettings, err := argocdSettingsMgr.GetSettings()
 	if err != nil {
 		return nil, fmt.Errorf("Failed to get argocd settings: %w", err)
 	}
-	githubHandler, err := github.New(github.Options.Secret(argocdSettings.WebhookGitHubSecret))
-	if err != nil {
-		return nil, fmt.Errorf("Unable to init GitHub webhook: %w", err)
-	}
-	gitlabHandler, err := gitlab.New(gitlab.Options.Secret(argocdSettings.WebhookGitLabSecret))
-	if err != nil {
-		return nil, fmt.Errorf("Unable to init GitLab webhook: %w", err)
-	}
-	azuredevopsHandler, err := azuredevops.New(azuredevops.Options.BasicAuth(argocdSettings.WebhookAzureDevOpsUsername, argocdSettings.WebhookAzureDevOpsPassword))
-	if err != nil {
-		return nil, fmt.Errorf("Unable to init Azure DevOps webhook: %w", err)
-	}
 
 	webhookHandler := &WebhookHandler{
-		namespace:   namespace,
-		github:      githubHandler,
-		gitlab:      gitlabHandler,
-		azuredevops: azuredevopsHandler,
-		client:      client,
-		generators:  generators,
-		queue:       make(chan interface{}, payloadQueueSize),
+		namespace:  namespace,
+		client:     client,
+		generators: generators,
+		repoCache:  repoCache,
 	}
-
-	webhookHandler.startWorkerPool(webhookParallelism)
+	webhookHandler.Dispatcher = webhook.NewDispatcher(argocdSettings, webhookParallelism, argocdSettingsMgr.GetMaxWebhookPayloadSize(), webhookHandler.HandleEvent)
 
 	return webhookHandler, nil
 }
 
-func (h *WebhookHandler) startWorkerPool(webhookParallelism int) {
-	for i := 0; i < webhookParallelism; i++ {
-		h.Add(1)
-		go func() {
-			defer h.Done()
-			for {
-				payload, ok := <-h.queue
-				if !ok {
-					return
-				}
-				h.HandleEvent(payload)
-			}
-		}()
-	}
-}
-
 func (h *WebhookHandler) HandleEvent(payload interface{}) {
 	gitGenInfo := getGitGeneratorInfo(payload)
 	prGenInfo := getPRGeneratorInfo(payload)
@@ -132,6 +94,10 @@ func (h *WebhookHandler) HandleEvent(payload interface{}) {
 		return
 	}
 
+	if gitGenInfo != nil {
+		webhook.InvalidateGitReferences(h.repoCache, gitGenInfo.WebURL, gitGeneratorRepoURLs(appSetList.Items))
+	}
+
 	for _, appSet := range appSetList.Items {
 		shouldRefresh := false
 		for _, gen := range appSet.Spec.Generators {
@@ -156,41 +122,6 @@ func (h *WebhookHandler) HandleEvent(payload interface{}) {
 	}
 }
 
-func (h *WebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
-	var payload interface{}
-	var err error
-
-	switch {
-	case r.Header.Get("X-GitHub-Event") != "":
-		payload, err = h.github.Parse(r, github.PushEvent, github.PullRequestEvent, github.PingEvent)
-	case r.Header.Get("X-Gitlab-Event") != "":
-		payload, err = h.gitlab.Parse(r, gitlab.PushEvents, gitlab.TagEvents, gitlab.MergeRequestEvents)
-	case r.Header.Get("X-Vss-Activityid") != "":
-		payload, err = h.azuredevops.Parse(r, azuredevops.GitPushEventType, azuredevops.GitPullRequestCreatedEventType, azuredevops.GitPullRequestUpdatedEventType, azuredevops.GitPullRequestMergedEventType)
-	default:
-		log.Debug("Ignoring unknown webhook event")
-		http.Error(w, "Unknown webhook event", http.StatusBadRequest)
-		return
-	}
-
-	if err != nil {
-		log.Infof("Webhook processing failed: %s", err)
-		status := http.StatusBadRequest
-		if r.Method != http.MethodPost {
-			status = http.StatusMethodNotAllowed
-		}
-		http.Error(w, fmt.Sprintf("Webhook processing failed: %s", html.EscapeString(err.Error())), status)
-		return
-	}
-
-	select {
-	case h.queue <- payload:
-	default:
-		log.Info("Queue is full, discarding webhook payload")
-		http.Error(w, "Queue is full, discarding webhook payload", http.StatusServiceUnavailable)
-	}
-}
-
 func getGitGeneratorInfo(payload interface{}) *gitGeneratorInfo {
 	var (
 		webURL      string
@@ -230,6 +161,7 @@ func getGitGeneratorInfo(payload interface{}) *gitGeneratorInfo {
 	}
 
 	return &gitGeneratorInfo{
+		WebURL:      webURL,
 		RepoRegexp:  repoRegexp,
 		TouchedHead: touchedHead,
 		Revision:    revision,
@@ -364,6 +296,32 @@ func shouldRefreshGitGenerator(gen *v1alpha1.GitGenerator, info *gitGeneratorInf
 	return true
 }
 
+// gitGeneratorRepoURLs returns the repository URLs of the Git generators of the given ApplicationSets, including the
+// Git generators nested in matrix and merge generators
+func gitGeneratorRepoURLs(appSets []v1alpha1.ApplicationSet) []string {
+	var repoURLs []string
+	for _, appSet := range appSets {
+		for _, gen := range appSet.Spec.Generators {
+			if gen.Git != nil {
+				repoURLs = append(repoURLs, gen.Git.RepoURL)
+			}
+			var nested []v1alpha1.ApplicationSetNestedGenerator
+			if gen.Matrix != nil {
+				nested = append(nested, gen.Matrix.Generators...)
+			}
+			if gen.Merge != nil {
+				nested = append(nested, gen.Merge.Generators...)
+			}
+			for _, nestedGen := range nested {
+				if nestedGen.Git != nil {
+					repoURLs = append(repoURLs, nestedGen.Git.RepoURL)
+				}
+			}
+		}
+	}
+	return repoURLs
+}
+
 func shouldRefreshPluginGenerator(gen *v1alpha1.PluginGenerator) bool {
 	return gen != nil
 }
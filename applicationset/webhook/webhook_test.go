@@ -12,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -28,6 +29,8 @@ import (
 	"github.com/argoproj/argo-cd/v2/applicationset/services/scm_provider"
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	reposervercache "github.com/argoproj/argo-cd/v2/reposerver/cache"
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	argosettings "github.com/argoproj/argo-cd/v2/util/settings"
 )
 
@@ -217,7 +220,7 @@ func TestWebhookHandler(t *testing.T) {
 				fakeAppWithMergeAndNestedGitGenerator("merge-nested-git-github", namespace, "https://github.com/org/repo"),
 			).Build()
 			set := argosettings.NewSettingsManager(context.TODO(), fakeClient, namespace)
-			h, err := NewWebhookHandler(namespace, webhookParallelism, set, fc, mockGenerators())
+			h, err := NewWebhookHandler(namespace, webhookParallelism, set, fc, mockGenerators(), nil)
 			require.NoError(t, err)
 
 			req := httptest.NewRequest(http.MethodPost, "/api/webhook", nil)
@@ -228,8 +231,7 @@ func TestWebhookHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 
 			h.Handler(w, req)
-			close(h.queue)
-			h.Wait()
+			h.Stop()
 			assert.Equal(t, test.expectedStatusCode, w.Code)
 
 			list := &v1alpha1.ApplicationSetList{}
@@ -257,6 +259,49 @@ func TestWebhookHandler(t *testing.T) {
 	}
 }
 
+// TestWebhookHandler_InvalidatesGitReferences makes sure that a push deletes the cached references of every URL of the
+// pushed repository, whether or not an ApplicationSet tracks the pushed revision
+func TestWebhookHandler_InvalidatesGitReferences(t *testing.T) {
+	namespace := "test"
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	fc := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		fakeAppWithGitGeneratorWithRevision("git-github-tag", namespace, "git@github.com:org/repo.git", "v1.0"),
+		fakeAppWithMatrixAndGitGenerator("matrix-git-github", namespace, "https://github.com/org/repo.git"),
+	).Build()
+	repoCache := reposervercache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Minute, time.Minute, time.Minute)
+	refs := []*plumbing.Reference{plumbing.NewReferenceFromStrings("refs/heads/master", "abcdef")}
+	invalidatedRepoURLs := []string{"https://github.com/org/repo", "https://github.com/org/repo.git", "git@github.com:org/repo.git"}
+	for _, repoURL := range append(invalidatedRepoURLs, "https://github.com/org/other-repo") {
+		require.NoError(t, repoCache.SetGitReferences(repoURL, refs))
+	}
+
+	set := argosettings.NewSettingsManager(context.TODO(), newFakeClient(namespace), namespace)
+	h, err := NewWebhookHandler(namespace, 1, set, fc, mockGenerators(), repoCache)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/webhook", nil)
+	req.Header.Set("X-GitHub-Event", "push")
+	eventJSON, err := os.ReadFile(filepath.Join("testdata", "github-commit-event.json"))
+	require.NoError(t, err)
+	req.Body = io.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	h.Stop()
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	for _, repoURL := range invalidatedRepoURLs {
+		var cachedRefs []*plumbing.Reference
+		_, err = repoCache.GetGitReferences(repoURL, &cachedRefs)
+		require.NoError(t, err)
+		assert.Empty(t, cachedRefs, repoURL)
+	}
+	var cachedRefs []*plumbing.Reference
+	_, err = repoCache.GetGitReferences("https://github.com/org/other-repo", &cachedRefs)
+	require.NoError(t, err)
+	assert.Len(t, cachedRefs, 1)
+}
+
 func mockGenerators() map[string]generators.Generator {
 	// generatorMockList := generatorMock{}
 	generatorMockGit := &generatorMock{}
@@ -70,6 +70,8 @@ func NewCommand() *cobra.Command {
 		metricsAplicationConditions      []string
 		kubectlParallelismLimit          int64
 		cacheSource                      func() (*appstatecache.Cache, error)
+		eventsExportURL                  string
+		eventsExportHeaders              map[string]string
 		redisClient                      *redis.Client
 		repoServerPlaintext              bool
 		repoServerStrictTLS              bool
@@ -197,6 +199,9 @@ func NewCommand() *cobra.Command {
 				enableK8sEvent,
 			)
 			errors.CheckError(err)
+			if eventsExportURL != "" {
+				appController.SetEventExporter(argo.NewHTTPEventExporter(eventsExportURL, eventsExportHeaders))
+			}
 			cacheutil.CollectMetrics(redisClient, appController.GetMetricsServer())
 
 			stats.RegisterStackDumper()
@@ -275,6 +280,8 @@ func NewCommand() *cobra.Command {
 	// argocd k8s event logging flag
 	command.Flags().StringSliceVar(&enableK8sEvent, "enable-k8s-event", env.StringsFromEnv("ARGOCD_ENABLE_K8S_EVENT", argo.DefaultEnableEventList(), ","), "Enable ArgoCD to use k8s event. For disabling all events, set the value as `none`. (e.g --enable-k8s-event=none), For enabling specific events, set the value as `event reason`. (e.g --enable-k8s-event=StatusRefreshed,ResourceCreated)")
 
+	command.Flags().StringVar(&eventsExportURL, "events-export-url", env.StringFromEnv("ARGOCD_APPLICATION_CONTROLLER_EVENTS_EXPORT_URL", ""), "URL to which application events, e.g. sync operations and health changes, are posted as JSON, e.g. to feed delivery metrics pipelines. Disabled if empty.")
+	command.Flags().StringToStringVar(&eventsExportHeaders, "events-export-headers", env.ParseStringToStringFromEnv("ARGOCD_APPLICATION_CONTROLLER_EVENTS_EXPORT_HEADERS", map[string]string{}, ","), "List of extra headers sent with exported events, headers are comma-separated key-value pairs(e.g. key1=value1,key2=value2)")
 	cacheSource = appstatecache.AddCacheFlagsToCmd(&command, cacheutil.Options{
 		OnClientCreated: func(client *redis.Client) {
 			redisClient = client
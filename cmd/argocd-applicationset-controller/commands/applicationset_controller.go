@@ -12,6 +12,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	reposervercache "github.com/argoproj/argo-cd/v2/reposerver/cache"
 	logutils "github.com/argoproj/argo-cd/v2/util/log"
 	"github.com/argoproj/argo-cd/v2/util/tls"
 
@@ -73,6 +74,7 @@ func NewCommand() *cobra.Command {
 		enableScmProviders           bool
 		webhookParallelism           int
 		tokenRefStrictMode           bool
+		repoCacheSrc                 func() (*reposervercache.Cache, error)
 	)
 	scheme := runtime.NewScheme()
 	_ = clientgoscheme.AddToScheme(scheme)
@@ -186,8 +188,11 @@ func NewCommand() *cobra.Command {
 
 			topLevelGenerators := generators.GetGenerators(ctx, mgr.GetClient(), k8sClient, namespace, argoCDService, dynamicClient, scmConfig)
 
+			repoCache, err := repoCacheSrc()
+			errors.CheckError(err)
+
 			// start a webhook server that listens to incoming webhook payloads
-			webhookHandler, err := webhook.NewWebhookHandler(namespace, webhookParallelism, argoSettingsMgr, mgr.GetClient(), topLevelGenerators)
+			webhookHandler, err := webhook.NewWebhookHandler(namespace, webhookParallelism, argoSettingsMgr, mgr.GetClient(), topLevelGenerators, repoCache)
 			if err != nil {
 				log.Error(err, "failed to create webhook handler")
 			}
@@ -212,6 +217,7 @@ func NewCommand() *cobra.Command {
 				EnablePolicyOverride:       enablePolicyOverride,
 				KubeClientset:              k8sClient,
 				ArgoDB:                     argoCDDB,
+				SettingsMgr:                argoSettingsMgr,
 				ArgoCDNamespace:            namespace,
 				ApplicationSetNamespaces:   applicationSetNamespaces,
 				EnableProgressiveSyncs:     enableProgressiveSyncs,
@@ -262,6 +268,7 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringSliceVar(&globalPreservedLabels, "preserved-labels", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_GLOBAL_PRESERVED_LABELS", []string{}, ","), "Sets global preserved field values for labels")
 	command.Flags().IntVar(&webhookParallelism, "webhook-parallelism-limit", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_WEBHOOK_PARALLELISM_LIMIT", 50, 1, 1000), "Number of webhook requests processed concurrently")
 	command.Flags().StringSliceVar(&metricsAplicationsetLabels, "metrics-applicationset-labels", []string{}, "List of Application labels that will be added to the argocd_applicationset_labels metric")
+	repoCacheSrc = reposervercache.AddCacheFlagsToCmd(&command)
 	return &command
 }
 
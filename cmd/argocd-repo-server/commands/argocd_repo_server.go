@@ -28,6 +28,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/reposerver/repository"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	"github.com/argoproj/argo-cd/v2/util/cli"
+	"github.com/argoproj/argo-cd/v2/util/crypto"
 	"github.com/argoproj/argo-cd/v2/util/env"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/gpg"
@@ -76,6 +77,8 @@ func NewCommand() *cobra.Command {
 		disableManifestMaxExtractedSize   bool
 		includeHiddenDirectories          bool
 		cmpUseManifestGeneratePaths       bool
+		objectStoreCache                  cacheutil.S3ObjectStoreConfig
+		objectStoreCacheEncryptionKey     string
 	)
 	command := cobra.Command{
 		Use:               cliName,
@@ -104,6 +107,16 @@ func NewCommand() *cobra.Command {
 
 			cache, err := cacheSrc()
 			errors.CheckError(err)
+			if objectStoreCache.Bucket != "" {
+				store, err := cacheutil.NewS3ObjectStore(objectStoreCache)
+				errors.CheckError(err)
+				if objectStoreCacheEncryptionKey != "" {
+					key, err := crypto.KeyFromPassphrase(objectStoreCacheEncryptionKey)
+					errors.CheckError(err)
+					store = cacheutil.NewEncryptedObjectStore(store, key)
+				}
+				cache.SetObjectStore(store)
+			}
 
 			maxCombinedDirectoryManifestsQuantity, err := resource.ParseQuantity(maxCombinedDirectoryManifestsSize)
 			errors.CheckError(err)
@@ -244,6 +257,12 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&disableManifestMaxExtractedSize, "disable-helm-manifest-max-extracted-size", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_DISABLE_HELM_MANIFEST_MAX_EXTRACTED_SIZE", false), "Disable maximum size of helm manifest archives when extracted")
 	command.Flags().BoolVar(&includeHiddenDirectories, "include-hidden-directories", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_INCLUDE_HIDDEN_DIRECTORIES", false), "Include hidden directories from Git")
 	command.Flags().BoolVar(&cmpUseManifestGeneratePaths, "plugin-use-manifest-generate-paths", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_PLUGIN_USE_MANIFEST_GENERATE_PATHS", false), "Pass the resources described in argocd.argoproj.io/manifest-generate-paths value to the cmpserver to generate the application manifests.")
+	command.Flags().StringVar(&objectStoreCache.Bucket, "object-store-cache-bucket", env.StringFromEnv("ARGOCD_REPO_SERVER_OBJECT_STORE_CACHE_BUCKET", ""), "Bucket of an S3 compatible object store used to share generated manifests between replicas. Disabled if empty.")
+	command.Flags().StringVar(&objectStoreCache.Prefix, "object-store-cache-prefix", env.StringFromEnv("ARGOCD_REPO_SERVER_OBJECT_STORE_CACHE_PREFIX", ""), "Prefix of the names of the objects stored in the object store cache bucket")
+	command.Flags().StringVar(&objectStoreCache.Endpoint, "object-store-cache-endpoint", env.StringFromEnv("ARGOCD_REPO_SERVER_OBJECT_STORE_CACHE_ENDPOINT", ""), "Endpoint of the object store cache, e.g. https://storage.googleapis.com for Google Cloud Storage. Defaults to AWS S3.")
+	command.Flags().StringVar(&objectStoreCache.Region, "object-store-cache-region", env.StringFromEnv("ARGOCD_REPO_SERVER_OBJECT_STORE_CACHE_REGION", ""), "Region of the object store cache bucket")
+	command.Flags().BoolVar(&objectStoreCache.ForcePathStyle, "object-store-cache-force-path-style", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_OBJECT_STORE_CACHE_FORCE_PATH_STYLE", false), "Address the object store cache bucket as part of the path instead of the host name")
+	command.Flags().StringVar(&objectStoreCacheEncryptionKey, "object-store-cache-encryption-key", env.StringFromEnv("ARGOCD_REPO_SERVER_OBJECT_STORE_CACHE_ENCRYPTION_KEY", ""), "Passphrase used to encrypt the objects of the object store cache, which include rendered Secrets. Objects are not encrypted if empty.")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
 	cacheSrc = reposervercache.AddCacheFlagsToCmd(&command, cacheutil.Options{
 		OnClientCreated: func(client *redis.Client) {
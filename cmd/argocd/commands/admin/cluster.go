@@ -62,6 +62,7 @@ argocd admin cluster namespaces my-cluster `,
 	command.AddCommand(NewGenClusterConfigCommand(pathOpts))
 	command.AddCommand(NewClusterStatsCommand(clientOpts))
 	command.AddCommand(NewClusterShardsCommand(clientOpts))
+	command.AddCommand(NewClusterProbeCommand())
 	namespacesCommand := NewClusterNamespacesCommand()
 	namespacesCommand.AddCommand(NewClusterEnableNamespacedMode())
 	namespacesCommand.AddCommand(NewClusterDisableNamespacedMode())
@@ -0,0 +1,123 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/cli"
+	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	kubeutil "github.com/argoproj/argo-cd/v2/util/kube"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+type clusterProbe struct {
+	cluster v1alpha1.Cluster
+	result  kubeutil.ProbeResult
+}
+
+// NewClusterProbeCommand returns a new instance of the `argocd admin cluster probe` command
+func NewClusterProbeCommand() *cobra.Command {
+	var (
+		clientConfig clientcmd.ClientConfig
+		timeout      time.Duration
+	)
+	command := cobra.Command{
+		Use:   "probe [CLUSTER_URL|CLUSTER_NAME...]",
+		Short: "Probe the connectivity to the API servers of the clusters managed by Argo CD",
+		Long: "Probe the connectivity to the API servers of the clusters managed by Argo CD using the credentials stored in Argo CD. " +
+			"The probes are executed from the machine running the command, whose network access might differ from the application controller. " +
+			"Clusters whose Kubernetes version is outside of the supported range, by default the version of the Kubernetes client libraries of " +
+			"Argo CD and the three minor versions before it, are reported. Set the " + kubeutil.EnvSupportedVersions + " env variable to change the range, e.g. v1.28-v1.31.",
+		Example: `
+# Probe all clusters
+argocd admin cluster probe
+
+# Probe a single cluster
+argocd admin cluster probe https://kubernetes.default.svc`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+
+			log.SetLevel(log.WarnLevel)
+
+			clientCfg, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+
+			kubeClient := kubernetes.NewForConfigOrDie(clientCfg)
+			argoDB := db.NewDB(namespace, settings.NewSettingsManager(ctx, kubeClient, namespace), kubeClient)
+			clusters, err := argoDB.ListClusters(ctx)
+			errors.CheckError(err)
+
+			probes := probeClusters(ctx, filterClusters(clusters.Items, args), timeout)
+			printClusterProbes(os.Stdout, probes)
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(&command)
+	command.Flags().DurationVar(&timeout, "timeout", 10*time.Second, "Timeout of the probe of a single cluster")
+	return &command
+}
+
+// filterClusters returns the clusters which server URL or name matches one of the given arguments, or all clusters if
+// no arguments are given
+func filterClusters(clusters []v1alpha1.Cluster, args []string) []v1alpha1.Cluster {
+	if len(args) == 0 {
+		return clusters
+	}
+	var filtered []v1alpha1.Cluster
+	for _, cluster := range clusters {
+		for _, arg := range args {
+			if cluster.Server == arg || cluster.Name == arg {
+				filtered = append(filtered, cluster)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+func probeClusters(ctx context.Context, clusters []v1alpha1.Cluster, timeout time.Duration) []clusterProbe {
+	probes := make([]clusterProbe, len(clusters))
+	_ = kube.RunAllAsync(len(clusters), func(i int) error {
+		probes[i].cluster = clusters[i]
+		config, err := clusters[i].RESTConfig()
+		if err != nil {
+			probes[i].result = kubeutil.ProbeResult{Err: err}
+			return nil
+		}
+		probeCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		probes[i].result = kubeutil.ProbeAPIServer(probeCtx, config, true)
+		return nil
+	})
+	return probes
+}
+
+func printClusterProbes(out io.Writer, probes []clusterProbe) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "SERVER\tNAME\tSTATUS\tLATENCY\tVERSION\tMESSAGE\n")
+	supportedVersions := kubeutil.GetSupportedVersions(common.GetVersion().KubectlVersion)
+	for _, probe := range probes {
+		status := v1alpha1.ConnectionStatusSuccessful
+		message := supportedVersions.UnsupportedVersionMessage(probe.result.ServerVersion)
+		if probe.result.Err != nil {
+			status = v1alpha1.ConnectionStatusFailed
+			message = probe.result.Err.Error()
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", probe.cluster.Server, probe.cluster.Name, status, probe.result.Latency.Round(time.Millisecond), probe.result.ServerVersion, message)
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,35 @@
+package admin
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	kubeutil "github.com/argoproj/argo-cd/v2/util/kube"
+)
+
+func TestFilterClusters(t *testing.T) {
+	clusters := []v1alpha1.Cluster{
+		{Server: "https://kubernetes.default.svc", Name: "in-cluster"},
+		{Server: "https://prod.example.com", Name: "prod"},
+	}
+	assert.Len(t, filterClusters(clusters, nil), 2)
+	assert.Equal(t, []v1alpha1.Cluster{clusters[1]}, filterClusters(clusters, []string{"prod"}))
+	assert.Equal(t, []v1alpha1.Cluster{clusters[0]}, filterClusters(clusters, []string{"https://kubernetes.default.svc"}))
+	assert.Empty(t, filterClusters(clusters, []string{"staging"}))
+}
+
+func TestPrintClusterProbes(t *testing.T) {
+	out := &bytes.Buffer{}
+	printClusterProbes(out, []clusterProbe{
+		{cluster: v1alpha1.Cluster{Server: "https://prod.example.com", Name: "prod"}, result: kubeutil.ProbeResult{Latency: 42 * time.Millisecond, ServerVersion: "v1.31.1"}},
+		{cluster: v1alpha1.Cluster{Server: "https://staging.example.com", Name: "staging"}, result: kubeutil.ProbeResult{Err: errors.New("API server is not reachable")}},
+	})
+	assert.Contains(t, out.String(), "https://prod.example.com     prod     Successful  42ms     v1.31.1")
+	assert.Contains(t, out.String(), "Failed")
+	assert.Contains(t, out.String(), "API server is not reachable")
+}
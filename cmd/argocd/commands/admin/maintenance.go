@@ -0,0 +1,163 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/cli"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+// NewMaintenanceCommand returns a new instance of the `argocd admin maintenance` command
+func NewMaintenanceCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "maintenance",
+		Short: "Manage read-only maintenance mode of the application controller",
+		Long: "Manage read-only maintenance mode of the application controller. While maintenance mode is enabled, applications " +
+			"keep being refreshed but no new sync operations are executed, including automated syncs.",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(newMaintenanceToggleCommand(true))
+	command.AddCommand(newMaintenanceToggleCommand(false))
+	command.AddCommand(NewMaintenanceStatusCommand())
+	return command
+}
+
+func newMaintenanceToggleCommand(enable bool) *cobra.Command {
+	var (
+		clientConfig clientcmd.ClientConfig
+		project      string
+		message      string
+	)
+	use, short, example := "disable", "Disable maintenance mode", `
+# Resume sync operations of all applications
+argocd admin maintenance disable
+
+# Resume sync operations of the applications of a single project
+argocd admin maintenance disable --project my-project`
+	if enable {
+		use, short, example = "enable", "Enable maintenance mode", `
+# Defer sync operations of all applications
+argocd admin maintenance enable --message "Upgrading Argo CD"
+
+# Defer sync operations of the applications of a single project
+argocd admin maintenance enable --project my-project`
+	}
+	command := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Example: example,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			config, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+
+			if project != "" {
+				errors.CheckError(setProjectMaintenanceMode(ctx, appclientset.NewForConfigOrDie(config), namespace, project, enable))
+				fmt.Printf("Maintenance mode of project '%s' %sd\n", project, use)
+				return
+			}
+			errors.CheckError(setMaintenanceMode(ctx, kubernetes.NewForConfigOrDie(config), namespace, enable, message))
+			fmt.Printf("Maintenance mode %sd\n", use)
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVar(&project, "project", "", "Only toggle maintenance mode of the given project")
+	if enable {
+		command.Flags().StringVar(&message, "message", "", "Message displayed to users while maintenance mode is enabled")
+	}
+	return command
+}
+
+// NewMaintenanceStatusCommand returns a new instance of the `argocd admin maintenance status` command
+func NewMaintenanceStatusCommand() *cobra.Command {
+	var clientConfig clientcmd.ClientConfig
+	command := &cobra.Command{
+		Use:   "status",
+		Short: "Print maintenance mode of Argo CD and of all projects",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			config, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+
+			cm, err := kubernetes.NewForConfigOrDie(config).CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDConfigMapName, v1.GetOptions{})
+			errors.CheckError(err)
+			if settings.IsMaintenanceModeEnabled(cm.Data) {
+				fmt.Printf("Argo CD: enabled (%s)\n", cm.Data[settings.MaintenanceModeMessageKey])
+			} else {
+				fmt.Println("Argo CD: disabled")
+			}
+
+			projects, err := appclientset.NewForConfigOrDie(config).ArgoprojV1alpha1().AppProjects(namespace).List(ctx, v1.ListOptions{})
+			errors.CheckError(err)
+			for _, proj := range projects.Items {
+				if argo.IsProjectInMaintenanceMode(&proj) {
+					fmt.Printf("Project %s: enabled\n", proj.Name)
+				}
+			}
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	return command
+}
+
+func setMaintenanceMode(ctx context.Context, kubeClientset kubernetes.Interface, namespace string, enable bool, message string) error {
+	data := map[string]interface{}{
+		settings.MaintenanceModeEnabledKey: nil,
+		settings.MaintenanceModeMessageKey: nil,
+	}
+	if enable {
+		data[settings.MaintenanceModeEnabledKey] = "true"
+		if message != "" {
+			data[settings.MaintenanceModeMessageKey] = message
+		}
+	}
+	patch, err := json.Marshal(map[string]interface{}{"data": data})
+	if err != nil {
+		return fmt.Errorf("error marshaling patch: %w", err)
+	}
+	_, err = kubeClientset.CoreV1().ConfigMaps(namespace).Patch(ctx, common.ArgoCDConfigMapName, types.MergePatchType, patch, v1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("error patching %s: %w", common.ArgoCDConfigMapName, err)
+	}
+	return nil
+}
+
+func setProjectMaintenanceMode(ctx context.Context, appClientset appclientset.Interface, namespace string, project string, enable bool) error {
+	var value interface{}
+	if enable {
+		value = "true"
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{common.AnnotationKeyMaintenanceMode: value},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error marshaling patch: %w", err)
+	}
+	_, err = appClientset.ArgoprojV1alpha1().AppProjects(namespace).Patch(ctx, project, types.MergePatchType, patch, v1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("error patching project %s: %w", project, err)
+	}
+	return nil
+}
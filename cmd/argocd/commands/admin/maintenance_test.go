@@ -0,0 +1,57 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appfake "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
+)
+
+func TestSetMaintenanceMode(t *testing.T) {
+	ctx := context.Background()
+	kubeClientset := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd"},
+		Data:       map[string]string{"url": "https://argocd.example.com"},
+	})
+
+	require.NoError(t, setMaintenanceMode(ctx, kubeClientset, "argocd", true, "Upgrading Argo CD"))
+	cm, err := kubeClientset.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"url":                            "https://argocd.example.com",
+		"controller.maintenance.enabled": "true",
+		"controller.maintenance.message": "Upgrading Argo CD",
+	}, cm.Data)
+
+	require.NoError(t, setMaintenanceMode(ctx, kubeClientset, "argocd", false, ""))
+	cm, err = kubeClientset.CoreV1().ConfigMaps("argocd").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"url": "https://argocd.example.com"}, cm.Data)
+}
+
+func TestSetProjectMaintenanceMode(t *testing.T) {
+	ctx := context.Background()
+	appClientset := appfake.NewSimpleClientset(&v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-project", Namespace: "argocd"},
+	})
+
+	require.NoError(t, setProjectMaintenanceMode(ctx, appClientset, "argocd", "my-project", true))
+	proj, err := appClientset.ArgoprojV1alpha1().AppProjects("argocd").Get(ctx, "my-project", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "true", proj.Annotations[common.AnnotationKeyMaintenanceMode])
+
+	require.NoError(t, setProjectMaintenanceMode(ctx, appClientset, "argocd", "my-project", false))
+	proj, err = appClientset.ArgoprojV1alpha1().AppProjects("argocd").Get(ctx, "my-project", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, proj.Annotations, common.AnnotationKeyMaintenanceMode)
+
+	require.Error(t, setProjectMaintenanceMode(ctx, appClientset, "argocd", "missing", true))
+}
@@ -34,6 +34,7 @@ func NewProjectsCommand() *cobra.Command {
 	command.AddCommand(NewGenProjectSpecCommand())
 	command.AddCommand(NewUpdatePolicyRuleCommand())
 	command.AddCommand(NewProjectAllowListGenCommand())
+	command.AddCommand(NewProjectPolicyLintCommand())
 	return command
 }
 
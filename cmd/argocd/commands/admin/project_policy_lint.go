@@ -0,0 +1,127 @@
+package admin
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/assets"
+	"github.com/argoproj/argo-cd/v2/util/cli"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+)
+
+// NewProjectPolicyLintCommand returns a new instance of the `argocd admin proj policy-lint` command
+func NewProjectPolicyLintCommand() *cobra.Command {
+	var (
+		clientConfig clientcmd.ClientConfig
+		policyFile   string
+		strict       bool
+		whoCan       string
+		resource     string
+		object       string
+	)
+	command := &cobra.Command{
+		Use:   "policy-lint [PROJECT_GLOB]",
+		Short: "Statically analyze the role policies of projects",
+		Long: "Statically analyze the role policies of projects. Reports rules which never apply because a deny rule of the same " +
+			"role or of the global RBAC policy overrides them, rules which are covered by another rule of the same role, deny rules " +
+			"which revoke permissions the global RBAC policy grants across projects and global rules which allow every action on " +
+			"the objects of every project.",
+		Example: `
+# Lint the role policies of all projects
+argocd admin proj policy-lint
+
+# Lint the role policies of all projects against a local global RBAC policy and fail if there are findings
+argocd admin proj policy-lint --policy-file policy.csv --strict
+
+# Print the subjects which are allowed to sync the application 'my-app' of the project 'my-project'
+argocd admin proj policy-lint --who-can sync --object my-project/my-app`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			log.SetLevel(log.WarnLevel)
+
+			projectGlob := "*"
+			if len(args) > 0 {
+				projectGlob = args[0]
+			}
+
+			config, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+
+			projList, err := appclientset.NewForConfigOrDie(config).ArgoprojV1alpha1().AppProjects(namespace).List(ctx, v1.ListOptions{})
+			errors.CheckError(err)
+			userPolicy, defaultRole, matchMode := getPolicy(ctx, policyFile, kubernetes.NewForConfigOrDie(config), namespace)
+
+			if whoCan != "" {
+				if object == "" {
+					log.Fatal("--object is required with --who-can")
+				}
+				allowed, defaultAllowed, err := rbacpolicy.SubjectsAllowed(assets.BuiltinPolicyCSV, userPolicy, defaultRole, matchMode, projList.Items, resource, whoCan, object)
+				errors.CheckError(err)
+				printSubjectsAllowed(os.Stdout, allowed, defaultAllowed, defaultRole)
+				return
+			}
+
+			var projects []v1alpha1.AppProject
+			for _, proj := range projList.Items {
+				if globMatch(projectGlob, proj.Name) {
+					projects = append(projects, proj)
+				}
+			}
+			findings := append(rbacpolicy.LintGlobalPolicy(userPolicy, matchMode), rbacpolicy.LintProjectPolicies(projects, userPolicy, matchMode)...)
+			printPolicyLintFindings(os.Stdout, findings)
+			if strict && len(findings) > 0 {
+				os.Exit(1)
+			}
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVar(&policyFile, "policy-file", "", "Path to a global RBAC policy CSV file or argocd-rbac-cm manifest, used instead of the policy of the cluster")
+	command.Flags().BoolVar(&strict, "strict", false, "Exit with a non-zero code if any finding is reported")
+	command.Flags().StringVar(&whoCan, "who-can", "", "Print the subjects which are allowed to perform the given action on --object instead of linting")
+	command.Flags().StringVar(&resource, "resource", rbacpolicy.ResourceApplications, "Resource checked by --who-can")
+	command.Flags().StringVar(&object, "object", "", "Object checked by --who-can, e.g. my-project/my-app")
+	return command
+}
+
+func printPolicyLintFindings(out io.Writer, findings []rbacpolicy.PolicyLintFinding) {
+	if len(findings) == 0 {
+		_, _ = fmt.Fprintln(out, "No findings")
+		return
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "PROJECT\tROLE\tPOLICY\tMESSAGE\n")
+	for _, finding := range findings {
+		project := finding.Project
+		if project == "" {
+			project = "-"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", project, finding.Role, finding.Policy, finding.Message)
+	}
+	_ = w.Flush()
+}
+
+func printSubjectsAllowed(out io.Writer, allowed []string, defaultAllowed bool, defaultRole string) {
+	if defaultAllowed {
+		_, _ = fmt.Fprintf(out, "All users (default role %s)\n", defaultRole)
+	}
+	if len(allowed) > 0 {
+		_, _ = fmt.Fprintln(out, strings.Join(allowed, "\n"))
+	} else if !defaultAllowed {
+		_, _ = fmt.Fprintln(out, "No subjects")
+	}
+}
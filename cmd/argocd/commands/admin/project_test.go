@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 )
 
 const (
@@ -82,3 +84,28 @@ func TestGetModification_NotSupported(t *testing.T) {
 	_, err := getModification("bar", "*", "*", "allow")
 	assert.Errorf(t, err, "modification bar is not supported")
 }
+
+func TestPrintPolicyLintFindings(t *testing.T) {
+	out := &bytes.Buffer{}
+	printPolicyLintFindings(out, nil)
+	assert.Equal(t, "No findings\n", out.String())
+
+	out.Reset()
+	printPolicyLintFindings(out, []rbacpolicy.PolicyLintFinding{
+		{Role: "role:org-admin", Policy: "p, role:org-admin, applications, *, */*, allow", Message: "rule allows every action on applications of every project"},
+		{Project: "foo", Role: "test", Policy: "p, proj:foo:test, applications, sync, foo/*, allow", Message: "rule is redundant, it is covered by rule 'p, proj:foo:test, applications, *, foo/*, allow'"},
+	})
+	assert.Contains(t, out.String(), "PROJECT  ROLE            POLICY")
+	assert.Contains(t, out.String(), "-        role:org-admin  p, role:org-admin, applications, *, */*, allow")
+	assert.Contains(t, out.String(), "foo      test            p, proj:foo:test, applications, sync, foo/*, allow")
+}
+
+func TestPrintSubjectsAllowed(t *testing.T) {
+	out := &bytes.Buffer{}
+	printSubjectsAllowed(out, []string{"my-org:deployers", "proj:foo:test"}, true, "role:readonly")
+	assert.Equal(t, "All users (default role role:readonly)\nmy-org:deployers\nproj:foo:test\n", out.String())
+
+	out.Reset()
+	printSubjectsAllowed(out, nil, false, "")
+	assert.Equal(t, "No subjects\n", out.String())
+}
@@ -7,10 +7,13 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"text/tabwriter"
 	"time"
 	"unicode/utf8"
@@ -44,6 +47,7 @@ import (
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	repoapiclient "github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/reposerver/repository"
+	"github.com/argoproj/argo-cd/v2/util/app/path"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	argodiff "github.com/argoproj/argo-cd/v2/util/argo/diff"
 	"github.com/argoproj/argo-cd/v2/util/argo/normalizers"
@@ -89,6 +93,8 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationWaitCommand(clientOpts))
 	command.AddCommand(NewApplicationManifestsCommand(clientOpts))
 	command.AddCommand(NewApplicationTerminateOpCommand(clientOpts))
+	command.AddCommand(NewApplicationRefreshChangedCommand(clientOpts))
+	command.AddCommand(NewApplicationWarmCacheCommand(clientOpts))
 	command.AddCommand(NewApplicationEditCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchResourceCommand(clientOpts))
@@ -2818,6 +2824,7 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 		sourcePositions []int64
 		local           string
 		localRepoRoot   string
+		showCommands    bool
 	)
 	command := &cobra.Command{
 		Use:   "manifests APPNAME",
@@ -2831,6 +2838,9 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 
   # Get manifests for a multi-source application at specific revisions for specific sources
   argocd app manifests my-app --revisions 0.0.1 --source-positions 1 --revisions 0.0.2 --source-positions 2
+
+  # Print the commands used to generate the manifests of an application, e.g. to reproduce them locally
+  argocd app manifests my-app --show-commands
   		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -2850,11 +2860,33 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 				}
 			}
 
+			if showCommands && (source != "git" || local != "") {
+				log.Fatal("--show-commands is only supported with --source git and without --local")
+			}
+
 			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
 			clientset := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := clientset.NewApplicationClientOrDie()
 			defer argoio.Close(conn)
 
+			if showCommands {
+				q := application.ApplicationManifestQuery{
+					Name:            &appName,
+					AppNamespace:    &appNs,
+					Revisions:       revisions,
+					SourcePositions: sourcePositions,
+				}
+				if revision != "" {
+					q.Revision = ptr.To(revision)
+				}
+				res, err := appIf.GetManifests(ctx, &q)
+				errors.CheckError(err)
+				for _, command := range res.Commands {
+					fmt.Println(command)
+				}
+				return
+			}
+
 			resources, err := appIf.ManagedResources(ctx, &application.ResourcesQuery{
 				ApplicationName: &appName,
 				AppNamespace:    &appNs,
@@ -2938,6 +2970,7 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 	command.Flags().Int64SliceVar(&sourcePositions, "source-positions", []int64{}, "List of source positions. Default is empty array. Counting start at 1.")
 	command.Flags().StringVar(&local, "local", "", "If set, show locally-generated manifests. Value is the absolute path to app manifests within the manifest repo. Example: '/home/username/apps/env/app-1'.")
 	command.Flags().StringVar(&localRepoRoot, "local-repo-root", ".", "Path to the local repository root. Used together with --local allows setting the repository root. Example: '/home/username/apps'.")
+	command.Flags().BoolVar(&showCommands, "show-commands", false, "Print the commands used to generate the manifests from git instead of the manifests")
 	return command
 }
 
@@ -2967,6 +3000,200 @@ func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *c
 	return command
 }
 
+// NewApplicationRefreshChangedCommand returns a new instance of an `argocd app refresh-changed` command
+func NewApplicationRefreshChangedCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		hardRefresh bool
+		dryRun      bool
+		projects    []string
+		selector    string
+		parallelism int
+	)
+	command := &cobra.Command{
+		Use:   "refresh-changed REPOURL PATH...",
+		Short: "Refresh the applications of a repository which are affected by the given changed paths",
+		Long: "Refresh the applications of a repository which are affected by the given changed paths, e.g. the files changed by a commit in a CI pipeline. " +
+			"An application is affected if a changed path is under one of the paths of its argocd.argoproj.io/manifest-generate-paths annotation or, " +
+			"if the annotation is not set, under the path of one of its sources which use the repository.",
+		Example: `  # Refresh the applications affected by the files changed by the last commit
+  argocd app refresh-changed https://github.com/argoproj/argocd-example-apps.git $(git diff --name-only HEAD~1)
+
+  # Print the affected applications of a project without refreshing them
+  argocd app refresh-changed https://github.com/argoproj/argocd-example-apps.git guestbook/guestbook-ui-svc.yaml -p default --dry-run`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) < 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			repoURL, changedPaths := args[0], args[1:]
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			apps, err := appIf.List(ctx, &application.ApplicationQuery{Selector: ptr.To(selector), Projects: projects})
+			errors.CheckError(err)
+
+			affected := appsAffectedByChangedPaths(apps.Items, repoURL, changedPaths)
+			if dryRun {
+				for _, app := range affected {
+					fmt.Println(app.QualifiedName())
+				}
+				return
+			}
+
+			refreshType := getRefreshType(true, hardRefresh)
+			sem := make(chan struct{}, max(parallelism, 1))
+			var wg sync.WaitGroup
+			var failed atomic.Bool
+			for i := range affected {
+				app := affected[i]
+				wg.Add(1)
+				sem <- struct{}{}
+				go func() {
+					defer wg.Done()
+					defer func() { <-sem }()
+					_, err := appIf.Get(ctx, &application.ApplicationQuery{
+						Name:         ptr.To(app.Name),
+						AppNamespace: ptr.To(app.Namespace),
+						Refresh:      refreshType,
+					})
+					if err != nil {
+						log.Errorf("Failed to refresh application '%s': %v", app.QualifiedName(), err)
+						failed.Store(true)
+						return
+					}
+					fmt.Printf("Application '%s' refreshed\n", app.QualifiedName())
+				}()
+			}
+			wg.Wait()
+			if failed.Load() {
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().BoolVar(&hardRefresh, "hard-refresh", false, "Refresh application data as well as target manifests cache")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print the affected applications without refreshing them")
+	command.Flags().StringSliceVarP(&projects, "project", "p", []string{}, "Only consider applications of the given projects")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Only consider applications matching the given label selector (e.g. -l key1=value1,key2=value2)")
+	command.Flags().IntVar(&parallelism, "parallelism", 10, "Maximum number of applications refreshed in parallel")
+	return command
+}
+
+// appsAffectedByChangedPaths returns the applications with a source using the given repository whose refresh paths
+// overlap the given changed paths. The paths of the sources are used if the manifest-generate-paths annotation is not set.
+func appsAffectedByChangedPaths(apps []argoappv1.Application, repoURL string, changedPaths []string) []argoappv1.Application {
+	var affected []argoappv1.Application
+	for _, app := range apps {
+		var sourcePaths []string
+		for _, source := range app.Spec.GetSources() {
+			if git.SameURL(source.RepoURL, repoURL) {
+				sourcePaths = append(sourcePaths, filepath.Clean(source.Path))
+			}
+		}
+		if len(sourcePaths) == 0 {
+			continue
+		}
+		refreshPaths := path.GetAppRefreshPaths(&app)
+		if len(refreshPaths) == 0 {
+			refreshPaths = sourcePaths
+		}
+		if path.AppFilesHaveChanged(refreshPaths, changedPaths) {
+			affected = append(affected, app)
+		}
+	}
+	return affected
+}
+
+// NewApplicationWarmCacheCommand returns a new instance of an `argocd app warm-cache` command
+func NewApplicationWarmCacheCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		projects    []string
+		selector    string
+		parallelism int
+	)
+	command := &cobra.Command{
+		Use:   "warm-cache REPOURL REVISION",
+		Short: "Generate the manifests of the applications of a repository at a revision to warm up the manifest cache",
+		Long: "Generate the manifests of the applications with a source using the given repository at the given revision, e.g. in a CI pipeline right after a merge. " +
+			"The repo server caches the generated manifests, so the next refresh of the applications after the revision is pushed does not have to generate them.",
+		Example: `  # Warm up the manifest cache of the applications of a repository for the last commit
+  argocd app warm-cache https://github.com/argoproj/argocd-example-apps.git $(git rev-parse HEAD)
+
+  # Warm up the manifest cache of the applications of a project
+  argocd app warm-cache https://github.com/argoproj/argocd-example-apps.git $(git rev-parse HEAD) -p default`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			repoURL, revision := args[0], args[1]
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			apps, err := appIf.List(ctx, &application.ApplicationQuery{Selector: ptr.To(selector), Projects: projects})
+			errors.CheckError(err)
+
+			sem := make(chan struct{}, max(parallelism, 1))
+			var wg sync.WaitGroup
+			var failed atomic.Bool
+			for i := range apps.Items {
+				app := apps.Items[i]
+				q := warmCacheManifestQuery(&app, repoURL, revision)
+				if q == nil {
+					continue
+				}
+				wg.Add(1)
+				sem <- struct{}{}
+				go func() {
+					defer wg.Done()
+					defer func() { <-sem }()
+					if _, err := appIf.GetManifests(ctx, q); err != nil {
+						log.Errorf("Failed to generate manifests of application '%s': %v", app.QualifiedName(), err)
+						failed.Store(true)
+						return
+					}
+					fmt.Printf("Manifests of application '%s' generated\n", app.QualifiedName())
+				}()
+			}
+			wg.Wait()
+			if failed.Load() {
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().StringSliceVarP(&projects, "project", "p", []string{}, "Only consider applications of the given projects")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Only consider applications matching the given label selector (e.g. -l key1=value1,key2=value2)")
+	command.Flags().IntVar(&parallelism, "parallelism", 10, "Maximum number of applications whose manifests are generated in parallel")
+	return command
+}
+
+// warmCacheManifestQuery returns the query generating the manifests of the given application with the sources using
+// the given repository at the given revision, or nil if no source of the application uses the repository.
+func warmCacheManifestQuery(app *argoappv1.Application, repoURL string, revision string) *application.ApplicationManifestQuery {
+	q := &application.ApplicationManifestQuery{
+		Name:         ptr.To(app.Name),
+		AppNamespace: ptr.To(app.Namespace),
+	}
+	if !app.Spec.HasMultipleSources() {
+		if app.Spec.Source == nil || !git.SameURL(app.Spec.Source.RepoURL, repoURL) {
+			return nil
+		}
+		q.Revision = ptr.To(revision)
+		return q
+	}
+	for i, source := range app.Spec.Sources {
+		if git.SameURL(source.RepoURL, repoURL) {
+			q.Revisions = append(q.Revisions, revision)
+			q.SourcePositions = append(q.SourcePositions, int64(i+1))
+		}
+	}
+	if len(q.SourcePositions) == 0 {
+		return nil
+	}
+	return q
+}
+
 func NewApplicationEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var appNamespace string
 	command := &cobra.Command{
@@ -57,6 +57,7 @@ func TestPrintTreeViewDetailedAppResources(t *testing.T) {
 		Status:  "Degraded",
 		Message: "Readiness Gate failed",
 	}
+	nodes[2].Info = []v1alpha1.InfoItem{{Name: v1alpha1.InfoItemLastAppliedRevision, Value: "a1b2c3d"}}
 
 	nodeMapping := make(map[string]v1alpha1.ResourceNode)
 	mapParentToChild := make(map[string][]string)
@@ -86,6 +87,7 @@ func TestPrintTreeViewDetailedAppResources(t *testing.T) {
 	assert.Contains(t, output, "Rollout")
 	assert.Contains(t, output, "Degraded")
 	assert.Contains(t, output, "Readiness Gate failed")
+	assert.Contains(t, output, "a1b2c3d")
 }
 
 func TestPrintResourcesTree(t *testing.T) {
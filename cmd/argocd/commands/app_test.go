@@ -1923,6 +1923,67 @@ func testApp(name, project string, labels map[string]string, annotations map[str
 	}
 }
 
+func TestAppsAffectedByChangedPaths(t *testing.T) {
+	newApp := func(name, repoURL, path string, annotations map[string]string) v1alpha1.Application {
+		return v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations},
+			Spec:       v1alpha1.ApplicationSpec{Source: &v1alpha1.ApplicationSource{RepoURL: repoURL, Path: path}},
+		}
+	}
+	apps := []v1alpha1.Application{
+		newApp("guestbook", "https://github.com/argoproj/argocd-example-apps.git", "guestbook", nil),
+		newApp("helm-guestbook", "https://github.com/argoproj/argocd-example-apps", "helm-guestbook", nil),
+		newApp("kustomize-guestbook", "https://github.com/argoproj/argocd-example-apps.git", "kustomize-guestbook/overlays/prod", map[string]string{
+			v1alpha1.AnnotationKeyManifestGeneratePaths: ".;../../base",
+		}),
+		newApp("other-repo", "https://github.com/argoproj/other.git", "guestbook", nil),
+	}
+	names := func(apps []v1alpha1.Application) []string {
+		var res []string
+		for _, app := range apps {
+			res = append(res, app.Name)
+		}
+		return res
+	}
+
+	affected := appsAffectedByChangedPaths(apps, "https://github.com/argoproj/argocd-example-apps.git", []string{"guestbook/guestbook-ui-svc.yaml"})
+	assert.Equal(t, []string{"guestbook"}, names(affected))
+
+	affected = appsAffectedByChangedPaths(apps, "https://github.com/argoproj/argocd-example-apps.git", []string{"helm-guestbook/values.yaml", "kustomize-guestbook/base/deployment.yaml"})
+	assert.Equal(t, []string{"helm-guestbook", "kustomize-guestbook"}, names(affected))
+
+	affected = appsAffectedByChangedPaths(apps, "https://github.com/argoproj/argocd-example-apps.git", []string{"README.md"})
+	assert.Empty(t, affected)
+}
+
+func TestWarmCacheManifestQuery(t *testing.T) {
+	repoURL := "https://github.com/argoproj/argocd-example-apps.git"
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Spec:       v1alpha1.ApplicationSpec{Source: &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"}},
+	}
+	q := warmCacheManifestQuery(app, repoURL, "abc123")
+	require.NotNil(t, q)
+	assert.Equal(t, "guestbook", q.GetName())
+	assert.Equal(t, "argocd", q.GetAppNamespace())
+	assert.Equal(t, "abc123", q.GetRevision())
+
+	assert.Nil(t, warmCacheManifestQuery(app, "https://github.com/argoproj/other.git", "abc123"))
+
+	multiSourceApp := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "multi", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSpec{Sources: v1alpha1.ApplicationSources{
+			{RepoURL: "https://helm.example.com", Chart: "guestbook"},
+			{RepoURL: repoURL, Ref: "values"},
+		}},
+	}
+	q = warmCacheManifestQuery(multiSourceApp, repoURL, "abc123")
+	require.NotNil(t, q)
+	assert.Empty(t, q.GetRevision())
+	assert.Equal(t, []string{"abc123"}, q.Revisions)
+	assert.Equal(t, []int64{2}, q.SourcePositions)
+}
+
 func TestWaitOnApplicationStatus_JSON_YAML_WideOutput(t *testing.T) {
 	acdClient := &customAcdClient{&fakeAcdClient{}}
 	ctx := context.Background()
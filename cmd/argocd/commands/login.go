@@ -44,6 +44,7 @@ func NewLoginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comman
 		ssoPort          int
 		skipTestTLS      bool
 		ssoLaunchBrowser bool
+		ssoDeviceCode    bool
 	)
 	command := &cobra.Command{
 		Use:   "login SERVER",
@@ -55,6 +56,9 @@ argocd login cd.argoproj.io
 # Login to Argo CD using SSO
 argocd login cd.argoproj.io --sso
 
+# Login to Argo CD using SSO from a headless environment (OAuth2 device authorization grant)
+argocd login cd.argoproj.io --sso --sso-device-code
+
 # Configure direct access using Kubernetes API server
 argocd login cd.argoproj.io --core`,
 		Run: func(c *cobra.Command, args []string) {
@@ -136,7 +140,11 @@ argocd login cd.argoproj.io --core`,
 					errors.CheckError(err)
 					oauth2conf, provider, err := acdClient.OIDCConfig(ctx, acdSet)
 					errors.CheckError(err)
-					tokenString, refreshToken = oauth2Login(ctx, ssoPort, acdSet.GetOIDCConfig(), oauth2conf, provider, ssoLaunchBrowser)
+					if ssoDeviceCode {
+						tokenString, refreshToken = oauth2DeviceLogin(ctx, acdSet.GetOIDCConfig(), oauth2conf, provider)
+					} else {
+						tokenString, refreshToken = oauth2Login(ctx, ssoPort, acdSet.GetOIDCConfig(), oauth2conf, provider, ssoLaunchBrowser)
+					}
 				}
 				parser := jwt.NewParser(jwt.WithoutClaimsValidation())
 				claims := jwt.MapClaims{}
@@ -186,6 +194,7 @@ argocd login cd.argoproj.io --core`,
 	command.Flags().
 		BoolVar(&skipTestTLS, "skip-test-tls", false, "Skip testing whether the server is configured with TLS (this can help when the command hangs for no apparent reason)")
 	command.Flags().BoolVar(&ssoLaunchBrowser, "sso-launch-browser", true, "Automatically launch the system default browser when performing SSO login")
+	command.Flags().BoolVar(&ssoDeviceCode, "sso-device-code", false, "Perform SSO login using the OAuth2 device authorization grant, which does not require a browser or a local callback port")
 	return command
 }
 
@@ -351,6 +360,54 @@ func oauth2Login(
 	return tokenString, refreshToken
 }
 
+// oauth2DeviceLogin performs the OAuth2 device authorization grant (RFC 8628). The user is asked to visit a
+// verification URL on any device and enter a code, while the CLI polls the token endpoint. It returns the JWT
+// token and a refresh token (if supported)
+func oauth2DeviceLogin(
+	ctx context.Context,
+	oidcSettings *settingspkg.OIDCConfig,
+	oauth2conf *oauth2.Config,
+	provider *oidc.Provider,
+) (string, string) {
+	oidcConf, err := oidcutil.ParseConfig(provider)
+	errors.CheckError(err)
+	log.Debug("OIDC Configuration:")
+	log.Debugf("  grant_types_supported: %v", oidcConf.GrantTypesSupported)
+	log.Debugf("  device_authorization_endpoint: %v", oidcConf.DeviceAuthorizationEndpoint)
+	if !oidcutil.SupportsDeviceCodeGrant(oidcConf) {
+		log.Fatalf("OIDC provider %s does not support the device authorization grant", oidcConf.Issuer)
+	}
+	oauth2conf.Endpoint.DeviceAuthURL = oidcConf.DeviceAuthorizationEndpoint
+
+	opts := []oauth2.AuthCodeOption{oauth2.AccessTypeOffline}
+	if claimsRequested := oidcSettings.GetIDTokenClaims(); claimsRequested != nil {
+		opts = oidcutil.AppendClaimsAuthenticationRequestParameter(opts, claimsRequested)
+	}
+	deviceAuth, err := oauth2conf.DeviceAuth(ctx, opts...)
+	errors.CheckError(err)
+
+	fmt.Printf("Performing %s flow login\n", oidcutil.GrantTypeDeviceCode)
+	if deviceAuth.VerificationURIComplete != "" {
+		fmt.Printf("To authenticate, visit %s\n", deviceAuth.VerificationURIComplete)
+	} else {
+		fmt.Printf("To authenticate, visit %s and enter the code %s\n", deviceAuth.VerificationURI, deviceAuth.UserCode)
+	}
+
+	// DeviceAccessToken polls the token endpoint until the user completed the login, the device code
+	// expired or the context is canceled
+	tok, err := oauth2conf.DeviceAccessToken(ctx, deviceAuth)
+	errors.CheckError(err)
+	tokenString, ok := tok.Extra("id_token").(string)
+	if !ok {
+		log.Fatal("no id_token in token response")
+	}
+	refreshToken := tok.RefreshToken
+	fmt.Printf("Authentication successful\n")
+	log.Debugf("Token: %s", tokenString)
+	log.Debugf("Refresh Token: %s", refreshToken)
+	return tokenString, refreshToken
+}
+
 func passwordLogin(ctx context.Context, acdClient argocdclient.Client, username, password string) string {
 	username, password = cli.PromptCredentials(username, password)
 	sessConn, sessionIf := acdClient.NewSessionClientOrDie()
@@ -0,0 +1,104 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	// pluginPrefix is the prefix of the names of executables providing CLI plugins, e.g. argocd-promote
+	pluginPrefix = "argocd-"
+	// envPluginCLIPath is set for plugins to the path of the CLI which executes them, so that plugins can call the CLI
+	// and reuse its configuration and current context, e.g. "$ARGOCD_CLI_PATH app get my-app"
+	envPluginCLIPath = "ARGOCD_CLI_PATH"
+)
+
+// componentBinaryNames are the names under which the Argo CD components are installed next to the CLI, e.g. as
+// symlinks in the Argo CD image. They share the plugin prefix but must never be executed as plugins.
+var componentBinaryNames = map[string]bool{
+	"argocd-server":                    true,
+	"argocd-repo-server":               true,
+	"argocd-cmp-server":                true,
+	"argocd-application-controller":    true,
+	"argocd-applicationset-controller": true,
+	"argocd-dex":                       true,
+	"argocd-notifications":             true,
+	"argocd-git-ask-pass":              true,
+	"argocd-k8s-auth":                  true,
+}
+
+// PluginHandler finds and executes CLI plugins
+type PluginHandler interface {
+	// Lookup returns the path of the executable of the plugin with the given name
+	Lookup(name string) (string, bool)
+	// Execute runs the executable of a plugin with the given arguments and environment
+	Execute(executablePath string, args []string, environment []string) error
+}
+
+// DefaultPluginHandler finds plugins as executables named argocd-<name> in the PATH, like kubectl does. The binaries
+// of the Argo CD components are not considered plugins.
+type DefaultPluginHandler struct{}
+
+// NewDefaultPluginHandler returns a new instance of a DefaultPluginHandler
+func NewDefaultPluginHandler() *DefaultPluginHandler {
+	return &DefaultPluginHandler{}
+}
+
+func (h *DefaultPluginHandler) Lookup(name string) (string, bool) {
+	if componentBinaryNames[pluginPrefix+name] {
+		return "", false
+	}
+	path, err := exec.LookPath(pluginPrefix + name)
+	if err != nil || path == "" {
+		return "", false
+	}
+	return path, true
+}
+
+func (h *DefaultPluginHandler) Execute(executablePath string, args []string, environment []string) error {
+	cmd := exec.Command(executablePath, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = environment
+	return cmd.Run()
+}
+
+// HandlePluginCommand executes the plugin for the given arguments if they don't refer to a command of the given root
+// command. The plugin is named after the leading arguments which are not flags, joined by dashes, and the longest
+// name of an existing plugin wins, e.g. "argocd promote app my-app" runs "argocd-promote-app my-app" if it exists,
+// otherwise "argocd-promote app my-app". Returns false if no plugin was executed.
+func HandlePluginCommand(root *cobra.Command, handler PluginHandler, args []string) (bool, error) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return false, nil
+	}
+	if _, _, err := root.Find(args); err == nil {
+		// built-in commands cannot be overridden by plugins
+		return false, nil
+	}
+
+	var nameParts []string
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-") {
+			break
+		}
+		// dashes in the executable name separate the parts of the command
+		nameParts = append(nameParts, strings.ReplaceAll(arg, "-", "_"))
+	}
+	for i := len(nameParts); i > 0; i-- {
+		path, ok := handler.Lookup(strings.Join(nameParts[:i], "-"))
+		if !ok {
+			continue
+		}
+		environment := os.Environ()
+		if cliPath, err := os.Executable(); err == nil {
+			environment = append(environment, fmt.Sprintf("%s=%s", envPluginCLIPath, cliPath))
+		}
+		return true, handler.Execute(path, args[i:], environment)
+	}
+	return false, nil
+}
@@ -0,0 +1,115 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePluginHandler struct {
+	plugins  map[string]string
+	executed string
+	args     []string
+	env      []string
+}
+
+func (h *fakePluginHandler) Lookup(name string) (string, bool) {
+	path, ok := h.plugins[name]
+	return path, ok
+}
+
+func (h *fakePluginHandler) Execute(executablePath string, args []string, environment []string) error {
+	h.executed = executablePath
+	h.args = args
+	h.env = environment
+	return nil
+}
+
+func TestHandlePluginCommand(t *testing.T) {
+	newHandler := func() *fakePluginHandler {
+		return &fakePluginHandler{plugins: map[string]string{
+			"promote":         "/usr/local/bin/argocd-promote",
+			"promote-app":     "/usr/local/bin/argocd-promote-app",
+			"my_plugin":       "/usr/local/bin/argocd-my_plugin",
+			"version-compare": "/usr/local/bin/argocd-version-compare",
+		}}
+	}
+	root := NewCommand()
+
+	t.Run("longest plugin name wins", func(t *testing.T) {
+		handler := newHandler()
+		handled, err := HandlePluginCommand(root, handler, []string{"promote", "app", "my-app", "--to", "prod"})
+		require.NoError(t, err)
+		assert.True(t, handled)
+		assert.Equal(t, "/usr/local/bin/argocd-promote-app", handler.executed)
+		assert.Equal(t, []string{"my-app", "--to", "prod"}, handler.args)
+		assert.True(t, slices.ContainsFunc(handler.env, func(e string) bool {
+			return strings.HasPrefix(e, envPluginCLIPath+"=")
+		}))
+	})
+
+	t.Run("shorter plugin name", func(t *testing.T) {
+		handler := newHandler()
+		handled, err := HandlePluginCommand(root, handler, []string{"promote", "cluster", "--to", "prod"})
+		require.NoError(t, err)
+		assert.True(t, handled)
+		assert.Equal(t, "/usr/local/bin/argocd-promote", handler.executed)
+		assert.Equal(t, []string{"cluster", "--to", "prod"}, handler.args)
+	})
+
+	t.Run("dashes in arguments", func(t *testing.T) {
+		handler := newHandler()
+		handled, err := HandlePluginCommand(root, handler, []string{"my-plugin"})
+		require.NoError(t, err)
+		assert.True(t, handled)
+		assert.Equal(t, "/usr/local/bin/argocd-my_plugin", handler.executed)
+		assert.Empty(t, handler.args)
+	})
+
+	t.Run("built-in commands are not overridden", func(t *testing.T) {
+		handler := newHandler()
+		handled, err := HandlePluginCommand(root, handler, []string{"version", "compare"})
+		require.NoError(t, err)
+		assert.False(t, handled)
+		assert.Empty(t, handler.executed)
+	})
+
+	t.Run("unknown command", func(t *testing.T) {
+		handler := newHandler()
+		handled, err := HandlePluginCommand(root, handler, []string{"unknown", "--flag"})
+		require.NoError(t, err)
+		assert.False(t, handled)
+	})
+
+	t.Run("flags only", func(t *testing.T) {
+		handler := newHandler()
+		handled, err := HandlePluginCommand(root, handler, []string{"--help"})
+		require.NoError(t, err)
+		assert.False(t, handled)
+	})
+}
+
+func TestDefaultPluginHandler_Lookup(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"argocd-promote", "argocd-server", "argocd-notifications"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"), 0o755))
+	}
+	t.Setenv("PATH", dir)
+	handler := NewDefaultPluginHandler()
+
+	path, ok := handler.Lookup("promote")
+	assert.True(t, ok)
+	assert.Equal(t, filepath.Join(dir, "argocd-promote"), path)
+
+	_, ok = handler.Lookup("server")
+	assert.False(t, ok, "component binaries must not be executed as plugins")
+	_, ok = handler.Lookup("notifications")
+	assert.False(t, ok, "component binaries must not be executed as plugins")
+	_, ok = handler.Lookup("missing")
+	assert.False(t, ok)
+}
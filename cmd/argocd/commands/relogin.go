@@ -23,6 +23,7 @@ func NewReloginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comm
 		password         string
 		ssoPort          int
 		ssoLaunchBrowser bool
+		ssoDeviceCode    bool
 	)
 	command := &cobra.Command{
 		Use:   "relogin",
@@ -73,7 +74,11 @@ func NewReloginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comm
 				errors.CheckError(err)
 				oauth2conf, provider, err := acdClient.OIDCConfig(ctx, acdSet)
 				errors.CheckError(err)
-				tokenString, refreshToken = oauth2Login(ctx, ssoPort, acdSet.GetOIDCConfig(), oauth2conf, provider, ssoLaunchBrowser)
+				if ssoDeviceCode {
+					tokenString, refreshToken = oauth2DeviceLogin(ctx, acdSet.GetOIDCConfig(), oauth2conf, provider)
+				} else {
+					tokenString, refreshToken = oauth2Login(ctx, ssoPort, acdSet.GetOIDCConfig(), oauth2conf, provider, ssoLaunchBrowser)
+				}
 			}
 
 			localCfg.UpsertUser(localconfig.User{
@@ -101,5 +106,6 @@ argocd login cd.argoproj.io --core
 	command.Flags().StringVar(&password, "password", "", "The password of an account to authenticate")
 	command.Flags().IntVar(&ssoPort, "sso-port", DefaultSSOLocalPort, "Port to run local OAuth2 login application")
 	command.Flags().BoolVar(&ssoLaunchBrowser, "sso-launch-browser", true, "Automatically launch the default browser when performing SSO login")
+	command.Flags().BoolVar(&ssoDeviceCode, "sso-device-code", false, "Perform SSO login using the OAuth2 device authorization grant, which does not require a browser or a local callback port")
 	return command
 }
@@ -27,6 +27,15 @@ func extractHealthStatusAndReason(node v1alpha1.ResourceNode) (healthStatus heal
 	return
 }
 
+func extractLastAppliedRevision(node v1alpha1.ResourceNode) string {
+	for _, item := range node.Info {
+		if item.Name == v1alpha1.InfoItemLastAppliedRevision {
+			return item.Value
+		}
+	}
+	return ""
+}
+
 func treeViewAppGet(prefix string, uidToNodeMap map[string]v1alpha1.ResourceNode, parentToChildMap map[string][]string, parent v1alpha1.ResourceNode, mapNodeNameToResourceState map[string]*resourceState, w *tabwriter.Writer) {
 	healthStatus, _ := extractHealthStatusAndReason(parent)
 	if mapNodeNameToResourceState[parent.Kind+"/"+parent.Name] != nil {
@@ -113,7 +122,7 @@ func detailedTreeViewAppResourcesNotOrphaned(prefix string, uidToNodeMap map[str
 		if parent.CreatedAt != nil {
 			age = duration.HumanDuration(time.Since(parent.CreatedAt.Time))
 		}
-		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", parent.Group, parent.Kind, parent.Namespace, parent.Name, "No", age, healthStatus, reason)
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", parent.Group, parent.Kind, parent.Namespace, parent.Name, "No", age, healthStatus, reason, extractLastAppliedRevision(parent))
 	}
 	chs := parentChildMap[parent.UID]
 	for i, child := range chs {
@@ -134,7 +143,7 @@ func detailedTreeViewAppResourcesOrphaned(prefix string, uidToNodeMap map[string
 	if parent.CreatedAt != nil {
 		age = duration.HumanDuration(time.Since(parent.CreatedAt.Time))
 	}
-	_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", parent.Group, parent.Kind, parent.Namespace, parent.Name, "Yes", age, healthStatus, reason)
+	_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", parent.Group, parent.Kind, parent.Namespace, parent.Name, "Yes", age, healthStatus, reason, "")
 
 	chs := parentChildMap[parent.UID]
 	for i, child := range chs {
@@ -1,7 +1,10 @@
 package main
 
 import (
+	"errors"
+	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 
 	"github.com/argoproj/argo-cd/v2/cmd/util"
@@ -33,10 +36,12 @@ func main() {
 	}
 
 	isCLI := false
+	isArgocdCLI := false
 	switch binaryName {
 	case "argocd", "argocd-linux-amd64", "argocd-darwin-amd64", "argocd-windows-amd64.exe":
 		command = cli.NewCommand()
 		isCLI = true
+		isArgocdCLI = true
 	case "argocd-server":
 		command = apiserver.NewCommand()
 	case "argocd-application-controller":
@@ -61,9 +66,23 @@ func main() {
 	default:
 		command = cli.NewCommand()
 		isCLI = true
+		isArgocdCLI = true
 	}
 	util.SetAutoMaxProcs(isCLI)
 
+	if isArgocdCLI {
+		if handled, err := cli.HandlePluginCommand(command, cli.NewDefaultPluginHandler(), os.Args[1:]); handled {
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) {
+				os.Exit(exitErr.ExitCode())
+			} else if err != nil {
+				fmt.Fprintf(os.Stderr, "failed to execute plugin: %v\n", err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
 	if err := command.Execute(); err != nil {
 		os.Exit(1)
 	}
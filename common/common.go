@@ -203,6 +203,35 @@ const (
 	// AnnotationKeyAppSkipReconcile tells the Application to skip the Application controller reconcile.
 	// Skip reconcile when the value is "true" or any other string values that can be strconv.ParseBool() to be true.
 	AnnotationKeyAppSkipReconcile = "argocd.argoproj.io/skip-reconcile"
+	// AnnotationKeyDependsOn is a comma-separated list of resources of the same application which must be applied and
+	// healthy before the annotated resource is applied. Each entry has the form <kind>/<name>, <group>/<kind>/<name> or
+	// <group>/<kind>/<namespace>/<name>. The namespace defaults to the namespace of the annotated resource.
+	AnnotationKeyDependsOn = "argocd.argoproj.io/depends-on"
+	// AnnotationKeyMaintenanceMode puts all applications of an AppProject into read-only maintenance mode when set to "true".
+	// Applications keep being refreshed, but the application controller does not execute any sync operations for them.
+	AnnotationKeyMaintenanceMode = "argocd.argoproj.io/maintenance-mode"
+	// AnnotationKeyTTL is the time to live of an Application, e.g. "72h", counted from its creation. Once expired, the
+	// application controller, or the ApplicationSet controller for generated Applications, deletes the Application
+	// together with its resources.
+	AnnotationKeyTTL = "argocd.argoproj.io/ttl"
+	// AnnotationKeyQuotaMaxApplications is the maximum number of applications of an AppProject
+	AnnotationKeyQuotaMaxApplications = "argocd.argoproj.io/quota-max-applications"
+	// AnnotationKeyQuotaMaxResources is the maximum number of resources managed by all applications of an AppProject
+	AnnotationKeyQuotaMaxResources = "argocd.argoproj.io/quota-max-resources"
+	// AnnotationKeyQuotaMaxClusters is the maximum number of distinct destination clusters of the applications of an AppProject
+	AnnotationKeyQuotaMaxClusters = "argocd.argoproj.io/quota-max-clusters"
+	// AnnotationKeyQuotaMaxApplicationManifests is the maximum number of manifests generated for a single application of an AppProject
+	AnnotationKeyQuotaMaxApplicationManifests = "argocd.argoproj.io/quota-max-application-manifests"
+	// AnnotationKeyQuotaMaxApplicationManifestsSize is the maximum total size of the manifests generated for a single
+	// application of an AppProject, e.g. "10Mi"
+	AnnotationKeyQuotaMaxApplicationManifestsSize = "argocd.argoproj.io/quota-max-application-manifests-size"
+	// AnnotationKeyDisabledSourceTypes is a comma-separated list of source types, e.g. "Plugin,Helm", whose manifest
+	// generation is disabled for the applications of an AppProject
+	AnnotationKeyDisabledSourceTypes = "argocd.argoproj.io/disabled-source-types"
+	// AnnotationKeyK8sClientQPS overrides the QPS of the K8s client of a cluster when set on its cluster secret
+	AnnotationKeyK8sClientQPS = "argocd.argoproj.io/k8s-client-qps"
+	// AnnotationKeyK8sClientBurst overrides the burst of the K8s client of a cluster when set on its cluster secret
+	AnnotationKeyK8sClientBurst = "argocd.argoproj.io/k8s-client-burst"
 	// LabelKeyComponentRepoServer is the label key to identify the component as repo-server
 	LabelKeyComponentRepoServer = "app.kubernetes.io/component"
 	// LabelValueComponentRepoServer is the label value for the repo-server component
@@ -384,6 +413,8 @@ func GetCMPWorkDir() string {
 const (
 	// AnnotationApplicationSetRefresh is an annotation that is added when an ApplicationSet is requested to be refreshed by a webhook. The ApplicationSet controller will remove this annotation at the end of reconciliation.
 	AnnotationApplicationSetRefresh = "argocd.argoproj.io/application-set-refresh"
+	// AnnotationApplicationSetExpiredApplications lists the names of the generated Applications which the ApplicationSet controller deleted because their time to live passed. The listed Applications are not created again for as long as the generators produce them.
+	AnnotationApplicationSetExpiredApplications = "argocd.argoproj.io/expired-applications"
 )
 
 // gRPC settings
@@ -66,6 +66,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/glob"
 	"github.com/argoproj/argo-cd/v2/util/helm"
+	kubeutil "github.com/argoproj/argo-cd/v2/util/kube"
 	logutils "github.com/argoproj/argo-cd/v2/util/log"
 	settings_util "github.com/argoproj/argo-cd/v2/util/settings"
 )
@@ -293,7 +294,7 @@ func NewApplicationController(
 		}
 	}
 	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, kubectl, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking())
-	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.settingsMgr, stateCache, projInformer, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts)
+	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.settingsMgr, stateCache, projInformer, appLister, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts)
 	ctrl.appInformer = appInformer
 	ctrl.appLister = appLister
 	ctrl.projInformer = projInformer
@@ -321,6 +322,12 @@ func (ctrl *ApplicationController) GetMetricsServer() *metrics.MetricsServer {
 	return ctrl.metricsServer
 }
 
+// SetEventExporter configures the controller to export all application events, e.g. sync operations and health
+// changes, to an external system using the given exporter
+func (ctrl *ApplicationController) SetEventExporter(exporter argo.EventExporter) {
+	ctrl.auditLogger.SetEventExporter(exporter)
+}
+
 func (ctrl *ApplicationController) onKubectlRun(command string) (kube.CleanupFunc, error) {
 	ctrl.metricsServer.IncKubectlExec(command)
 	if ctrl.kubectlSemaphore != nil {
@@ -587,6 +594,8 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 		return nil, fmt.Errorf("failed to iterate resource hierarchy v2: %w", err)
 	}
 	ts.AddCheckpoint("process_managed_resources_ms")
+	ctrl.setLastAppliedRevisions(a, nodes)
+	ts.AddCheckpoint("set_last_applied_revisions_ms")
 	orphanedNodes := make([]appv1.ResourceNode, 0)
 	orphanedNodesKeys := make([]kube.ResourceKey, 0)
 	for k := range orphanedNodesMap {
@@ -642,6 +651,26 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 	return &appv1.ApplicationTree{Nodes: nodes, OrphanedNodes: orphanedNodes, Hosts: hosts}, nil
 }
 
+// setLastAppliedRevisions adds the revision which last changed a resource to the info of the corresponding tree node
+func (ctrl *ApplicationController) setLastAppliedRevisions(a *appv1.Application, nodes []appv1.ResourceNode) {
+	revisions, err := getLastAppliedRevisions(ctrl.cache, a.InstanceName(ctrl.namespace), a.Status.Resources)
+	if err != nil {
+		getAppLog(a).Warnf("Failed to get revisions of resources: %v", err)
+	}
+	for i := range nodes {
+		node := &nodes[i]
+		key := kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)
+		revision, ok := revisions[key.String()]
+		if !ok {
+			continue
+		}
+		// copy the info items since they might be shared with the cluster cache
+		info := make([]appv1.InfoItem, 0, len(node.Info)+1)
+		info = append(info, node.Info...)
+		node.Info = append(info, appv1.InfoItem{Name: appv1.InfoItemLastAppliedRevision, Value: revision})
+	}
+}
+
 func (ctrl *ApplicationController) getAppHosts(a *appv1.Application, appNodes []appv1.ResourceNode) ([]appv1.HostInfo, error) {
 	ts := stats.NewTimingStats()
 	defer func() {
@@ -982,7 +1011,13 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 	ts.AddCheckpoint("get_fresh_app_ms")
 
 	if app.Operation != nil {
-		ctrl.processRequestedAppOperation(app)
+		// Operations which already started are allowed to complete, new ones wait until maintenance mode ends
+		if message := ctrl.deferredOperationMessage(app); message != "" {
+			logCtx.Infof("Deferring operation: %s", message)
+			ctrl.setAppCondition(app, appv1.ApplicationCondition{Type: appv1.ApplicationConditionMaintenanceModeWarning, Message: message})
+		} else {
+			ctrl.processRequestedAppOperation(app)
+		}
 		ts.AddCheckpoint("process_requested_app_operation_ms")
 	} else if app.DeletionTimestamp != nil {
 		if err = ctrl.finalizeApplicationDeletion(app, func(project string) ([]*appv1.Cluster, error) {
@@ -1573,6 +1608,9 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		return
 	}
 	origApp = origApp.DeepCopy()
+	if ctrl.deleteAppIfExpired(origApp) {
+		return
+	}
 	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, ctrl.statusRefreshTimeout, ctrl.statusHardRefreshTimeout)
 
 	if !needRefresh {
@@ -1696,8 +1734,13 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary(app)
 	}
 
+	setExpirationCondition(app)
+	maintenanceMessage := ctrl.maintenanceModeMessage(project)
+	setMaintenanceModeCondition(app, maintenanceMessage)
 	canSync, _ := project.Spec.SyncWindows.Matches(app).CanSync(false)
-	if canSync {
+	if maintenanceMessage != "" {
+		logCtx.Info("Sync prevented by maintenance mode")
+	} else if canSync {
 		syncErrCond, opMS := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionUpdated)
 		setOpMs = opMS
 		if syncErrCond != nil {
@@ -1721,6 +1764,15 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	}
 	app.Status.Sync = *compareResult.syncStatus
 	app.Status.Health = *compareResult.healthStatus
+	lastAppliedRevisions, err := getLastAppliedRevisions(ctrl.cache, app.InstanceName(ctrl.namespace), app.Status.Resources)
+	if err != nil {
+		logCtx.Warnf("Failed to get revisions of resources: %v", err)
+	}
+	for i := range compareResult.resources {
+		res := &compareResult.resources[i]
+		key := kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)
+		res.LastAppliedRevision = lastAppliedRevisions[key.String()]
+	}
 	app.Status.Resources = compareResult.resources
 	sort.Slice(app.Status.Resources, func(i, j int) bool {
 		return resourceStatusKey(app.Status.Resources[i]) < resourceStatusKey(app.Status.Resources[j])
@@ -1833,10 +1885,22 @@ func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application)
 		} else {
 			errorConditions = append(errorConditions, specConditions...)
 		}
+		if message, err := ctrl.projectQuotaExceededMessage(app, proj); err != nil {
+			errorConditions = append(errorConditions, appv1.ApplicationCondition{
+				Type:    appv1.ApplicationConditionUnknownError,
+				Message: err.Error(),
+			})
+		} else if message != "" {
+			errorConditions = append(errorConditions, appv1.ApplicationCondition{
+				Type:    appv1.ApplicationConditionQuotaExceededError,
+				Message: message,
+			})
+		}
 	}
 	app.Status.SetConditions(errorConditions, map[appv1.ApplicationConditionType]bool{
-		appv1.ApplicationConditionInvalidSpecError: true,
-		appv1.ApplicationConditionUnknownError:     true,
+		appv1.ApplicationConditionInvalidSpecError:   true,
+		appv1.ApplicationConditionUnknownError:       true,
+		appv1.ApplicationConditionQuotaExceededError: true,
 	})
 	return proj, len(errorConditions) > 0
 }
@@ -2357,10 +2421,21 @@ func (ctrl *ApplicationController) projectErrorToCondition(err error, app *appv1
 }
 
 func (ctrl *ApplicationController) RegisterClusterSecretUpdater(ctx context.Context) {
-	updater := NewClusterInfoUpdater(ctrl.stateCache, ctrl.db, ctrl.appLister.Applications(""), ctrl.cache, ctrl.clusterSharding.IsManagedCluster, ctrl.getAppProj, ctrl.namespace)
+	updater := NewClusterInfoUpdater(ctrl.stateCache, ctrl.db, ctrl.appLister.Applications(""), ctrl.cache, ctrl.clusterSharding.IsManagedCluster, ctrl.getAppProj, ctrl.probeCluster, ctrl.statusRefreshTimeout, ctrl.namespace)
 	go updater.Run(ctx)
 }
 
+// probeCluster checks the connectivity to the API server of the given cluster and records the outcome in the metrics
+func (ctrl *ApplicationController) probeCluster(ctx context.Context, cluster *appv1.Cluster, withVersion bool) kubeutil.ProbeResult {
+	config, err := cluster.RESTConfig()
+	if err != nil {
+		return kubeutil.ProbeResult{Err: err}
+	}
+	result := kubeutil.ProbeAPIServer(ctx, config, withVersion)
+	ctrl.metricsServer.ObserveClusterProbe(cluster.Server, result.Latency, result.Err != nil)
+	return result
+}
+
 func isOperationInProgress(app *appv1.Application) bool {
 	return app.Status.OperationState != nil && !app.Status.OperationState.Phase.Completed()
 }
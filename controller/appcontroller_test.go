@@ -1610,6 +1610,50 @@ func TestRefreshAppConditions(t *testing.T) {
 		assert.Equal(t, v1alpha1.ApplicationConditionInvalidSpecError, app.Status.Conditions[0].Type)
 		assert.Equal(t, "Application referencing project wrong project which does not exist", app.Status.Conditions[0].Message)
 	})
+
+	t.Run("QuotaExceededErrorCondition", func(t *testing.T) {
+		quotaProj := defaultProj
+		quotaProj.Annotations = map[string]string{common.AnnotationKeyQuotaMaxApplications: "1", common.AnnotationKeyQuotaMaxClusters: "1"}
+		oldApp := newFakeApp()
+		oldApp.Name = "old-app"
+		oldApp.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+		newApp := newFakeApp()
+		newApp.CreationTimestamp = metav1.NewTime(time.Now())
+
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{oldApp, newApp, &quotaProj}}, nil)
+
+		_, hasErrors := ctrl.refreshAppConditions(oldApp)
+		assert.False(t, hasErrors, "applications created before the quota was reached are not blocked")
+		_, hasErrors = ctrl.refreshAppConditions(newApp)
+		assert.True(t, hasErrors)
+		require.Len(t, newApp.Status.Conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionQuotaExceededError, newApp.Status.Conditions[0].Type)
+		assert.Equal(t, "project default exceeds its quota of 1 applications", newApp.Status.Conditions[0].Message)
+	})
+
+	t.Run("QuotaExceededErrorConditionClusters", func(t *testing.T) {
+		quotaProj := defaultProj
+		quotaProj.Annotations = map[string]string{common.AnnotationKeyQuotaMaxClusters: "1"}
+		oldApp := newFakeApp()
+		oldApp.Name = "old-app"
+		oldApp.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+		sameClusterApp := newFakeApp()
+		sameClusterApp.Name = "same-cluster-app"
+		sameClusterApp.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Minute))
+		newApp := newFakeApp()
+		newApp.CreationTimestamp = metav1.NewTime(time.Now())
+		newApp.Spec.Destination.Server = "https://other-cluster"
+
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{oldApp, sameClusterApp, newApp, &quotaProj}}, nil)
+
+		_, hasErrors := ctrl.refreshAppConditions(sameClusterApp)
+		assert.False(t, hasErrors)
+		_, hasErrors = ctrl.refreshAppConditions(newApp)
+		assert.True(t, hasErrors)
+		conditions := newApp.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{v1alpha1.ApplicationConditionQuotaExceededError: true})
+		require.Len(t, conditions, 1)
+		assert.Equal(t, "project default exceeds its quota of 1 destination clusters", conditions[0].Message)
+	})
 }
 
 func TestUpdateReconciledAt(t *testing.T) {
@@ -1791,6 +1835,63 @@ func TestProcessRequestedAppOperation_InvalidDestination(t *testing.T) {
 	assert.Contains(t, message, "application destination can't have both name and server defined: another-cluster https://localhost:6443")
 }
 
+func TestProcessRequestedAppOperation_ProjectQuotaExceeded(t *testing.T) {
+	configMap := func(name string) string {
+		return fmt.Sprintf(`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "%s", "namespace": "%s"}}`, name, test.FakeDestNamespace)
+	}
+	runSync := func(t *testing.T, statusResources int, manifests ...string) string {
+		t.Helper()
+		app := newFakeApp()
+		app.Spec.Project = "test-project"
+		app.Operation = &v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{Prune: true},
+		}
+		app.Status.OperationState = nil
+		for i := 0; i < statusResources; i++ {
+			app.Status.Resources = append(app.Status.Resources, v1alpha1.ResourceStatus{Kind: "ConfigMap", Name: fmt.Sprintf("cm-%d", i)})
+		}
+		proj := defaultProj
+		proj.Name = "test-project"
+		proj.Annotations = map[string]string{common.AnnotationKeyQuotaMaxResources: "1"}
+		ctrl := newFakeController(&fakeData{
+			apps: []runtime.Object{app, &proj},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: manifests,
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		}, nil)
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		receivedPatch := map[string]interface{}{}
+		func() {
+			fakeAppCs.Lock()
+			defer fakeAppCs.Unlock()
+			fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+				if patchAction, ok := action.(kubetesting.PatchAction); ok {
+					require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+				}
+				return true, &v1alpha1.Application{}, nil
+			})
+		}()
+
+		ctrl.processRequestedAppOperation(app)
+
+		message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+		return message
+	}
+
+	t.Run("sync adding resources beyond the quota", func(t *testing.T) {
+		message := runSync(t, 0, configMap("cm-1"), configMap("cm-2"))
+		assert.Equal(t, "project test-project exceeds its quota of 1 managed resources: 2 resources", message)
+	})
+	t.Run("sync reducing resources of a project exceeding the quota", func(t *testing.T) {
+		message := runSync(t, 3, configMap("cm-1"), configMap("cm-2"))
+		assert.NotContains(t, message, "quota")
+	})
+}
+
 func TestProcessRequestedAppOperation_FailedHasRetries(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.Project = "invalid-project"
@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/argoproj/argo-cd/v2/common"
@@ -21,6 +22,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v2/util/db"
+	kubeutil "github.com/argoproj/argo-cd/v2/util/kube"
 )
 
 const (
@@ -29,7 +31,17 @@ const (
 	EnvClusterInfoTimeout = "ARGO_CD_UPDATE_CLUSTER_INFO_TIMEOUT"
 )
 
-var clusterInfoTimeout = env.ParseDurationFromEnv(EnvClusterInfoTimeout, defaultSecretUpdateInterval, defaultSecretUpdateInterval, 1*time.Minute)
+var (
+	clusterInfoTimeout = env.ParseDurationFromEnv(EnvClusterInfoTimeout, defaultSecretUpdateInterval, defaultSecretUpdateInterval, 1*time.Minute)
+	// supportedKubernetesVersions are the Kubernetes versions of managed clusters which are not reported as unsupported
+	supportedKubernetesVersions = kubeutil.GetSupportedVersions(common.GetVersion().KubectlVersion)
+)
+
+// clusterProbe is the outcome of the last probe of the API server of a cluster
+type clusterProbe struct {
+	result   kubeutil.ProbeResult
+	probedAt time.Time
+}
 
 type clusterInfoUpdater struct {
 	infoSource    metrics.HasClustersInfo
@@ -38,8 +50,13 @@ type clusterInfoUpdater struct {
 	cache         *appstatecache.Cache
 	clusterFilter func(cluster *appv1.Cluster) bool
 	projGetter    func(app *appv1.Application) (*appv1.AppProject, error)
+	probe         func(ctx context.Context, cluster *appv1.Cluster, withVersion bool) kubeutil.ProbeResult
+	probeInterval time.Duration
 	namespace     string
 	lastUpdated   time.Time
+
+	probesLock sync.Mutex
+	probes     map[string]clusterProbe
 }
 
 func NewClusterInfoUpdater(
@@ -49,9 +66,22 @@ func NewClusterInfoUpdater(
 	cache *appstatecache.Cache,
 	clusterFilter func(cluster *appv1.Cluster) bool,
 	projGetter func(app *appv1.Application) (*appv1.AppProject, error),
+	probe func(ctx context.Context, cluster *appv1.Cluster, withVersion bool) kubeutil.ProbeResult,
+	probeInterval time.Duration,
 	namespace string,
 ) *clusterInfoUpdater {
-	return &clusterInfoUpdater{infoSource, db, appLister, cache, clusterFilter, projGetter, namespace, time.Time{}}
+	return &clusterInfoUpdater{
+		infoSource:    infoSource,
+		db:            db,
+		appLister:     appLister,
+		cache:         cache,
+		clusterFilter: clusterFilter,
+		projGetter:    projGetter,
+		probe:         probe,
+		probeInterval: probeInterval,
+		namespace:     namespace,
+		probes:        map[string]clusterProbe{},
+	}
 }
 
 func (c *clusterInfoUpdater) Run(ctx context.Context) {
@@ -120,6 +150,9 @@ func (c *clusterInfoUpdater) updateClusterInfo(ctx context.Context, cluster appv
 	}
 
 	updated := c.getUpdatedClusterInfo(ctx, apps, cluster, info, metav1.Now())
+	if c.probe != nil {
+		applyProbeResult(&updated, c.getProbeResult(ctx, &cluster, updated.ServerVersion == ""))
+	}
 	return c.cache.SetClusterInfo(cluster.Server, &updated)
 }
 
@@ -168,6 +201,39 @@ func (c *clusterInfoUpdater) getUpdatedClusterInfo(ctx context.Context, apps []*
 	return clusterInfo
 }
 
+// getProbeResult probes the API server of the cluster at most once per probe interval, which is the application resync
+// period, and returns the outcome of the last probe otherwise. The version of the API server is only requested if it is
+// not known from the cluster cache.
+func (c *clusterInfoUpdater) getProbeResult(ctx context.Context, cluster *appv1.Cluster, withVersion bool) kubeutil.ProbeResult {
+	c.probesLock.Lock()
+	last, ok := c.probes[cluster.Server]
+	c.probesLock.Unlock()
+	if ok && time.Since(last.probedAt) < c.probeInterval && (!withVersion || last.result.ServerVersion != "" || last.result.Err != nil) {
+		return last.result
+	}
+	result := c.probe(ctx, cluster, withVersion)
+	c.probesLock.Lock()
+	c.probes[cluster.Server] = clusterProbe{result: result, probedAt: time.Now()}
+	c.probesLock.Unlock()
+	return result
+}
+
+// applyProbeResult overrides the connection state derived from the cluster cache with the outcome of actively probing
+// the API server, so that unreachable clusters and rejected credentials are reported before a sync fails
+func applyProbeResult(clusterInfo *appv1.ClusterInfo, result kubeutil.ProbeResult) {
+	if result.Err != nil {
+		clusterInfo.ConnectionState.Status = appv1.ConnectionStatusFailed
+		clusterInfo.ConnectionState.Message = result.Err.Error()
+		return
+	}
+	if clusterInfo.ServerVersion == "" {
+		clusterInfo.ServerVersion = result.ServerVersion
+	}
+	if clusterInfo.ConnectionState.Status == appv1.ConnectionStatusSuccessful {
+		clusterInfo.ConnectionState.Message = supportedKubernetesVersions.UnsupportedVersionMessage(clusterInfo.ServerVersion)
+	}
+}
+
 func updateClusterLabels(ctx context.Context, clusterInfo *cache.ClusterInfo, cluster appv1.Cluster, updateCluster func(context.Context, *appv1.Cluster) (*appv1.Cluster, error)) error {
 	if clusterInfo != nil && cluster.Labels[common.LabelKeyAutoLabelClusterInfo] == "true" && cluster.Labels[common.LabelKeyClusterKubernetesVersion] != clusterInfo.K8SVersion {
 		cluster.Labels[common.LabelKeyClusterKubernetesVersion] = clusterInfo.K8SVersion
@@ -19,6 +19,7 @@ import (
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	"github.com/argoproj/argo-cd/v2/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v2/util/db"
+	kubeutil "github.com/argoproj/argo-cd/v2/util/kube"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 
 	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
@@ -88,7 +89,7 @@ func TestClusterSecretUpdater(t *testing.T) {
 		}
 
 		lister := applisters.NewApplicationLister(appInformer.GetIndexer()).Applications(fakeNamespace)
-		updater := NewClusterInfoUpdater(nil, argoDB, lister, appCache, nil, nil, fakeNamespace)
+		updater := NewClusterInfoUpdater(nil, argoDB, lister, appCache, nil, nil, nil, 0, fakeNamespace)
 
 		err = updater.updateClusterInfo(context.Background(), *cluster, info)
 		require.NoError(t, err, "Invoking updateClusterInfo failed.")
@@ -189,3 +190,42 @@ func TestUpdateClusterLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyProbeResult(t *testing.T) {
+	t.Run("Failed", func(t *testing.T) {
+		info := v1alpha1.ClusterInfo{ConnectionState: v1alpha1.ConnectionState{Status: v1alpha1.ConnectionStatusSuccessful}}
+		applyProbeResult(&info, kubeutil.ProbeResult{Err: errors.New("credentials were rejected by the API server")})
+		assert.Equal(t, v1alpha1.ConnectionStatusFailed, info.ConnectionState.Status)
+		assert.Equal(t, "credentials were rejected by the API server", info.ConnectionState.Message)
+	})
+
+	t.Run("Successful", func(t *testing.T) {
+		info := v1alpha1.ClusterInfo{ConnectionState: v1alpha1.ConnectionState{Status: v1alpha1.ConnectionStatusUnknown}}
+		applyProbeResult(&info, kubeutil.ProbeResult{ServerVersion: "v1.31.1"})
+		assert.Equal(t, v1alpha1.ConnectionStatusUnknown, info.ConnectionState.Status)
+		assert.Equal(t, "v1.31.1", info.ServerVersion)
+	})
+}
+
+func TestGetProbeResult(t *testing.T) {
+	var probes []bool
+	updater := NewClusterInfoUpdater(nil, nil, nil, nil, nil, nil, func(_ context.Context, _ *v1alpha1.Cluster, withVersion bool) kubeutil.ProbeResult {
+		probes = append(probes, withVersion)
+		if withVersion {
+			return kubeutil.ProbeResult{ServerVersion: "v1.31.1"}
+		}
+		return kubeutil.ProbeResult{}
+	}, time.Hour, "argocd")
+	cluster := &v1alpha1.Cluster{Server: "https://prod.example.com"}
+
+	assert.Empty(t, updater.getProbeResult(context.Background(), cluster, false).ServerVersion)
+	assert.Empty(t, updater.getProbeResult(context.Background(), cluster, false).ServerVersion)
+	assert.Equal(t, []bool{false}, probes, "clusters must be probed at most once per probe interval")
+
+	assert.Equal(t, "v1.31.1", updater.getProbeResult(context.Background(), cluster, true).ServerVersion)
+	assert.Equal(t, []bool{false, true}, probes, "clusters must be probed again if the version is not known yet")
+
+	updater.probeInterval = 0
+	updater.getProbeResult(context.Background(), cluster, false)
+	assert.Equal(t, []bool{false, true, false}, probes)
+}
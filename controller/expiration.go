@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+// expirationWarningPeriod is the period before the expiry of an application during which it reports the expiry with a
+// warning condition
+const expirationWarningPeriod = time.Hour
+
+// setExpirationCondition sets the expiration warning condition of the application if its time to live is invalid or if
+// it expires within the warning period, and clears it otherwise
+func setExpirationCondition(app *appv1.Application) {
+	var conditions []appv1.ApplicationCondition
+	expiresAt, err := argo.GetAppExpiration(app)
+	if err != nil {
+		conditions = append(conditions, appv1.ApplicationCondition{Type: appv1.ApplicationConditionExpirationWarning, Message: err.Error()})
+	} else if expiresAt != nil && time.Until(*expiresAt) <= expirationWarningPeriod {
+		conditions = append(conditions, appv1.ApplicationCondition{
+			Type:    appv1.ApplicationConditionExpirationWarning,
+			Message: fmt.Sprintf("Application expires at %s and will be deleted together with its resources", expiresAt.UTC().Format(time.RFC3339)),
+		})
+	}
+	app.Status.SetConditions(conditions, map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionExpirationWarning: true})
+}
+
+// deleteAppIfExpired deletes the application together with its resources once its time to live has passed. Applications
+// which did not expire yet are requeued so that the expiration warning is raised and the application is deleted in time.
+// Applications generated by an ApplicationSet are deleted by the ApplicationSet controller, which would otherwise create
+// them again, and the deletion is deferred while Argo CD or the project is in maintenance mode. Returns true if the
+// application was deleted.
+func (ctrl *ApplicationController) deleteAppIfExpired(app *appv1.Application) bool {
+	if app.GetDeletionTimestamp() != nil {
+		return false
+	}
+	expiresAt, err := argo.GetAppExpiration(app)
+	if err != nil || expiresAt == nil {
+		return false
+	}
+	if remaining := time.Until(*expiresAt); remaining > 0 {
+		if remaining > expirationWarningPeriod {
+			remaining -= expirationWarningPeriod
+		}
+		ctrl.requestAppRefresh(app.QualifiedName(), nil, &remaining)
+		return false
+	}
+	if argo.IsGeneratedByApplicationSet(app) {
+		return false
+	}
+
+	logCtx := getAppLog(app)
+	proj, err := ctrl.getAppProj(app)
+	if err != nil {
+		logCtx.Warnf("Failed to get project of expired application: %v", err)
+		return false
+	}
+	if message := ctrl.maintenanceModeMessage(proj); message != "" {
+		logCtx.Infof("Deferring deletion of expired application: %s", message)
+		return false
+	}
+	if !app.CascadedDeletion() {
+		app.SetCascadedDeletion(appv1.ResourcesFinalizerName)
+		if err := ctrl.updateFinalizers(app); err != nil {
+			logCtx.Errorf("Failed to set finalizer of expired application: %v", err)
+			return false
+		}
+	}
+	err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Delete(context.Background(), app.Name, metav1.DeleteOptions{})
+	if err != nil {
+		logCtx.Errorf("Failed to delete expired application: %v", err)
+		return false
+	}
+	message := fmt.Sprintf("Application expired at %s, deleting it together with its resources", expiresAt.UTC().Format(time.RFC3339))
+	logCtx.Info(message)
+	ctrl.logAppEvent(app, argo.EventInfo{Reason: argo.EventReasonResourceDeleted, Type: v1.EventTypeNormal}, message, context.TODO())
+	return true
+}
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestSetExpirationCondition(t *testing.T) {
+	expirationConditions := map[v1alpha1.ApplicationConditionType]bool{v1alpha1.ApplicationConditionExpirationWarning: true}
+	app := newFakeApp()
+	app.CreationTimestamp = metav1.Now()
+	app.Annotations = map[string]string{common.AnnotationKeyTTL: "72h"}
+	setExpirationCondition(app)
+	assert.Empty(t, app.Status.GetConditions(expirationConditions))
+
+	app.Annotations[common.AnnotationKeyTTL] = "30m"
+	setExpirationCondition(app)
+	conditions := app.Status.GetConditions(expirationConditions)
+	require.Len(t, conditions, 1)
+	assert.Contains(t, conditions[0].Message, "will be deleted together with its resources")
+
+	app.Annotations[common.AnnotationKeyTTL] = "three days"
+	setExpirationCondition(app)
+	conditions = app.Status.GetConditions(expirationConditions)
+	require.Len(t, conditions, 1)
+	assert.Contains(t, conditions[0].Message, "invalid")
+
+	delete(app.Annotations, common.AnnotationKeyTTL)
+	setExpirationCondition(app)
+	assert.Empty(t, app.Status.GetConditions(expirationConditions))
+}
+
+func TestDeleteAppIfExpired(t *testing.T) {
+	t.Run("not expired", func(t *testing.T) {
+		app := newFakeApp()
+		app.CreationTimestamp = metav1.Now()
+		app.Annotations = map[string]string{common.AnnotationKeyTTL: "1h"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+
+		assert.False(t, ctrl.deleteAppIfExpired(app))
+		_, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(context.Background(), app.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		app := newFakeApp()
+		app.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+		app.Annotations = map[string]string{common.AnnotationKeyTTL: "1h"}
+		app.Finalizers = nil
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+
+		assert.True(t, ctrl.deleteAppIfExpired(app))
+		assert.True(t, app.CascadedDeletion())
+		_, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(context.Background(), app.Name, metav1.GetOptions{})
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+	t.Run("generated by ApplicationSet", func(t *testing.T) {
+		app := newFakeApp()
+		app.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+		app.Annotations = map[string]string{common.AnnotationKeyTTL: "1h"}
+		app.OwnerReferences = []metav1.OwnerReference{{APIVersion: "argoproj.io/v1alpha1", Kind: "ApplicationSet", Name: "previews", Controller: ptr.To(true)}}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+
+		assert.False(t, ctrl.deleteAppIfExpired(app))
+		_, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(context.Background(), app.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+	})
+
+	t.Run("maintenance mode", func(t *testing.T) {
+		app := newFakeApp()
+		app.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+		app.Annotations = map[string]string{common.AnnotationKeyTTL: "1h"}
+		proj := defaultProj.DeepCopy()
+		proj.Annotations = map[string]string{common.AnnotationKeyMaintenanceMode: "true"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}}, nil)
+
+		assert.False(t, ctrl.deleteAppIfExpired(app))
+		_, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(context.Background(), app.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+// maintenanceModeMessage returns a message explaining why sync operations must not be executed, or an empty string if
+// neither Argo CD nor the given project is in maintenance mode. Refreshes are never affected by maintenance mode.
+func (ctrl *ApplicationController) maintenanceModeMessage(proj *appv1.AppProject) string {
+	enabled, message, err := ctrl.settingsMgr.GetMaintenanceMode()
+	if err != nil {
+		log.Warnf("Failed to get maintenance mode settings: %v", err)
+	} else if enabled {
+		if message == "" {
+			return "Argo CD is in maintenance mode, sync operations are deferred"
+		}
+		return fmt.Sprintf("Argo CD is in maintenance mode, sync operations are deferred: %s", message)
+	}
+	if proj != nil && argo.IsProjectInMaintenanceMode(proj) {
+		return fmt.Sprintf("Project %s is in maintenance mode, sync operations are deferred", proj.Name)
+	}
+	return ""
+}
+
+// setMaintenanceModeCondition sets or clears the maintenance mode warning condition of the application
+func setMaintenanceModeCondition(app *appv1.Application, message string) {
+	var conditions []appv1.ApplicationCondition
+	if message != "" {
+		conditions = append(conditions, appv1.ApplicationCondition{Type: appv1.ApplicationConditionMaintenanceModeWarning, Message: message})
+	}
+	app.Status.SetConditions(conditions, map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionMaintenanceModeWarning: true})
+}
+
+// deferredOperationMessage returns a non-empty message if the requested operation of the application has not started yet
+// and must be deferred because of maintenance mode
+func (ctrl *ApplicationController) deferredOperationMessage(app *appv1.Application) string {
+	if isOperationInProgress(app) {
+		return ""
+	}
+	// project errors are reported by the operation itself, only the global maintenance mode is considered then
+	proj, _ := ctrl.getAppProj(app)
+	return ctrl.maintenanceModeMessage(proj)
+}
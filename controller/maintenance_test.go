@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"testing"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestDeferredOperationMessage(t *testing.T) {
+	newApp := func() *v1alpha1.Application {
+		app := newFakeApp()
+		app.Spec.Project = "default"
+		app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+		app.Status.OperationState = nil
+		return app
+	}
+
+	t.Run("maintenance mode disabled", func(t *testing.T) {
+		app := newApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+		assert.Empty(t, ctrl.deferredOperationMessage(app))
+	})
+
+	t.Run("maintenance mode enabled", func(t *testing.T) {
+		app := newApp()
+		ctrl := newFakeController(&fakeData{
+			apps: []runtime.Object{app, &defaultProj},
+			configMapData: map[string]string{
+				"controller.maintenance.enabled": "true",
+				"controller.maintenance.message": "upgrade in progress",
+			},
+		}, nil)
+		assert.Equal(t, "Argo CD is in maintenance mode, sync operations are deferred: upgrade in progress", ctrl.deferredOperationMessage(app))
+	})
+
+	t.Run("project in maintenance mode", func(t *testing.T) {
+		app := newApp()
+		proj := defaultProj.DeepCopy()
+		proj.Annotations = map[string]string{common.AnnotationKeyMaintenanceMode: "true"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}}, nil)
+		assert.Equal(t, "Project default is in maintenance mode, sync operations are deferred", ctrl.deferredOperationMessage(app))
+	})
+
+	t.Run("operation already in progress", func(t *testing.T) {
+		app := newApp()
+		app.Status.OperationState = &v1alpha1.OperationState{Phase: synccommon.OperationRunning}
+		ctrl := newFakeController(&fakeData{
+			apps:          []runtime.Object{app, &defaultProj},
+			configMapData: map[string]string{"controller.maintenance.enabled": "true"},
+		}, nil)
+		assert.Empty(t, ctrl.deferredOperationMessage(app))
+	})
+}
+
+func TestSetMaintenanceModeCondition(t *testing.T) {
+	app := newFakeApp()
+	setMaintenanceModeCondition(app, "Argo CD is in maintenance mode, sync operations are deferred")
+	assert.Len(t, app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{v1alpha1.ApplicationConditionMaintenanceModeWarning: true}), 1)
+
+	setMaintenanceModeCondition(app, "")
+	assert.Empty(t, app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{v1alpha1.ApplicationConditionMaintenanceModeWarning: true}))
+}
@@ -35,6 +35,8 @@ type MetricsServer struct {
 	kubectlExecPendingGauge *prometheus.GaugeVec
 	k8sRequestCounter       *prometheus.CounterVec
 	clusterEventsCounter    *prometheus.CounterVec
+	clusterProbeCounter     *prometheus.CounterVec
+	clusterProbeHistogram   *prometheus.HistogramVec
 	redisRequestCounter     *prometheus.CounterVec
 	reconcileHistogram      *prometheus.HistogramVec
 	redisRequestHistogram   *prometheus.HistogramVec
@@ -128,6 +130,20 @@ var (
 		Help: "Number of processes k8s resource events.",
 	}, append(descClusterDefaultLabels, "group", "kind"))
 
+	clusterProbeCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_cluster_probe_total",
+		Help: "Number of cluster API server connectivity probes.",
+	}, append(descClusterDefaultLabels, "failed"))
+
+	clusterProbeHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_cluster_probe_duration",
+			Help:    "Cluster API server connectivity probe duration in seconds.",
+			Buckets: []float64{0.05, 0.1, 0.25, .5, 1, 2, 5},
+		},
+		descClusterDefaultLabels,
+	)
+
 	redisRequestCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "argocd_redis_request_total",
@@ -190,6 +206,8 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 	registry.MustRegister(kubectlExecPendingGauge)
 	registry.MustRegister(reconcileHistogram)
 	registry.MustRegister(clusterEventsCounter)
+	registry.MustRegister(clusterProbeCounter)
+	registry.MustRegister(clusterProbeHistogram)
 	registry.MustRegister(redisRequestCounter)
 	registry.MustRegister(redisRequestHistogram)
 
@@ -205,6 +223,8 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 		kubectlExecPendingGauge: kubectlExecPendingGauge,
 		reconcileHistogram:      reconcileHistogram,
 		clusterEventsCounter:    clusterEventsCounter,
+		clusterProbeCounter:     clusterProbeCounter,
+		clusterProbeHistogram:   clusterProbeHistogram,
 		redisRequestCounter:     redisRequestCounter,
 		redisRequestHistogram:   redisRequestHistogram,
 		hostname:                hostname,
@@ -246,6 +266,12 @@ func (m *MetricsServer) IncClusterEventsCount(server, group, kind string) {
 	m.clusterEventsCounter.WithLabelValues(server, group, kind).Inc()
 }
 
+// ObserveClusterProbe records the outcome and duration of a cluster API server connectivity probe
+func (m *MetricsServer) ObserveClusterProbe(server string, duration time.Duration, failed bool) {
+	m.clusterProbeCounter.WithLabelValues(server, strconv.FormatBool(failed)).Inc()
+	m.clusterProbeHistogram.WithLabelValues(server).Observe(duration.Seconds())
+}
+
 // IncKubernetesRequest increments the kubernetes requests counter for an application
 func (m *MetricsServer) IncKubernetesRequest(app *argoappv1.Application, server, statusCode, verb, resourceKind, resourceNamespace string) {
 	var namespace, name, project string
@@ -292,6 +318,8 @@ func (m *MetricsServer) SetExpiration(cacheExpiration time.Duration) error {
 		m.kubectlExecPendingGauge.Reset()
 		m.k8sRequestCounter.Reset()
 		m.clusterEventsCounter.Reset()
+		m.clusterProbeCounter.Reset()
+		m.clusterProbeHistogram.Reset()
 		m.redisRequestCounter.Reset()
 		m.reconcileHistogram.Reset()
 		m.redisRequestHistogram.Reset()
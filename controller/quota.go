@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+// validateSyncProjectQuota returns an error if the given sync operation increases the number of resources managed by
+// the applications of the given project beyond its quota. The resources managed once the sync completed are counted
+// from the compared target and live resources. Syncs which do not increase the number of managed resources, e.g. syncs
+// pruning resources, are allowed even if the project exceeds its quota.
+func (m *appStateManager) validateSyncProjectQuota(app *appv1.Application, proj *appv1.AppProject, syncOp appv1.SyncOperation, resources []managedResource) error {
+	apps, err := m.appLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("error listing applications: %w", err)
+	}
+	current := argo.GetProjectUsage(proj, apps)
+	updated := current
+	updated.Resources += postSyncResourceCount(syncOp, resources) - len(app.Status.Resources)
+	return argo.ValidateProjectQuota(proj, current, updated)
+}
+
+// postSyncResourceCount returns the number of resources managed by an application once the given sync operation
+// completed: target resources which already exist or are synced, and live resources which are not pruned.
+func postSyncResourceCount(syncOp appv1.SyncOperation, resources []managedResource) int {
+	count := 0
+	for _, res := range resources {
+		if res.Hook {
+			continue
+		}
+		selected := len(syncOp.Resources) == 0 ||
+			argo.ContainsSyncResource(res.Name, res.Namespace, schema.GroupVersionKind{Group: res.Group, Kind: res.Kind}, syncOp.Resources)
+		switch {
+		case res.Target != nil:
+			if res.Live != nil || selected {
+				count++
+			}
+		case res.Live != nil:
+			if !selected || !syncOp.Prune {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// projectQuotaExceededMessage returns a message if the given application exceeds the application or cluster quota of
+// its project, e.g. because it was created declaratively rather than through the API server which rejects such
+// applications. The applications of the project are admitted by creation time, so that the oldest applications keep
+// being reconciled and only the ones created after the quota was reached are blocked.
+func (ctrl *ApplicationController) projectQuotaExceededMessage(app *appv1.Application, proj *appv1.AppProject) (string, error) {
+	quota, err := argo.GetProjectQuota(proj)
+	if err != nil {
+		return "", err
+	}
+	if quota.MaxApplications == 0 && quota.MaxClusters == 0 {
+		return "", nil
+	}
+	apps, err := ctrl.appLister.List(labels.Everything())
+	if err != nil {
+		return "", fmt.Errorf("error listing applications: %w", err)
+	}
+	var projectApps []*appv1.Application
+	for _, a := range apps {
+		if a.Spec.GetProject() == proj.Name && a.DeletionTimestamp == nil {
+			projectApps = append(projectApps, a)
+		}
+	}
+	sort.Slice(projectApps, func(i, j int) bool {
+		if !projectApps[i].CreationTimestamp.Equal(&projectApps[j].CreationTimestamp) {
+			return projectApps[i].CreationTimestamp.Before(&projectApps[j].CreationTimestamp)
+		}
+		return projectApps[i].QualifiedName() < projectApps[j].QualifiedName()
+	})
+
+	admitted := 0
+	clusters := make(map[string]bool)
+	for _, a := range projectApps {
+		if quota.MaxApplications > 0 && admitted >= quota.MaxApplications {
+			if a.QualifiedName() == app.QualifiedName() {
+				return fmt.Sprintf("project %s exceeds its quota of %d applications", proj.Name, quota.MaxApplications), nil
+			}
+			continue
+		}
+		cluster := argo.DestinationClusterKey(a.Spec.Destination)
+		if quota.MaxClusters > 0 && cluster != "" && !clusters[cluster] && len(clusters) >= quota.MaxClusters {
+			if a.QualifiedName() == app.QualifiedName() {
+				return fmt.Sprintf("project %s exceeds its quota of %d destination clusters", proj.Name, quota.MaxClusters), nil
+			}
+			continue
+		}
+		admitted++
+		if cluster != "" {
+			clusters[cluster] = true
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestPostSyncResourceCount(t *testing.T) {
+	obj := &unstructured.Unstructured{}
+	resources := []managedResource{
+		// in sync
+		{Kind: "ConfigMap", Name: "synced", Target: obj, Live: obj},
+		// created by the sync
+		{Kind: "ConfigMap", Name: "missing", Target: obj},
+		// pruned by the sync
+		{Kind: "ConfigMap", Name: "extraneous", Live: obj},
+		// hooks are not managed resources
+		{Kind: "Pod", Name: "hook", Target: obj, Hook: true},
+	}
+
+	assert.Equal(t, 3, postSyncResourceCount(appv1.SyncOperation{}, resources))
+	assert.Equal(t, 2, postSyncResourceCount(appv1.SyncOperation{Prune: true}, resources))
+	// partial syncs only create and prune the selected resources
+	partial := appv1.SyncOperation{Prune: true, Resources: []appv1.SyncOperationResource{{Kind: "ConfigMap", Name: "extraneous"}}}
+	assert.Equal(t, 1, postSyncResourceCount(partial, resources))
+}
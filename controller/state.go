@@ -32,6 +32,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/controller/metrics"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
+	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/util/app/path"
 	"github.com/argoproj/argo-cd/v2/util/argo"
@@ -106,6 +107,7 @@ type appStateManager struct {
 	settingsMgr           *settings.SettingsManager
 	appclientset          appclientset.Interface
 	projInformer          cache.SharedIndexInformer
+	appLister             applisters.ApplicationLister
 	kubectl               kubeutil.Kubectl
 	repoClientset         apiclient.Clientset
 	liveStateCache        statecache.LiveStateCache
@@ -149,6 +151,7 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 	if err != nil {
 		return nil, nil, false, fmt.Errorf("failed to get enabled source types: %w", err)
 	}
+	enabledSourceTypes = argo.GetProjectEnabledSourceTypes(proj, enabledSourceTypes)
 	ts.AddCheckpoint("plugins_ms")
 
 	kustomizeSettings, err := m.settingsMgr.GetKustomizeSettings()
@@ -179,6 +182,8 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 
 	manifestInfos := make([]*apiclient.ManifestResponse, 0)
 	targetObjs := make([]*unstructured.Unstructured, 0)
+	var manifestsCount int
+	var manifestsSize int64
 
 	// Store the map of all sources having ref field into a map for applications with sources field
 	// If it's for a rollback process, the refSources[*].targetRevision fields are the desired
@@ -283,6 +288,15 @@ func (m *appStateManager) GetRepoObjs(app *v1alpha1.Application, sources []v1alp
 			return nil, nil, false, fmt.Errorf("failed to generate manifest for source %d of %d: %w", i+1, len(sources), err)
 		}
 
+		// check the quota before unmarshaling the manifests, so that huge manifests are rejected as early as possible
+		manifestsCount += len(manifestInfo.Manifests)
+		for _, manifest := range manifestInfo.Manifests {
+			manifestsSize += int64(len(manifest))
+		}
+		if err := argo.ValidateApplicationManifestsQuota(proj, manifestsCount, manifestsSize); err != nil {
+			return nil, nil, false, err
+		}
+
 		targetObj, err := unmarshalManifests(manifestInfo.Manifests)
 		if err != nil {
 			return nil, nil, false, fmt.Errorf("failed to unmarshal manifests for source %d of %d: %w", i+1, len(sources), err)
@@ -562,6 +576,13 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	}
 	ts.AddCheckpoint("dedup_ms")
 
+	_, dependencyConditions := resolveResourceDependencies(targetObjs)
+	for _, condition := range dependencyConditions {
+		condition.LastTransitionTime = &now
+		conditions = append(conditions, condition)
+	}
+	ts.AddCheckpoint("resource_dependencies_ms")
+
 	liveObjByKey, err := m.liveStateCache.GetManagedLiveObjs(app, targetObjs)
 	if err != nil {
 		liveObjByKey = make(map[kubeutil.ResourceKey]*unstructured.Unstructured)
@@ -893,10 +914,11 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	}
 
 	app.Status.SetConditions(conditions, map[v1alpha1.ApplicationConditionType]bool{
-		v1alpha1.ApplicationConditionComparisonError:         true,
-		v1alpha1.ApplicationConditionSharedResourceWarning:   true,
-		v1alpha1.ApplicationConditionRepeatedResourceWarning: true,
-		v1alpha1.ApplicationConditionExcludedResourceWarning: true,
+		v1alpha1.ApplicationConditionComparisonError:           true,
+		v1alpha1.ApplicationConditionSharedResourceWarning:     true,
+		v1alpha1.ApplicationConditionRepeatedResourceWarning:   true,
+		v1alpha1.ApplicationConditionExcludedResourceWarning:   true,
+		v1alpha1.ApplicationConditionResourceDependencyWarning: true,
 	})
 	ts.AddCheckpoint("health_ms")
 	compRes.timings = ts.Timings()
@@ -1023,6 +1045,7 @@ func NewAppStateManager(
 	settingsMgr *settings.SettingsManager,
 	liveStateCache statecache.LiveStateCache,
 	projInformer cache.SharedIndexInformer,
+	appLister applisters.ApplicationLister,
 	metricsServer *metrics.MetricsServer,
 	cache *appstatecache.Cache,
 	statusRefreshTimeout time.Duration,
@@ -1042,6 +1065,7 @@ func NewAppStateManager(
 		namespace:             namespace,
 		settingsMgr:           settingsMgr,
 		projInformer:          projInformer,
+		appLister:             appLister,
 		metricsServer:         metricsServer,
 		statusRefreshTimeout:  statusRefreshTimeout,
 		resourceTracking:      resourceTracking,
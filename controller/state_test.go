@@ -317,6 +317,34 @@ func TestCompareAppStateMissing(t *testing.T) {
 	assert.Empty(t, app.Status.Conditions)
 }
 
+// TestGetRepoObjsExceedingManifestsQuota tests that manifests exceeding the per-application quota of the project are rejected
+func TestGetRepoObjsExceedingManifestsQuota(t *testing.T) {
+	app := newFakeApp()
+	data := fakeData{
+		apps: []runtime.Object{app},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{PodManifest, PodManifest},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data, nil)
+	proj := defaultProj.DeepCopy()
+	proj.Annotations = map[string]string{common.AnnotationKeyQuotaMaxApplicationManifests: "1"}
+
+	_, _, _, err := ctrl.appStateManager.GetRepoObjs(app, []argoappv1.ApplicationSource{app.Spec.GetSource()}, "app-label", []string{""}, false, false, false, proj, false)
+	require.EqualError(t, err, "generated manifests exceed the quota of project default of 1 manifests per application: 2 manifests")
+
+	// the quota is checked before the manifests are unmarshaled
+	data.manifestResponse.Manifests = []string{"{invalid", "{invalid"}
+	ctrl = newFakeController(&data, nil)
+	proj.Annotations = map[string]string{common.AnnotationKeyQuotaMaxApplicationManifestsSize: "10"}
+	_, _, _, err = ctrl.appStateManager.GetRepoObjs(app, []argoappv1.ApplicationSource{app.Spec.GetSource()}, "app-label", []string{""}, false, false, false, proj, false)
+	require.EqualError(t, err, "generated manifests exceed the quota of project default of 10 per application: 16")
+}
+
 // TestCompareAppStateExtra tests when there is an extra object in live but not defined in git
 func TestCompareAppStateExtra(t *testing.T) {
 	pod := NewPod()
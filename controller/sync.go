@@ -32,6 +32,7 @@ import (
 	listersv1alpha1 "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/argo/diff"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v2/util/glob"
 	logutils "github.com/argoproj/argo-cd/v2/util/log"
 	"github.com/argoproj/argo-cd/v2/util/lua"
@@ -144,6 +145,8 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		}
 	}
 
+	// the quota is only enforced when an operation starts, running operations are allowed to complete
+	started := state.SyncResult == nil
 	if state.SyncResult != nil {
 		syncRes = state.SyncResult
 		revision = state.SyncResult.Revision
@@ -221,6 +224,14 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		return
 	}
 
+	if started && !syncOp.DryRun {
+		if err := m.validateSyncProjectQuota(app, proj, syncOp, compareResult.managedResources); err != nil {
+			state.Phase = common.OperationFailed
+			state.Message = err.Error()
+			return
+		}
+	}
+
 	clst, err := m.db.GetCluster(context.Background(), app.Spec.Destination.Server)
 	if err != nil {
 		state.Phase = common.OperationError
@@ -307,6 +318,14 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		reconciliationResult.Target = patchedTargets
 	}
 
+	// the waves computed from the dependencies of resources only order the sync tasks, the declared waves are applied
+	var declaredWaves map[kube.ResourceKey]string
+	reconciliationResult.Target, declaredWaves = applyResourceDependencyWaves(reconciliationResult.Target)
+	kubectl := m.kubectl
+	if len(declaredWaves) > 0 {
+		kubectl = &dependencyWaveKubectl{Kubectl: m.kubectl, declaredWaves: declaredWaves}
+	}
+
 	appLabelKey, err := m.settingsMgr.GetAppInstanceLabelKey()
 	if err != nil {
 		log.Errorf("Could not get appInstanceLabelKey: %v", err)
@@ -389,7 +408,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		reconciliationResult,
 		restConfig,
 		rawConfig,
-		m.kubectl,
+		kubectl,
 		app.Spec.Destination.Namespace,
 		openAPISchema,
 		opts...,
@@ -451,6 +470,16 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
+	if !syncOp.DryRun {
+		appliedRevision := compareResult.syncStatus.Revision
+		if isMultiSourceRevision {
+			appliedRevision = strings.Join(compareResult.syncStatus.Revisions, ",")
+		}
+		if err := m.persistResourcesRevisions(app, appliedRevision, compareResult.managedResources, resState); err != nil {
+			logEntry.Warnf("Failed to persist revisions of synced resources: %v", err)
+		}
+	}
+
 	if !syncOp.DryRun && len(syncOp.Resources) == 0 && state.Phase.Successful() {
 		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, isMultiSourceRevision, state.StartedAt, state.Operation.InitiatedBy)
 		if err != nil {
@@ -460,6 +489,75 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	}
 }
 
+// persistResourcesRevisions remembers the revision which last changed each resource of the application, so the resource
+// tree can tell which revision introduced the current state of a resource. A synced resource is changed by the sync if
+// it was missing or differed from its target state before the sync, other synced resources keep their previous
+// revision and pruned resources are forgotten. The revisions are stored in the cache until the next refresh of the
+// application persists them in its status, see getLastAppliedRevisions.
+func (m *appStateManager) persistResourcesRevisions(app *v1alpha1.Application, revision string, resources []managedResource, results []common.ResourceSyncResult) error {
+	if revision == "" {
+		return nil
+	}
+	changed := make(map[kube.ResourceKey]bool)
+	for _, res := range resources {
+		if !res.Hook && res.Target != nil && (res.Live == nil || res.Diff.Modified) {
+			changed[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = true
+		}
+	}
+	appName := app.InstanceName(m.namespace)
+	revisions, err := getLastAppliedRevisions(m.cache, appName, app.Status.Resources)
+	if err != nil {
+		return err
+	}
+	updated := false
+	for _, res := range results {
+		if res.HookType != "" {
+			continue
+		}
+		key := res.ResourceKey.String()
+		switch {
+		case res.Status == common.ResultCodePruned:
+			if _, ok := revisions[key]; ok {
+				delete(revisions, key)
+				updated = true
+			}
+		case res.Status == common.ResultCodeSynced && changed[res.ResourceKey]:
+			if revisions[key] != revision {
+				revisions[key] = revision
+				updated = true
+			}
+		}
+	}
+	if !updated {
+		return nil
+	}
+	return m.cache.SetAppResourcesRevisions(appName, revisions)
+}
+
+// getLastAppliedRevisions returns the revisions which last changed the given resources of an application, keyed by
+// resource key. The revisions are persisted in the resources of the application status, and overridden by the cached
+// revisions of syncs which completed since the status was last refreshed.
+func getLastAppliedRevisions(cache *appstatecache.Cache, appName string, resources []v1alpha1.ResourceStatus) (map[string]string, error) {
+	revisions := make(map[string]string)
+	for _, res := range resources {
+		if res.LastAppliedRevision != "" {
+			key := kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)
+			revisions[key.String()] = res.LastAppliedRevision
+		}
+	}
+	cached := make(map[string]string)
+	if err := cache.GetAppResourcesRevisions(appName, &cached); err != nil {
+		if goerrors.Is(err, appstatecache.ErrCacheMiss) {
+			return revisions, nil
+		}
+		return revisions, fmt.Errorf("error getting resources revisions: %w", err)
+	}
+	for key, revision := range cached {
+		revisions[key] = revision
+	}
+	return revisions, nil
+}
+
 // normalizeTargetResources modifies target resources to ensure ignored fields are not touched during synchronization:
 //   - applies normalization to the target resources based on the live resources
 //   - copies ignored fields from the matching live resources: apply normalizer to the live resource,
@@ -0,0 +1,215 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/openapi"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// parseDependencyRef parses a single entry of the depends-on annotation. The namespace defaults to the namespace of
+// the dependent resource.
+func parseDependencyRef(ref string, namespace string) (kube.ResourceKey, error) {
+	parts := strings.Split(ref, "/")
+	switch len(parts) {
+	case 2:
+		return kube.NewResourceKey("", parts[0], namespace, parts[1]), nil
+	case 3:
+		return kube.NewResourceKey(parts[0], parts[1], namespace, parts[2]), nil
+	case 4:
+		return kube.NewResourceKey(parts[0], parts[1], parts[2], parts[3]), nil
+	}
+	return kube.ResourceKey{}, fmt.Errorf("invalid dependency %q, expected <kind>/<name>, <group>/<kind>/<name> or <group>/<kind>/<namespace>/<name>", ref)
+}
+
+// resolveResourceDependencies translates the dependencies declared with the depends-on annotation into sync waves: a
+// resource is moved into a later wave than all the resources it depends on. Since the sync engine only starts a wave
+// once all resources of previous waves are healthy, a resource is not applied before its dependencies are healthy.
+// Returns the waves of the resources whose wave is changed by their dependencies, and warnings about invalid
+// dependencies. Hooks are ignored since they are ordered by their sync phase. The given objects are not modified.
+func resolveResourceDependencies(targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]int, []v1alpha1.ApplicationCondition) {
+	var messages []string
+	objByKey := make(map[kube.ResourceKey]*unstructured.Unstructured)
+	for _, obj := range targetObjs {
+		if obj == nil || hook.IsHook(obj) {
+			continue
+		}
+		objByKey[kube.GetResourceKey(obj)] = obj
+	}
+
+	dependencies := make(map[kube.ResourceKey][]kube.ResourceKey)
+	for key, obj := range objByKey {
+		refs := obj.GetAnnotations()[common.AnnotationKeyDependsOn]
+		if refs == "" {
+			continue
+		}
+		for _, ref := range strings.Split(refs, ",") {
+			depKey, err := parseDependencyRef(strings.TrimSpace(ref), key.Namespace)
+			if err != nil {
+				messages = append(messages, fmt.Sprintf("Resource %s has an %v", key.String(), err))
+				continue
+			}
+			if _, ok := objByKey[depKey]; !ok {
+				// cluster-scoped resources do not have a namespace
+				clusterScopedKey := kube.NewResourceKey(depKey.Group, depKey.Kind, "", depKey.Name)
+				if _, ok := objByKey[clusterScopedKey]; !ok {
+					messages = append(messages, fmt.Sprintf("Resource %s depends on %s which is not part of the application", key.String(), depKey.String()))
+					continue
+				}
+				depKey = clusterScopedKey
+			}
+			dependencies[key] = append(dependencies[key], depKey)
+		}
+	}
+
+	waves := make(map[kube.ResourceKey]int)
+	visiting := make(map[kube.ResourceKey]bool)
+	var resolveWave func(key kube.ResourceKey) (int, error)
+	resolveWave = func(key kube.ResourceKey) (int, error) {
+		if wave, ok := waves[key]; ok {
+			return wave, nil
+		}
+		if visiting[key] {
+			return 0, fmt.Errorf("dependency cycle detected at %s", key.String())
+		}
+		visiting[key] = true
+		defer delete(visiting, key)
+		wave := syncwaves.Wave(objByKey[key])
+		for _, depKey := range dependencies[key] {
+			depWave, err := resolveWave(depKey)
+			if err != nil {
+				return 0, err
+			}
+			if depWave+1 > wave {
+				wave = depWave + 1
+			}
+		}
+		waves[key] = wave
+		return wave, nil
+	}
+
+	keys := make([]kube.ResourceKey, 0, len(dependencies))
+	for key := range dependencies {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	effectiveWaves := make(map[kube.ResourceKey]int)
+	for _, key := range keys {
+		wave, err := resolveWave(key)
+		if err != nil {
+			messages = append(messages, fmt.Sprintf("Resource %s dependencies are ignored: %v", key.String(), err))
+			continue
+		}
+		effectiveWaves[key] = wave
+	}
+	for key, wave := range effectiveWaves {
+		if wave == syncwaves.Wave(objByKey[key]) {
+			delete(effectiveWaves, key)
+		}
+	}
+
+	sort.Strings(messages)
+	conditions := make([]v1alpha1.ApplicationCondition, 0, len(messages))
+	for _, message := range messages {
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionResourceDependencyWarning, Message: message})
+	}
+	return effectiveWaves, conditions
+}
+
+// applyResourceDependencyWaves returns the target objects in which the objects whose wave is changed by their
+// dependencies are replaced by copies annotated with the computed wave, so that the sync tasks are ordered by their
+// dependencies. Also returns the declared waves of the replaced objects, which must be restored before they are applied.
+func applyResourceDependencyWaves(targetObjs []*unstructured.Unstructured) ([]*unstructured.Unstructured, map[kube.ResourceKey]string) {
+	waves, _ := resolveResourceDependencies(targetObjs)
+	if len(waves) == 0 {
+		return targetObjs, nil
+	}
+	declaredWaves := make(map[kube.ResourceKey]string, len(waves))
+	result := make([]*unstructured.Unstructured, len(targetObjs))
+	for i, obj := range targetObjs {
+		result[i] = obj
+		if obj == nil {
+			continue
+		}
+		key := kube.GetResourceKey(obj)
+		wave, ok := waves[key]
+		if !ok {
+			continue
+		}
+		obj = obj.DeepCopy()
+		annotations := obj.GetAnnotations()
+		declaredWaves[key] = annotations[synccommon.AnnotationSyncWave]
+		annotations[synccommon.AnnotationSyncWave] = strconv.Itoa(wave)
+		obj.SetAnnotations(annotations)
+		result[i] = obj
+	}
+	return result, declaredWaves
+}
+
+// dependencyWaveKubectl applies objects with their declared sync waves, so that the waves computed from dependencies
+// only order the sync tasks and never end up in the live objects
+type dependencyWaveKubectl struct {
+	kube.Kubectl
+	declaredWaves map[kube.ResourceKey]string
+}
+
+func (k *dependencyWaveKubectl) ManageResources(config *rest.Config, openAPISchema openapi.Resources) (kube.ResourceOperations, func(), error) {
+	ops, cleanup, err := k.Kubectl.ManageResources(config, openAPISchema)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &dependencyWaveResourceOperations{ResourceOperations: ops, declaredWaves: k.declaredWaves}, cleanup, nil
+}
+
+type dependencyWaveResourceOperations struct {
+	kube.ResourceOperations
+	declaredWaves map[kube.ResourceKey]string
+}
+
+// withDeclaredWave returns a copy of the object with its declared sync wave if the wave was computed from dependencies
+func (o *dependencyWaveResourceOperations) withDeclaredWave(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	declared, ok := o.declaredWaves[kube.GetResourceKey(obj)]
+	if !ok {
+		return obj
+	}
+	obj = obj.DeepCopy()
+	annotations := obj.GetAnnotations()
+	if declared == "" {
+		delete(annotations, synccommon.AnnotationSyncWave)
+	} else {
+		annotations[synccommon.AnnotationSyncWave] = declared
+	}
+	obj.SetAnnotations(annotations)
+	return obj
+}
+
+func (o *dependencyWaveResourceOperations) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force, validate, serverSideApply bool, manager string, serverSideDiff bool) (string, error) {
+	return o.ResourceOperations.ApplyResource(ctx, o.withDeclaredWave(obj), dryRunStrategy, force, validate, serverSideApply, manager, serverSideDiff)
+}
+
+func (o *dependencyWaveResourceOperations) ReplaceResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force bool) (string, error) {
+	return o.ResourceOperations.ReplaceResource(ctx, o.withDeclaredWave(obj), dryRunStrategy, force)
+}
+
+func (o *dependencyWaveResourceOperations) CreateResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, validate bool) (string, error) {
+	return o.ResourceOperations.CreateResource(ctx, o.withDeclaredWave(obj), dryRunStrategy, validate)
+}
+
+func (o *dependencyWaveResourceOperations) UpdateResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy) (*unstructured.Unstructured, error) {
+	return o.ResourceOperations.UpdateResource(ctx, o.withDeclaredWave(obj), dryRunStrategy)
+}
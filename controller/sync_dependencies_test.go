@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	. "github.com/argoproj/gitops-engine/pkg/utils/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func newDependencyTestObj(apiVersion, kind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func TestParseDependencyRef(t *testing.T) {
+	key, err := parseDependencyRef("Service/db", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "/Service/default/db", key.String())
+
+	key, err = parseDependencyRef("batch/Job/migrate", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "batch/Job/default/migrate", key.String())
+
+	key, err = parseDependencyRef("apps/Deployment/other/db", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "apps/Deployment/other/db", key.String())
+
+	_, err = parseDependencyRef("db", "default")
+	require.Error(t, err)
+}
+
+func TestResolveResourceDependencies(t *testing.T) {
+	t.Run("dependent is moved after its dependencies", func(t *testing.T) {
+		ns := newDependencyTestObj("v1", "Namespace", "", "app")
+		migration := Annotate(newDependencyTestObj("batch/v1", "Job", "app", "migrate"), common.AnnotationKeyDependsOn, "Namespace/app")
+		migration = Annotate(migration, synccommon.AnnotationSyncWave, "2")
+		deployment := Annotate(newDependencyTestObj("apps/v1", "Deployment", "app", "api"), common.AnnotationKeyDependsOn, "batch/Job/migrate")
+		service := newDependencyTestObj("v1", "Service", "app", "api")
+
+		waves, conditions := resolveResourceDependencies([]*unstructured.Unstructured{service, deployment, migration, ns})
+
+		assert.Empty(t, conditions)
+		assert.Equal(t, map[kube.ResourceKey]int{kube.GetResourceKey(deployment): 3}, waves)
+		// the objects are not modified
+		assert.NotContains(t, deployment.GetAnnotations(), synccommon.AnnotationSyncWave)
+	})
+
+	t.Run("declared wave is kept if it is already later", func(t *testing.T) {
+		cm := newDependencyTestObj("v1", "ConfigMap", "default", "config")
+		pod := Annotate(Annotate(NewPod(), common.AnnotationKeyDependsOn, "ConfigMap/config"), synccommon.AnnotationSyncWave, "5")
+		pod.SetNamespace("default")
+
+		waves, conditions := resolveResourceDependencies([]*unstructured.Unstructured{cm, pod})
+
+		assert.Empty(t, conditions)
+		assert.Empty(t, waves)
+	})
+
+	t.Run("missing dependency", func(t *testing.T) {
+		pod := Annotate(NewPod(), common.AnnotationKeyDependsOn, "ConfigMap/missing")
+		pod.SetNamespace("default")
+
+		waves, conditions := resolveResourceDependencies([]*unstructured.Unstructured{pod})
+
+		require.Len(t, conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionResourceDependencyWarning, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "/ConfigMap/default/missing which is not part of the application")
+		assert.Empty(t, waves)
+	})
+
+	t.Run("dependency cycle", func(t *testing.T) {
+		a := Annotate(newDependencyTestObj("v1", "ConfigMap", "default", "a"), common.AnnotationKeyDependsOn, "ConfigMap/b")
+		b := Annotate(newDependencyTestObj("v1", "ConfigMap", "default", "b"), common.AnnotationKeyDependsOn, "ConfigMap/a")
+
+		waves, conditions := resolveResourceDependencies([]*unstructured.Unstructured{a, b})
+
+		require.Len(t, conditions, 2)
+		assert.Contains(t, conditions[0].Message, "dependency cycle detected")
+		assert.Empty(t, waves)
+	})
+}
+
+type fakeResourceOperations struct {
+	kube.ResourceOperations
+	applied []*unstructured.Unstructured
+}
+
+func (o *fakeResourceOperations) ApplyResource(_ context.Context, obj *unstructured.Unstructured, _ cmdutil.DryRunStrategy, _, _, _ bool, _ string, _ bool) (string, error) {
+	o.applied = append(o.applied, obj)
+	return "", nil
+}
+
+func TestApplyResourceDependencyWaves(t *testing.T) {
+	cm := Annotate(newDependencyTestObj("v1", "ConfigMap", "default", "config"), synccommon.AnnotationSyncWave, "1")
+	pod := Annotate(NewPod(), common.AnnotationKeyDependsOn, "ConfigMap/config")
+	pod.SetNamespace("default")
+	targets := []*unstructured.Unstructured{cm, nil, pod}
+
+	ordered, declaredWaves := applyResourceDependencyWaves(targets)
+
+	require.Len(t, ordered, 3)
+	assert.Same(t, cm, ordered[0])
+	assert.Nil(t, ordered[1])
+	assert.Equal(t, 2, syncwaves.Wave(ordered[2]))
+	assert.NotContains(t, pod.GetAnnotations(), synccommon.AnnotationSyncWave, "the target object must not be modified")
+	assert.Equal(t, map[kube.ResourceKey]string{kube.GetResourceKey(pod): ""}, declaredWaves)
+
+	// the computed wave is not applied
+	resourceOps := &fakeResourceOperations{}
+	ops := &dependencyWaveResourceOperations{ResourceOperations: resourceOps, declaredWaves: declaredWaves}
+	_, err := ops.ApplyResource(context.Background(), ordered[2], cmdutil.DryRunNone, false, false, false, "", false)
+	require.NoError(t, err)
+	_, err = ops.ApplyResource(context.Background(), ordered[0], cmdutil.DryRunNone, false, false, false, "", false)
+	require.NoError(t, err)
+	require.Len(t, resourceOps.applied, 2)
+	assert.Equal(t, pod, resourceOps.applied[0])
+	assert.Equal(t, 2, syncwaves.Wave(ordered[2]), "the ordered object must not be modified")
+	assert.Same(t, cm, resourceOps.applied[1])
+
+	t.Run("no dependencies", func(t *testing.T) {
+		ordered, declaredWaves := applyResourceDependencyWaves([]*unstructured.Unstructured{cm})
+		assert.Equal(t, []*unstructured.Unstructured{cm}, ordered)
+		assert.Empty(t, declaredWaves)
+	})
+}
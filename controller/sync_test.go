@@ -61,6 +61,82 @@ func TestPersistRevisionHistory(t *testing.T) {
 	assert.Equal(t, "abc123", updatedApp.Status.History[0].Revision)
 }
 
+func TestPersistResourcesRevisions(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+	manager := ctrl.appStateManager.(*appStateManager)
+
+	deployKey := kube.NewResourceKey("apps", "Deployment", test.FakeDestNamespace, "guestbook")
+	svcKey := kube.NewResourceKey("", "Service", test.FakeDestNamespace, "guestbook")
+	cmKey := kube.NewResourceKey("", "ConfigMap", test.FakeDestNamespace, "guestbook")
+	newManagedResource := func(key kube.ResourceKey, live bool, modified bool) managedResource {
+		res := managedResource{Group: key.Group, Kind: key.Kind, Namespace: key.Namespace, Name: key.Name, Target: &unstructured.Unstructured{}}
+		if live {
+			res.Live = &unstructured.Unstructured{}
+		}
+		res.Diff.Modified = modified
+		return res
+	}
+	err := manager.persistResourcesRevisions(app, "abc123", []managedResource{
+		newManagedResource(deployKey, false, false),
+		newManagedResource(svcKey, false, false),
+		newManagedResource(cmKey, false, false),
+	}, []common.ResourceSyncResult{
+		{ResourceKey: deployKey, Status: common.ResultCodeSynced, Message: "deployment.apps/guestbook created"},
+		{ResourceKey: svcKey, Status: common.ResultCodeSynced, Message: "service/guestbook created"},
+		{ResourceKey: cmKey, Status: common.ResultCodeSynced, Message: "configmap/guestbook created"},
+		{ResourceKey: kube.NewResourceKey("batch", "Job", test.FakeDestNamespace, "hook"), Status: common.ResultCodeSynced, HookType: common.HookTypePreSync},
+	})
+	require.NoError(t, err)
+
+	err = manager.persistResourcesRevisions(app, "def456", []managedResource{
+		newManagedResource(deployKey, true, true),
+		newManagedResource(svcKey, true, false),
+		{Group: cmKey.Group, Kind: cmKey.Kind, Namespace: cmKey.Namespace, Name: cmKey.Name, Live: &unstructured.Unstructured{}},
+	}, []common.ResourceSyncResult{
+		{ResourceKey: deployKey, Status: common.ResultCodeSynced, Message: "deployment.apps/guestbook configured"},
+		{ResourceKey: svcKey, Status: common.ResultCodeSynced, Message: "service/guestbook configured"},
+		{ResourceKey: cmKey, Status: common.ResultCodePruned, Message: "pruned"},
+	})
+	require.NoError(t, err)
+
+	revisions := map[string]string{}
+	require.NoError(t, ctrl.cache.GetAppResourcesRevisions(app.InstanceName(ctrl.namespace), &revisions))
+	assert.Equal(t, map[string]string{
+		deployKey.String(): "def456",
+		svcKey.String():    "abc123",
+	}, revisions)
+}
+
+func TestGetLastAppliedRevisions(t *testing.T) {
+	app := newFakeApp()
+	deployKey := kube.NewResourceKey("apps", "Deployment", test.FakeDestNamespace, "guestbook")
+	svcKey := kube.NewResourceKey("", "Service", test.FakeDestNamespace, "guestbook")
+	app.Status.Resources = []v1alpha1.ResourceStatus{
+		{Group: deployKey.Group, Kind: deployKey.Kind, Namespace: deployKey.Namespace, Name: deployKey.Name, LastAppliedRevision: "abc123"},
+		{Group: svcKey.Group, Kind: svcKey.Kind, Namespace: svcKey.Namespace, Name: svcKey.Name, LastAppliedRevision: "abc123"},
+		{Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "guestbook"},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+	manager := ctrl.appStateManager.(*appStateManager)
+
+	// the revisions persisted in the status are used if the cache is empty, e.g. after Redis was lost
+	revisions, err := getLastAppliedRevisions(ctrl.cache, app.InstanceName(ctrl.namespace), app.Status.Resources)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{deployKey.String(): "abc123", svcKey.String(): "abc123"}, revisions)
+
+	// the revisions of syncs which completed since the status was refreshed override the persisted ones
+	deploy := managedResource{Group: deployKey.Group, Kind: deployKey.Kind, Namespace: deployKey.Namespace, Name: deployKey.Name, Target: &unstructured.Unstructured{}, Live: &unstructured.Unstructured{}}
+	deploy.Diff.Modified = true
+	err = manager.persistResourcesRevisions(app, "def456", []managedResource{deploy}, []common.ResourceSyncResult{
+		{ResourceKey: deployKey, Status: common.ResultCodeSynced},
+	})
+	require.NoError(t, err)
+	revisions, err = getLastAppliedRevisions(ctrl.cache, app.InstanceName(ctrl.namespace), app.Status.Resources)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{deployKey.String(): "def456", svcKey.String(): "abc123"}, revisions)
+}
+
 func TestPersistManagedNamespaceMetadataState(t *testing.T) {
 	app := newFakeApp()
 	app.Status.OperationState = nil
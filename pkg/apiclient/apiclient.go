@@ -456,7 +456,12 @@ func (c *client) redeemRefreshToken() (string, string, error) {
 	if !ok {
 		return "", "", errors.New("no id_token in token response")
 	}
-	refreshToken, _ := token.Extra("refresh_token").(string)
+	// Providers are not required to rotate refresh tokens. The token source keeps the refresh token we sent
+	// if the response didn't contain a new one, so the stored refresh token is never dropped.
+	refreshToken := token.RefreshToken
+	if refreshToken == "" {
+		refreshToken = c.RefreshToken
+	}
 	return rawIDToken, refreshToken, nil
 }
 
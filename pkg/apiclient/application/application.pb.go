@@ -2477,6 +2477,10 @@ type ResourcesQuery struct {
 	Kind                 *string  `protobuf:"bytes,6,opt,name=kind" json:"kind,omitempty"`
 	AppNamespace         *string  `protobuf:"bytes,7,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	Project              *string  `protobuf:"bytes,8,opt,name=project" json:"project,omitempty"`
+	ResourceName         *string  `protobuf:"bytes,9,opt,name=resourceName" json:"resourceName,omitempty"`
+	Health               *string  `protobuf:"bytes,10,opt,name=health" json:"health,omitempty"`
+	Search               *string  `protobuf:"bytes,11,opt,name=search" json:"search,omitempty"`
+	Incremental          *bool    `protobuf:"varint,12,opt,name=incremental" json:"incremental,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2571,6 +2575,34 @@ func (m *ResourcesQuery) GetProject() string {
 	return ""
 }
 
+func (m *ResourcesQuery) GetResourceName() string {
+	if m != nil && m.ResourceName != nil {
+		return *m.ResourceName
+	}
+	return ""
+}
+
+func (m *ResourcesQuery) GetHealth() string {
+	if m != nil && m.Health != nil {
+		return *m.Health
+	}
+	return ""
+}
+
+func (m *ResourcesQuery) GetSearch() string {
+	if m != nil && m.Search != nil {
+		return *m.Search
+	}
+	return ""
+}
+
+func (m *ResourcesQuery) GetIncremental() bool {
+	if m != nil && m.Incremental != nil {
+		return *m.Incremental
+	}
+	return false
+}
+
 type ManagedResourcesResponse struct {
 	Items                []*v1alpha1.ResourceDiff `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
@@ -2842,179 +2874,181 @@ func init() {
 }
 
 var fileDescriptor_df6e82b174b5eaec = []byte{
-	// 2742 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x8c, 0x1b, 0x49,
-	0x15, 0xa6, 0xec, 0xf1, 0x8c, 0xe7, 0x79, 0x26, 0x93, 0xd4, 0x26, 0x83, 0xd7, 0x99, 0x0d, 0xde,
-	0x4e, 0xb2, 0x71, 0x26, 0x19, 0x3b, 0x31, 0x01, 0x65, 0x67, 0x77, 0x05, 0xc9, 0xe4, 0x17, 0x26,
-	0xd9, 0xd0, 0x93, 0x10, 0xb4, 0x1c, 0xa0, 0xb6, 0xbb, 0xc6, 0xd3, 0x4c, 0xbb, 0xbb, 0xd3, 0xdd,
-	0x76, 0x18, 0x85, 0x5c, 0x16, 0xed, 0x05, 0xad, 0x40, 0xc0, 0x1e, 0x10, 0x42, 0x80, 0x16, 0xad,
-	0x84, 0x10, 0x88, 0x0b, 0x42, 0x48, 0x08, 0x09, 0x0e, 0x20, 0x38, 0x20, 0xad, 0xe0, 0xc8, 0x05,
-	0x45, 0x88, 0x23, 0x5c, 0xf6, 0x8c, 0x50, 0x55, 0x57, 0x75, 0x57, 0xfb, 0xa7, 0xed, 0xc1, 0x46,
-	0x9b, 0x5b, 0xbf, 0x72, 0xd5, 0x7b, 0xdf, 0x7b, 0xf5, 0xea, 0xbd, 0x57, 0xaf, 0x0c, 0x27, 0x02,
-	0xea, 0x77, 0xa9, 0xdf, 0x20, 0x9e, 0x67, 0x5b, 0x06, 0x09, 0x2d, 0xd7, 0x51, 0xbf, 0xeb, 0x9e,
-	0xef, 0x86, 0x2e, 0x2e, 0x29, 0x43, 0x95, 0x95, 0x96, 0xeb, 0xb6, 0x6c, 0xda, 0x20, 0x9e, 0xd5,
-	0x20, 0x8e, 0xe3, 0x86, 0x7c, 0x38, 0x88, 0xa6, 0x56, 0xb4, 0xdd, 0x8b, 0x41, 0xdd, 0x72, 0xf9,
-	0xaf, 0x86, 0xeb, 0xd3, 0x46, 0xf7, 0x7c, 0xa3, 0x45, 0x1d, 0xea, 0x93, 0x90, 0x9a, 0x62, 0xce,
-	0x85, 0x64, 0x4e, 0x9b, 0x18, 0x3b, 0x96, 0x43, 0xfd, 0xbd, 0x86, 0xb7, 0xdb, 0x62, 0x03, 0x41,
-	0xa3, 0x4d, 0x43, 0x32, 0x68, 0xd5, 0x66, 0xcb, 0x0a, 0x77, 0x3a, 0xaf, 0xd7, 0x0d, 0xb7, 0xdd,
-	0x20, 0x7e, 0xcb, 0xf5, 0x7c, 0xf7, 0x4b, 0xfc, 0x63, 0xcd, 0x30, 0x1b, 0xdd, 0x66, 0xc2, 0x40,
-	0xd5, 0xa5, 0x7b, 0x9e, 0xd8, 0xde, 0x0e, 0xe9, 0xe7, 0x76, 0x75, 0x04, 0x37, 0x9f, 0x7a, 0xae,
-	0xb0, 0x0d, 0xff, 0xb4, 0x42, 0xd7, 0xdf, 0x53, 0x3e, 0x23, 0x36, 0xda, 0xfb, 0x08, 0x0e, 0x5e,
-	0x4a, 0xe4, 0x7d, 0xa6, 0x43, 0xfd, 0x3d, 0x8c, 0x61, 0xc6, 0x21, 0x6d, 0x5a, 0x46, 0x55, 0x54,
-	0x9b, 0xd7, 0xf9, 0x37, 0x2e, 0xc3, 0x9c, 0x4f, 0xb7, 0x7d, 0x1a, 0xec, 0x94, 0x73, 0x7c, 0x58,
-	0x92, 0xb8, 0x02, 0x45, 0x26, 0x9c, 0x1a, 0x61, 0x50, 0xce, 0x57, 0xf3, 0xb5, 0x79, 0x3d, 0xa6,
-	0x71, 0x0d, 0x96, 0x7c, 0x1a, 0xb8, 0x1d, 0xdf, 0xa0, 0x9f, 0xa5, 0x7e, 0x60, 0xb9, 0x4e, 0x79,
-	0x86, 0xaf, 0xee, 0x1d, 0x66, 0x5c, 0x02, 0x6a, 0x53, 0x23, 0x74, 0xfd, 0x72, 0x81, 0x4f, 0x89,
-	0x69, 0x86, 0x87, 0x01, 0x2f, 0xcf, 0x46, 0x78, 0xd8, 0x37, 0xd6, 0x60, 0x81, 0x78, 0xde, 0x6d,
-	0xd2, 0xa6, 0x81, 0x47, 0x0c, 0x5a, 0x9e, 0xe3, 0xbf, 0xa5, 0xc6, 0x18, 0x66, 0x81, 0xa4, 0x5c,
-	0xe4, 0xc0, 0x24, 0xa9, 0x6d, 0xc0, 0xfc, 0x6d, 0xd7, 0xa4, 0xc3, 0xd5, 0xed, 0x65, 0x9f, 0xeb,
-	0x67, 0xaf, 0xfd, 0x1e, 0xc1, 0x11, 0x9d, 0x76, 0x2d, 0x86, 0xff, 0x16, 0x0d, 0x89, 0x49, 0x42,
-	0xd2, 0xcb, 0x31, 0x17, 0x73, 0xac, 0x40, 0xd1, 0x17, 0x93, 0xcb, 0x39, 0x3e, 0x1e, 0xd3, 0x7d,
-	0xd2, 0xf2, 0xd9, 0xca, 0x44, 0x26, 0x94, 0x24, 0xae, 0x42, 0x29, 0xb2, 0xe5, 0x4d, 0xc7, 0xa4,
-	0x5f, 0xe6, 0xd6, 0x2b, 0xe8, 0xea, 0x10, 0x5e, 0x81, 0xf9, 0x6e, 0x64, 0xe7, 0x9b, 0x26, 0xb7,
-	0x62, 0x41, 0x4f, 0x06, 0xb4, 0x7f, 0x22, 0x38, 0xa6, 0xf8, 0x80, 0x2e, 0x76, 0xe6, 0x6a, 0x97,
-	0x3a, 0x61, 0x30, 0x5c, 0xa1, 0xb3, 0x70, 0x48, 0x6e, 0x62, 0xaf, 0x9d, 0xfa, 0x7f, 0x60, 0x2a,
-	0xaa, 0x83, 0x52, 0x45, 0x75, 0x8c, 0x29, 0x22, 0xe9, 0x7b, 0x37, 0xaf, 0x08, 0x35, 0xd5, 0xa1,
-	0x3e, 0x43, 0x15, 0xb2, 0x0d, 0x35, 0x9b, 0x32, 0x94, 0xf6, 0x1e, 0x82, 0xb2, 0xa2, 0xe8, 0x2d,
-	0xe2, 0x58, 0xdb, 0x34, 0x08, 0xc7, 0xdd, 0x33, 0x34, 0xc5, 0x3d, 0xab, 0xc1, 0x52, 0xa4, 0xd5,
-	0x1d, 0x76, 0x1e, 0x59, 0xfc, 0x29, 0x17, 0xaa, 0xf9, 0x5a, 0x5e, 0xef, 0x1d, 0x66, 0x7b, 0x27,
-	0x65, 0x06, 0xe5, 0x59, 0xee, 0xc6, 0xc9, 0x80, 0xf6, 0x3c, 0xcc, 0x5f, 0xb3, 0x6c, 0xba, 0xb1,
-	0xd3, 0x71, 0x76, 0xf1, 0x61, 0x28, 0x18, 0xec, 0x83, 0xeb, 0xb0, 0xa0, 0x47, 0x84, 0xf6, 0x4d,
-	0x04, 0xcf, 0x0f, 0xd3, 0xfa, 0xbe, 0x15, 0xee, 0xb0, 0xf5, 0xc1, 0x30, 0xf5, 0x8d, 0x1d, 0x6a,
-	0xec, 0x06, 0x9d, 0xb6, 0x74, 0x59, 0x49, 0x4f, 0xa6, 0xbe, 0xf6, 0x13, 0x04, 0xb5, 0x91, 0x98,
-	0xee, 0xfb, 0xc4, 0xf3, 0xa8, 0x8f, 0xaf, 0x41, 0xe1, 0x01, 0xfb, 0x81, 0x1f, 0xd0, 0x52, 0xb3,
-	0x5e, 0x57, 0x03, 0xfc, 0x48, 0x2e, 0x37, 0x3e, 0xa4, 0x47, 0xcb, 0x71, 0x5d, 0x9a, 0x27, 0xc7,
-	0xf9, 0x2c, 0xa7, 0xf8, 0xc4, 0x56, 0x64, 0xf3, 0xf9, 0xb4, 0xcb, 0xb3, 0x30, 0xe3, 0x11, 0x3f,
-	0xd4, 0x8e, 0xc0, 0x33, 0xe9, 0xe3, 0xe1, 0xb9, 0x4e, 0x40, 0xb5, 0x5f, 0xa7, 0xbd, 0x69, 0xc3,
-	0xa7, 0x24, 0xa4, 0x3a, 0x7d, 0xd0, 0xa1, 0x41, 0x88, 0x77, 0x41, 0xcd, 0x39, 0xdc, 0xaa, 0xa5,
-	0xe6, 0xcd, 0x7a, 0x12, 0xb4, 0xeb, 0x32, 0x68, 0xf3, 0x8f, 0x2f, 0x18, 0x66, 0xbd, 0xdb, 0xac,
-	0x7b, 0xbb, 0xad, 0x3a, 0x4b, 0x01, 0x29, 0x64, 0x32, 0x05, 0xa8, 0xaa, 0xea, 0x2a, 0x77, 0xbc,
-	0x0c, 0xb3, 0x1d, 0x2f, 0xa0, 0x7e, 0xc8, 0x35, 0x2b, 0xea, 0x82, 0x62, 0xfb, 0xd7, 0x25, 0xb6,
-	0x65, 0x92, 0x30, 0xda, 0x9f, 0xa2, 0x1e, 0xd3, 0xda, 0x6f, 0xd2, 0xe8, 0xef, 0x79, 0xe6, 0x07,
-	0x85, 0x5e, 0x45, 0x99, 0x4b, 0xa3, 0x54, 0x3d, 0x28, 0x9f, 0xf6, 0xa0, 0x5f, 0xa4, 0xf1, 0x5f,
-	0xa1, 0x36, 0x4d, 0xf0, 0x0f, 0x72, 0xe6, 0x32, 0xcc, 0x19, 0x24, 0x30, 0x88, 0x29, 0xa5, 0x48,
-	0x92, 0x05, 0x32, 0xcf, 0x77, 0x3d, 0xd2, 0xe2, 0x9c, 0xee, 0xb8, 0xb6, 0x65, 0xec, 0x09, 0x71,
-	0xfd, 0x3f, 0xf4, 0x39, 0xfe, 0x4c, 0xb6, 0xe3, 0x17, 0xd2, 0xb0, 0x8f, 0x43, 0x69, 0x6b, 0xcf,
-	0x31, 0x5e, 0xf5, 0xa2, 0xc3, 0x7d, 0x18, 0x0a, 0x56, 0x48, 0xdb, 0x41, 0x19, 0xf1, 0x83, 0x1d,
-	0x11, 0xda, 0x7f, 0x0a, 0xb0, 0xac, 0xe8, 0xc6, 0x16, 0x64, 0x69, 0x96, 0x15, 0xa5, 0x96, 0x61,
-	0xd6, 0xf4, 0xf7, 0xf4, 0x8e, 0x23, 0x1c, 0x40, 0x50, 0x4c, 0xb0, 0xe7, 0x77, 0x9c, 0x08, 0x7e,
-	0x51, 0x8f, 0x08, 0xbc, 0x0d, 0xc5, 0x20, 0x64, 0x55, 0x46, 0x6b, 0x8f, 0x03, 0x2f, 0x35, 0x3f,
-	0x35, 0xd9, 0xa6, 0x33, 0xe8, 0x5b, 0x82, 0xa3, 0x1e, 0xf3, 0xc6, 0x0f, 0x58, 0x4c, 0x8b, 0x02,
-	0x5d, 0x50, 0x9e, 0xab, 0xe6, 0x6b, 0xa5, 0xe6, 0xd6, 0xe4, 0x82, 0x5e, 0xf5, 0x58, 0x85, 0xa4,
-	0x64, 0x30, 0x3d, 0x91, 0xc2, 0xc2, 0x68, 0x5b, 0xc4, 0x87, 0x40, 0x54, 0x03, 0xc9, 0x00, 0xfe,
-	0x1c, 0x14, 0x2c, 0x67, 0xdb, 0x0d, 0xca, 0xf3, 0x1c, 0xcc, 0xe5, 0xc9, 0xc0, 0xdc, 0x74, 0xb6,
-	0x5d, 0x3d, 0x62, 0x88, 0x1f, 0xc0, 0xa2, 0x4f, 0x43, 0x7f, 0x4f, 0x5a, 0xa1, 0x0c, 0xdc, 0xae,
-	0x9f, 0x9e, 0x4c, 0x82, 0xae, 0xb2, 0xd4, 0xd3, 0x12, 0xf0, 0x3a, 0x94, 0x82, 0xc4, 0xc7, 0xca,
-	0x25, 0x2e, 0xb0, 0x9c, 0x62, 0xa4, 0xf8, 0xa0, 0xae, 0x4e, 0xee, 0xf3, 0xee, 0x85, 0x6c, 0xef,
-	0x5e, 0x1c, 0x99, 0xd5, 0x0e, 0x8c, 0x91, 0xd5, 0x96, 0x7a, 0xb3, 0xda, 0xbf, 0x11, 0xac, 0xf4,
-	0x05, 0xa7, 0x2d, 0x8f, 0x66, 0x1e, 0x03, 0x02, 0x33, 0x81, 0x47, 0x0d, 0x9e, 0xa9, 0x4a, 0xcd,
-	0x5b, 0x53, 0x8b, 0x56, 0x5c, 0x2e, 0x67, 0x9d, 0x15, 0x50, 0x27, 0x8c, 0x0b, 0x3f, 0x40, 0xf0,
-	0x61, 0x45, 0xe6, 0x1d, 0x12, 0x1a, 0x3b, 0x59, 0xca, 0xb2, 0xf3, 0xcb, 0xe6, 0x88, 0xbc, 0x1c,
-	0x11, 0xcc, 0xaa, 0xfc, 0xe3, 0xee, 0x9e, 0xc7, 0x00, 0xb2, 0x5f, 0x92, 0x81, 0x09, 0x8b, 0xa7,
-	0x9f, 0x22, 0xa8, 0xa8, 0x31, 0xdc, 0xb5, 0xed, 0xd7, 0x89, 0xb1, 0x9b, 0x05, 0xf2, 0x00, 0xe4,
-	0x2c, 0x93, 0x23, 0xcc, 0xeb, 0x39, 0xcb, 0xdc, 0x67, 0x30, 0xea, 0x85, 0x3b, 0x9b, 0x0d, 0x77,
-	0x2e, 0x0d, 0xf7, 0xfd, 0x1e, 0xb8, 0x32, 0x24, 0x64, 0xc0, 0x5d, 0x81, 0x79, 0xa7, 0xa7, 0x90,
-	0x4d, 0x06, 0x06, 0x14, 0xb0, 0xb9, 0xbe, 0x02, 0xb6, 0x0c, 0x73, 0xdd, 0xf8, 0x9a, 0xc3, 0x7e,
-	0x96, 0x24, 0x53, 0xb1, 0xe5, 0xbb, 0x1d, 0x4f, 0x18, 0x3d, 0x22, 0x18, 0x8a, 0x5d, 0xcb, 0x61,
-	0x25, 0x39, 0x47, 0xc1, 0xbe, 0xf7, 0x7f, 0xb1, 0x49, 0xa9, 0xfd, 0xb3, 0x1c, 0x7c, 0x64, 0x80,
-	0xda, 0x23, 0xfd, 0xe9, 0xe9, 0xd0, 0x3d, 0xf6, 0xea, 0xb9, 0xa1, 0x5e, 0x5d, 0x1c, 0xe5, 0xd5,
-	0xf3, 0xd9, 0xf6, 0x82, 0xb4, 0xbd, 0x7e, 0x9c, 0x83, 0xea, 0x00, 0x7b, 0x8d, 0x2e, 0x27, 0x9e,
-	0x1a, 0x83, 0x6d, 0xbb, 0xbe, 0xf0, 0x92, 0xa2, 0x1e, 0x11, 0xec, 0x9c, 0xb9, 0xbe, 0xb7, 0x43,
-	0x1c, 0xee, 0x1d, 0x45, 0x5d, 0x50, 0x13, 0x9a, 0xea, 0x6b, 0x39, 0x28, 0x4b, 0xfb, 0x5c, 0x32,
-	0xb8, 0xb5, 0x3a, 0xce, 0xd3, 0x6f, 0xa2, 0x65, 0x98, 0x25, 0x1c, 0xad, 0x70, 0x2a, 0x41, 0xf5,
-	0x19, 0xa3, 0x98, 0x6d, 0x8c, 0xf9, 0xb4, 0x31, 0xde, 0x44, 0x70, 0x34, 0x6d, 0x8c, 0x60, 0xd3,
-	0x0a, 0x42, 0x79, 0x39, 0xc0, 0xdb, 0x30, 0x17, 0xc9, 0x89, 0x4a, 0xbb, 0x52, 0x73, 0x73, 0xd2,
-	0x84, 0x9f, 0x32, 0xbc, 0x64, 0xae, 0xbd, 0x08, 0x47, 0x07, 0x46, 0x39, 0x01, 0xa3, 0x02, 0x45,
-	0x59, 0xe4, 0x88, 0xad, 0x89, 0x69, 0xed, 0xcd, 0x99, 0x74, 0xca, 0x71, 0xcd, 0x4d, 0xb7, 0x95,
-	0x71, 0xdf, 0xcf, 0xde, 0x4e, 0x66, 0x2a, 0xd7, 0x54, 0xae, 0xf6, 0x92, 0x64, 0xeb, 0x0c, 0xd7,
-	0x09, 0x89, 0xe5, 0x50, 0x5f, 0x64, 0xc5, 0x64, 0x80, 0x6d, 0x43, 0x60, 0x39, 0x06, 0xdd, 0xa2,
-	0x86, 0xeb, 0x98, 0x01, 0xdf, 0xcf, 0xbc, 0x9e, 0x1a, 0xc3, 0x37, 0x60, 0x9e, 0xd3, 0x77, 0xad,
-	0x76, 0x94, 0x06, 0x4a, 0xcd, 0xd5, 0x7a, 0xd4, 0x83, 0xab, 0xab, 0x3d, 0xb8, 0xc4, 0x86, 0x6d,
-	0x1a, 0x92, 0x7a, 0xf7, 0x7c, 0x9d, 0xad, 0xd0, 0x93, 0xc5, 0x0c, 0x4b, 0x48, 0x2c, 0x7b, 0xd3,
-	0x72, 0x78, 0xe1, 0xc9, 0x44, 0x25, 0x03, 0xcc, 0x55, 0xb6, 0x5d, 0xdb, 0x76, 0x1f, 0xca, 0x73,
-	0x13, 0x51, 0x6c, 0x55, 0xc7, 0x09, 0x2d, 0x9b, 0xcb, 0x8f, 0x1c, 0x21, 0x19, 0xe0, 0xab, 0x2c,
-	0x3b, 0xa4, 0xbe, 0x38, 0x30, 0x82, 0x8a, 0x9d, 0xb1, 0x14, 0xb5, 0x95, 0xe4, 0x79, 0x8d, 0xdc,
-	0x76, 0x41, 0x75, 0xdb, 0xde, 0xa3, 0xb0, 0x38, 0xa0, 0x37, 0xc2, 0xbb, 0x6c, 0xb4, 0x6b, 0xb9,
-	0x1d, 0x56, 0x53, 0xf1, 0xd2, 0x43, 0xd2, 0x7d, 0xae, 0xbc, 0x94, 0xed, 0xca, 0x07, 0xd3, 0xae,
-	0xfc, 0x5b, 0x04, 0xc5, 0x4d, 0xb7, 0x75, 0xd5, 0x09, 0xfd, 0x3d, 0x7e, 0x4b, 0x72, 0x9d, 0x90,
-	0x3a, 0xd2, 0x5f, 0x24, 0xc9, 0x36, 0x21, 0xb4, 0xda, 0x74, 0x2b, 0x24, 0x6d, 0x4f, 0xd4, 0x58,
-	0xfb, 0xda, 0x84, 0x78, 0x31, 0x33, 0x8c, 0x4d, 0x82, 0x90, 0x9f, 0xf8, 0xa2, 0xce, 0xbf, 0x99,
-	0x0a, 0xf1, 0x84, 0xad, 0xd0, 0x17, 0xc7, 0x3d, 0x35, 0xa6, 0xba, 0x58, 0x21, 0xc2, 0x26, 0x48,
-	0xad, 0x0d, 0xcf, 0xc6, 0xc5, 0xff, 0x5d, 0xea, 0xb7, 0x2d, 0x87, 0x64, 0x47, 0xef, 0x31, 0xda,
-	0x7b, 0x19, 0x77, 0x4f, 0x37, 0x75, 0xe8, 0x58, 0x2d, 0x7d, 0xdf, 0x72, 0x4c, 0xf7, 0x61, 0xc6,
-	0xe1, 0x99, 0x4c, 0xe0, 0x5f, 0xd2, 0x1d, 0x3a, 0x45, 0x62, 0x7c, 0xd2, 0x6f, 0xc0, 0x22, 0x8b,
-	0x09, 0x5d, 0x2a, 0x7e, 0x10, 0x61, 0x47, 0x1b, 0xd6, 0x2c, 0x49, 0x78, 0xe8, 0xe9, 0x85, 0x78,
-	0x13, 0x96, 0x48, 0x10, 0x58, 0x2d, 0x87, 0x9a, 0x92, 0x57, 0x6e, 0x6c, 0x5e, 0xbd, 0x4b, 0xa3,
-	0x6b, 0x37, 0x9f, 0x21, 0xf6, 0x5b, 0x92, 0xda, 0x57, 0x11, 0x1c, 0x19, 0xc8, 0x24, 0x3e, 0x39,
-	0x48, 0x09, 0xe3, 0x15, 0x28, 0x06, 0xc6, 0x0e, 0x35, 0x3b, 0x36, 0x95, 0xbd, 0x28, 0x49, 0xb3,
-	0xdf, 0xcc, 0x4e, 0xb4, 0xfb, 0x22, 0x8d, 0xc4, 0x34, 0x3e, 0x06, 0xd0, 0x26, 0x4e, 0x87, 0xd8,
-	0x1c, 0xc2, 0x0c, 0x87, 0xa0, 0x8c, 0x68, 0x2b, 0x50, 0x19, 0xe4, 0x3a, 0xa2, 0xc7, 0xf3, 0x2f,
-	0x04, 0x07, 0x64, 0x50, 0x15, 0xbb, 0x5b, 0x83, 0x25, 0xc5, 0x0c, 0xb7, 0x93, 0x8d, 0xee, 0x1d,
-	0x1e, 0x11, 0x30, 0xa5, 0x97, 0xe4, 0xd3, 0x4d, 0xf6, 0x6e, 0xaa, 0x4d, 0x3e, 0x76, 0xbe, 0x43,
-	0x53, 0xaa, 0x1f, 0xbf, 0x02, 0xe5, 0x5b, 0xc4, 0x21, 0x2d, 0x6a, 0xc6, 0x6a, 0xc7, 0x2e, 0xf6,
-	0x45, 0xb5, 0x59, 0x31, 0x71, 0x6b, 0x20, 0x2e, 0xb5, 0xac, 0xed, 0x6d, 0xd9, 0xf8, 0xf0, 0xa1,
-	0xb8, 0x69, 0x39, 0xbb, 0xec, 0xfe, 0xcc, 0x34, 0x0e, 0xad, 0xd0, 0x96, 0xd6, 0x8d, 0x08, 0x7c,
-	0x10, 0xf2, 0x1d, 0xdf, 0x16, 0x1e, 0xc0, 0x3e, 0x71, 0x15, 0x4a, 0x26, 0x0d, 0x0c, 0xdf, 0xf2,
-	0xc4, 0xfe, 0xf3, 0xa6, 0xb1, 0x32, 0xc4, 0xf6, 0xc1, 0x32, 0x5c, 0x67, 0xc3, 0x26, 0x41, 0x20,
-	0x13, 0x50, 0x3c, 0xa0, 0xbd, 0x0c, 0x8b, 0x4c, 0x66, 0xa2, 0xe6, 0x99, 0xb4, 0x9a, 0x47, 0x52,
-	0xf0, 0x25, 0x3c, 0x89, 0x98, 0xc0, 0x33, 0x2c, 0xef, 0x5f, 0xf2, 0x3c, 0xc1, 0x64, 0xcc, 0x72,
-	0x28, 0x3f, 0x28, 0x7f, 0x0e, 0xec, 0x95, 0x36, 0xff, 0x76, 0x1c, 0xb0, 0x7a, 0x4e, 0xa8, 0xdf,
-	0xb5, 0x0c, 0x8a, 0xbf, 0x85, 0x60, 0x86, 0x89, 0xc6, 0xcf, 0x0d, 0x3b, 0x96, 0xdc, 0x5f, 0x2b,
-	0xd3, 0xbb, 0x08, 0x33, 0x69, 0xda, 0xca, 0x1b, 0x7f, 0xfd, 0xc7, 0xb7, 0x73, 0xcb, 0xf8, 0x30,
-	0x7f, 0x21, 0xeb, 0x9e, 0x57, 0x5f, 0xab, 0x02, 0xfc, 0x16, 0x02, 0x2c, 0xea, 0x20, 0xe5, 0x0d,
-	0x01, 0x9f, 0x19, 0x06, 0x71, 0xc0, 0x5b, 0x43, 0xe5, 0x39, 0x25, 0xab, 0xd4, 0x0d, 0xd7, 0xa7,
-	0x2c, 0x87, 0xf0, 0x09, 0x1c, 0xc0, 0x2a, 0x07, 0x70, 0x02, 0x6b, 0x83, 0x00, 0x34, 0x1e, 0x31,
-	0x8b, 0x3e, 0x6e, 0xd0, 0x48, 0xee, 0x3b, 0x08, 0x0a, 0xf7, 0xf9, 0x1d, 0x62, 0x84, 0x91, 0xb6,
-	0xa6, 0x66, 0x24, 0x2e, 0x8e, 0xa3, 0xd5, 0x8e, 0x73, 0xa4, 0xcf, 0xe1, 0xa3, 0x12, 0x69, 0x10,
-	0xfa, 0x94, 0xb4, 0x53, 0x80, 0xcf, 0x21, 0xfc, 0x2e, 0x82, 0xd9, 0xa8, 0x79, 0x8c, 0x4f, 0x0e,
-	0x43, 0x99, 0x6a, 0x2e, 0x57, 0xa6, 0xd7, 0x89, 0xd5, 0x4e, 0x73, 0x8c, 0xc7, 0xb5, 0x81, 0xdb,
-	0xb9, 0x9e, 0xea, 0xd3, 0xbe, 0x8d, 0x20, 0x7f, 0x9d, 0x8e, 0xf4, 0xb7, 0x29, 0x82, 0xeb, 0x33,
-	0xe0, 0x80, 0xad, 0xc6, 0x3f, 0x42, 0xf0, 0xec, 0x75, 0x1a, 0x0e, 0x4e, 0x8f, 0xb8, 0x36, 0x3a,
-	0x67, 0x09, 0xb7, 0x3b, 0x33, 0xc6, 0xcc, 0x38, 0x2f, 0x34, 0x38, 0xb2, 0xd3, 0xf8, 0x54, 0x96,
-	0x13, 0x06, 0x7b, 0x8e, 0xf1, 0x50, 0xe0, 0xf8, 0x13, 0x82, 0x83, 0xbd, 0x6f, 0x85, 0x38, 0x9d,
-	0x50, 0x07, 0x3e, 0x25, 0x56, 0x6e, 0x4f, 0x1a, 0x65, 0xd3, 0x4c, 0xb5, 0x4b, 0x1c, 0xf9, 0x4b,
-	0xf8, 0xc5, 0x2c, 0xe4, 0x71, 0x27, 0xae, 0xf1, 0x48, 0x7e, 0x3e, 0xe6, 0xef, 0xda, 0x1c, 0xf6,
-	0x9f, 0x11, 0x1c, 0x96, 0x7c, 0x37, 0x76, 0x88, 0x1f, 0x5e, 0xa1, 0xac, 0x86, 0x0e, 0xc6, 0xd2,
-	0x67, 0xc2, 0xac, 0xa1, 0xca, 0xd3, 0xae, 0x72, 0x5d, 0x3e, 0x81, 0x5f, 0xd9, 0xb7, 0x2e, 0x06,
-	0x63, 0x63, 0x0a, 0xd8, 0x6f, 0x20, 0x58, 0xb8, 0x4e, 0xc3, 0x5b, 0x71, 0x37, 0xf8, 0xe4, 0x58,
-	0x2f, 0x4c, 0x95, 0x95, 0xba, 0xf2, 0x9c, 0x2e, 0x7f, 0x8a, 0x5d, 0x64, 0x8d, 0x83, 0x3b, 0x85,
-	0x4f, 0x66, 0x81, 0x4b, 0x3a, 0xd0, 0xef, 0x20, 0x38, 0xa2, 0x82, 0x48, 0x5e, 0xe6, 0x3e, 0xb6,
-	0xbf, 0xf7, 0x2e, 0xf1, 0x6a, 0x36, 0x02, 0x5d, 0x93, 0xa3, 0x3b, 0xab, 0x0d, 0x76, 0xe0, 0x76,
-	0x1f, 0x8a, 0x75, 0xb4, 0x5a, 0x43, 0xf8, 0x77, 0x08, 0x66, 0xa3, 0x66, 0xec, 0x70, 0x1b, 0xa5,
-	0x5e, 0x92, 0xa6, 0x19, 0x0d, 0xc4, 0x6e, 0x57, 0xce, 0x0d, 0x36, 0xa8, 0xba, 0x5e, 0xba, 0x6a,
-	0x9d, 0x5b, 0x39, 0x1d, 0xc6, 0x7e, 0x89, 0x00, 0x92, 0x86, 0x32, 0x3e, 0x9d, 0xad, 0x87, 0xd2,
-	0x74, 0xae, 0x4c, 0xb7, 0xa5, 0xac, 0xd5, 0xb9, 0x3e, 0xb5, 0x4a, 0x35, 0x33, 0x86, 0x78, 0xd4,
-	0x58, 0x8f, 0x9a, 0xcf, 0x3f, 0x44, 0x50, 0xe0, 0x7d, 0x3c, 0x7c, 0x62, 0x18, 0x66, 0xb5, 0xcd,
-	0x37, 0x4d, 0xd3, 0xbf, 0xc0, 0xa1, 0x56, 0x9b, 0x59, 0x81, 0x78, 0x1d, 0xad, 0xe2, 0x2e, 0xcc,
-	0x46, 0x9d, 0xb3, 0xe1, 0xee, 0x91, 0xea, 0xac, 0x55, 0xaa, 0x19, 0x85, 0x41, 0xe4, 0xa8, 0x22,
-	0x07, 0xac, 0x8e, 0xca, 0x01, 0x33, 0x2c, 0x4c, 0xe3, 0xe3, 0x59, 0x41, 0xfc, 0xff, 0x60, 0x98,
-	0x33, 0x1c, 0xdd, 0x49, 0xad, 0x3a, 0x2a, 0x0f, 0x30, 0xeb, 0x7c, 0x07, 0xc1, 0xc1, 0xde, 0xe2,
-	0x1a, 0x1f, 0xed, 0x89, 0x99, 0xea, 0x5d, 0xa3, 0x92, 0xb6, 0xe2, 0xb0, 0xc2, 0x5c, 0xfb, 0x24,
-	0x47, 0xb1, 0x8e, 0x2f, 0x8e, 0x3c, 0x19, 0xb7, 0x65, 0xd4, 0x61, 0x8c, 0xd6, 0x92, 0xd7, 0xb1,
-	0x5f, 0x21, 0x58, 0x90, 0x7c, 0xef, 0xfa, 0x94, 0x66, 0xc3, 0x9a, 0xde, 0x41, 0x60, 0xb2, 0xb4,
-	0x97, 0x39, 0xfc, 0x8f, 0xe3, 0x0b, 0x63, 0xc2, 0x97, 0xb0, 0xd7, 0x42, 0x86, 0xf4, 0x0f, 0x08,
-	0x0e, 0xdd, 0x8f, 0xfc, 0xfe, 0x03, 0xc2, 0xbf, 0xc1, 0xf1, 0xbf, 0x82, 0x5f, 0xca, 0xa8, 0xf3,
-	0x46, 0xa9, 0x71, 0x0e, 0xe1, 0x9f, 0x23, 0x28, 0xca, 0x57, 0x15, 0x7c, 0x6a, 0xe8, 0xc1, 0x48,
-	0xbf, 0xbb, 0x4c, 0xd3, 0x99, 0x45, 0x51, 0xa3, 0x9d, 0xc8, 0x4c, 0xa7, 0x42, 0x3e, 0x73, 0xe8,
-	0xb7, 0x11, 0xe0, 0xf8, 0xce, 0x1c, 0xdf, 0xa2, 0xf1, 0x0b, 0x29, 0x51, 0x43, 0x1b, 0x33, 0x95,
-	0x53, 0x23, 0xe7, 0xa5, 0x53, 0xe9, 0x6a, 0x66, 0x2a, 0x75, 0x63, 0xf9, 0x5f, 0x47, 0x50, 0xba,
-	0x4e, 0xe3, 0x3b, 0x48, 0x86, 0x2d, 0xd3, 0x8f, 0x42, 0x95, 0xda, 0xe8, 0x89, 0x02, 0xd1, 0x59,
-	0x8e, 0xe8, 0x05, 0x9c, 0x6d, 0x2a, 0x09, 0xe0, 0x7b, 0x08, 0x16, 0xef, 0xa8, 0x2e, 0x8a, 0xcf,
-	0x8e, 0x92, 0x94, 0x8a, 0xe4, 0xe3, 0xe3, 0xfa, 0x28, 0xc7, 0xb5, 0xa6, 0x8d, 0x85, 0x6b, 0x5d,
-	0xbc, 0xaf, 0x7c, 0x1f, 0x45, 0x97, 0xd8, 0x9e, 0x7e, 0xf6, 0xff, 0x6a, 0xb7, 0x8c, 0xb6, 0xb8,
-	0x76, 0x81, 0xe3, 0xab, 0xe3, 0xb3, 0xe3, 0xe0, 0x6b, 0x88, 0x26, 0x37, 0xfe, 0x2e, 0x82, 0x43,
-	0xfc, 0xad, 0x41, 0x65, 0xdc, 0x93, 0x62, 0x86, 0xbd, 0x4c, 0x8c, 0x91, 0x62, 0x44, 0xfc, 0xd1,
-	0xf6, 0x05, 0x6a, 0x5d, 0xbe, 0x23, 0x7c, 0x03, 0xc1, 0x01, 0x99, 0xd4, 0xc4, 0xee, 0xae, 0x8d,
-	0x32, 0xdc, 0x7e, 0x93, 0xa0, 0x70, 0xb7, 0xd5, 0xf1, 0xdc, 0xed, 0x5d, 0x04, 0x73, 0xa2, 0x9b,
-	0x9f, 0x51, 0x2a, 0x28, 0xed, 0xfe, 0x4a, 0x4f, 0x8f, 0x43, 0x34, 0x83, 0xb5, 0xcf, 0x73, 0xb1,
-	0xf7, 0x70, 0x23, 0x4b, 0xac, 0xe7, 0x9a, 0x41, 0xe3, 0x91, 0xe8, 0xc4, 0x3e, 0x6e, 0xd8, 0x6e,
-	0x2b, 0x78, 0x4d, 0xc3, 0x99, 0x09, 0x91, 0xcd, 0x39, 0x87, 0x70, 0x08, 0xf3, 0xcc, 0x39, 0x78,
-	0xe3, 0x04, 0x57, 0x7b, 0xda, 0x2c, 0x7d, 0x3d, 0x95, 0x4a, 0xa5, 0xaf, 0x11, 0x93, 0x64, 0x40,
-	0x71, 0x8d, 0xc5, 0xcf, 0x67, 0x8a, 0xe5, 0x82, 0xde, 0x42, 0x70, 0x48, 0xf5, 0xf6, 0x48, 0xfc,
-	0xd8, 0xbe, 0x9e, 0x85, 0x42, 0x14, 0xd5, 0x78, 0x75, 0x2c, 0x47, 0xe2, 0x70, 0x2e, 0x5f, 0xfb,
-	0xe3, 0x93, 0x63, 0xe8, 0xbd, 0x27, 0xc7, 0xd0, 0xdf, 0x9f, 0x1c, 0x43, 0xaf, 0x5d, 0x1c, 0xef,
-	0x3f, 0xc2, 0x86, 0x6d, 0x51, 0x27, 0x54, 0xd9, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0x45, 0x63,
-	0x3b, 0x00, 0x09, 0x2d, 0x00, 0x00,
+	// 2779 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x8c, 0x1c, 0x47,
+	0x15, 0xa6, 0x66, 0x76, 0x66, 0x67, 0xde, 0xec, 0x7a, 0xed, 0x8a, 0xbd, 0x4c, 0xc6, 0x1b, 0xb3,
+	0x69, 0xdb, 0xf1, 0x64, 0xed, 0x9d, 0xb1, 0x87, 0x80, 0x92, 0x4d, 0x22, 0x70, 0x36, 0x8e, 0x63,
+	0x58, 0x3b, 0xa6, 0xd7, 0xc1, 0x28, 0x1c, 0xa0, 0xd2, 0x5d, 0x3b, 0xd3, 0x6c, 0x4f, 0x77, 0xbb,
+	0xbb, 0x67, 0xcc, 0xca, 0xf8, 0x12, 0x94, 0x0b, 0x8a, 0x40, 0x40, 0x0e, 0x08, 0x21, 0x40, 0x41,
+	0x91, 0x10, 0x02, 0x71, 0x41, 0x08, 0x09, 0x21, 0xc1, 0x01, 0x04, 0x07, 0xa4, 0x08, 0x8e, 0x5c,
+	0x90, 0x15, 0x71, 0xe5, 0x92, 0x33, 0x42, 0x55, 0x5d, 0xd5, 0x5d, 0x3d, 0x3f, 0x3d, 0xb3, 0xcc,
+	0xa0, 0xf8, 0xd6, 0xaf, 0xa6, 0xea, 0xbd, 0xef, 0xbd, 0x7a, 0xf5, 0xde, 0xab, 0x57, 0x03, 0x67,
+	0x02, 0xea, 0xf7, 0xa9, 0xdf, 0x24, 0x9e, 0x67, 0x5b, 0x06, 0x09, 0x2d, 0xd7, 0x51, 0xbf, 0x1b,
+	0x9e, 0xef, 0x86, 0x2e, 0xae, 0x28, 0x43, 0xb5, 0xb5, 0xb6, 0xeb, 0xb6, 0x6d, 0xda, 0x24, 0x9e,
+	0xd5, 0x24, 0x8e, 0xe3, 0x86, 0x7c, 0x38, 0x88, 0xa6, 0xd6, 0xb4, 0xfd, 0xa7, 0x83, 0x86, 0xe5,
+	0xf2, 0x5f, 0x0d, 0xd7, 0xa7, 0xcd, 0xfe, 0xa5, 0x66, 0x9b, 0x3a, 0xd4, 0x27, 0x21, 0x35, 0xc5,
+	0x9c, 0xa7, 0x92, 0x39, 0x5d, 0x62, 0x74, 0x2c, 0x87, 0xfa, 0x07, 0x4d, 0x6f, 0xbf, 0xcd, 0x06,
+	0x82, 0x66, 0x97, 0x86, 0x64, 0xd4, 0xaa, 0x9d, 0xb6, 0x15, 0x76, 0x7a, 0xaf, 0x37, 0x0c, 0xb7,
+	0xdb, 0x24, 0x7e, 0xdb, 0xf5, 0x7c, 0xf7, 0x2b, 0xfc, 0x63, 0xd3, 0x30, 0x9b, 0xfd, 0x56, 0xc2,
+	0x40, 0xd5, 0xa5, 0x7f, 0x89, 0xd8, 0x5e, 0x87, 0x0c, 0x73, 0xbb, 0x32, 0x81, 0x9b, 0x4f, 0x3d,
+	0x57, 0xd8, 0x86, 0x7f, 0x5a, 0xa1, 0xeb, 0x1f, 0x28, 0x9f, 0x11, 0x1b, 0xed, 0x03, 0x04, 0x47,
+	0x2f, 0x27, 0xf2, 0x3e, 0xd7, 0xa3, 0xfe, 0x01, 0xc6, 0xb0, 0xe0, 0x90, 0x2e, 0xad, 0xa2, 0x75,
+	0x54, 0x2f, 0xeb, 0xfc, 0x1b, 0x57, 0x61, 0xd1, 0xa7, 0x7b, 0x3e, 0x0d, 0x3a, 0xd5, 0x1c, 0x1f,
+	0x96, 0x24, 0xae, 0x41, 0x89, 0x09, 0xa7, 0x46, 0x18, 0x54, 0xf3, 0xeb, 0xf9, 0x7a, 0x59, 0x8f,
+	0x69, 0x5c, 0x87, 0x15, 0x9f, 0x06, 0x6e, 0xcf, 0x37, 0xe8, 0xe7, 0xa9, 0x1f, 0x58, 0xae, 0x53,
+	0x5d, 0xe0, 0xab, 0x07, 0x87, 0x19, 0x97, 0x80, 0xda, 0xd4, 0x08, 0x5d, 0xbf, 0x5a, 0xe0, 0x53,
+	0x62, 0x9a, 0xe1, 0x61, 0xc0, 0xab, 0xc5, 0x08, 0x0f, 0xfb, 0xc6, 0x1a, 0x2c, 0x11, 0xcf, 0xbb,
+	0x41, 0xba, 0x34, 0xf0, 0x88, 0x41, 0xab, 0x8b, 0xfc, 0xb7, 0xd4, 0x18, 0xc3, 0x2c, 0x90, 0x54,
+	0x4b, 0x1c, 0x98, 0x24, 0xb5, 0x6d, 0x28, 0xdf, 0x70, 0x4d, 0x3a, 0x5e, 0xdd, 0x41, 0xf6, 0xb9,
+	0x61, 0xf6, 0xda, 0x1f, 0x11, 0x9c, 0xd0, 0x69, 0xdf, 0x62, 0xf8, 0xaf, 0xd3, 0x90, 0x98, 0x24,
+	0x24, 0x83, 0x1c, 0x73, 0x31, 0xc7, 0x1a, 0x94, 0x7c, 0x31, 0xb9, 0x9a, 0xe3, 0xe3, 0x31, 0x3d,
+	0x24, 0x2d, 0x9f, 0xad, 0x4c, 0x64, 0x42, 0x49, 0xe2, 0x75, 0xa8, 0x44, 0xb6, 0xbc, 0xe6, 0x98,
+	0xf4, 0xab, 0xdc, 0x7a, 0x05, 0x5d, 0x1d, 0xc2, 0x6b, 0x50, 0xee, 0x47, 0x76, 0xbe, 0x66, 0x72,
+	0x2b, 0x16, 0xf4, 0x64, 0x40, 0xfb, 0x17, 0x82, 0x53, 0x8a, 0x0f, 0xe8, 0x62, 0x67, 0xae, 0xf4,
+	0xa9, 0x13, 0x06, 0xe3, 0x15, 0xba, 0x00, 0xc7, 0xe4, 0x26, 0x0e, 0xda, 0x69, 0xf8, 0x07, 0xa6,
+	0xa2, 0x3a, 0x28, 0x55, 0x54, 0xc7, 0x98, 0x22, 0x92, 0x7e, 0xf5, 0xda, 0x8b, 0x42, 0x4d, 0x75,
+	0x68, 0xc8, 0x50, 0x85, 0x6c, 0x43, 0x15, 0x53, 0x86, 0xd2, 0xde, 0x43, 0x50, 0x55, 0x14, 0xbd,
+	0x4e, 0x1c, 0x6b, 0x8f, 0x06, 0xe1, 0xb4, 0x7b, 0x86, 0xe6, 0xb8, 0x67, 0x75, 0x58, 0x89, 0xb4,
+	0xba, 0xc9, 0xce, 0x23, 0x8b, 0x3f, 0xd5, 0xc2, 0x7a, 0xbe, 0x9e, 0xd7, 0x07, 0x87, 0xd9, 0xde,
+	0x49, 0x99, 0x41, 0xb5, 0xc8, 0xdd, 0x38, 0x19, 0xd0, 0x1e, 0x87, 0xf2, 0x4b, 0x96, 0x4d, 0xb7,
+	0x3b, 0x3d, 0x67, 0x1f, 0x1f, 0x87, 0x82, 0xc1, 0x3e, 0xb8, 0x0e, 0x4b, 0x7a, 0x44, 0x68, 0xdf,
+	0x46, 0xf0, 0xf8, 0x38, 0xad, 0x6f, 0x5b, 0x61, 0x87, 0xad, 0x0f, 0xc6, 0xa9, 0x6f, 0x74, 0xa8,
+	0xb1, 0x1f, 0xf4, 0xba, 0xd2, 0x65, 0x25, 0x3d, 0x9b, 0xfa, 0xda, 0xcf, 0x10, 0xd4, 0x27, 0x62,
+	0xba, 0xed, 0x13, 0xcf, 0xa3, 0x3e, 0x7e, 0x09, 0x0a, 0x77, 0xd8, 0x0f, 0xfc, 0x80, 0x56, 0x5a,
+	0x8d, 0x86, 0x1a, 0xe0, 0x27, 0x72, 0x79, 0xf9, 0x23, 0x7a, 0xb4, 0x1c, 0x37, 0xa4, 0x79, 0x72,
+	0x9c, 0xcf, 0x6a, 0x8a, 0x4f, 0x6c, 0x45, 0x36, 0x9f, 0x4f, 0x7b, 0xa1, 0x08, 0x0b, 0x1e, 0xf1,
+	0x43, 0xed, 0x04, 0x3c, 0x92, 0x3e, 0x1e, 0x9e, 0xeb, 0x04, 0x54, 0xfb, 0x6d, 0xda, 0x9b, 0xb6,
+	0x7d, 0x4a, 0x42, 0xaa, 0xd3, 0x3b, 0x3d, 0x1a, 0x84, 0x78, 0x1f, 0xd4, 0x9c, 0xc3, 0xad, 0x5a,
+	0x69, 0x5d, 0x6b, 0x24, 0x41, 0xbb, 0x21, 0x83, 0x36, 0xff, 0xf8, 0x92, 0x61, 0x36, 0xfa, 0xad,
+	0x86, 0xb7, 0xdf, 0x6e, 0xb0, 0x14, 0x90, 0x42, 0x26, 0x53, 0x80, 0xaa, 0xaa, 0xae, 0x72, 0xc7,
+	0xab, 0x50, 0xec, 0x79, 0x01, 0xf5, 0x43, 0xae, 0x59, 0x49, 0x17, 0x14, 0xdb, 0xbf, 0x3e, 0xb1,
+	0x2d, 0x93, 0x84, 0xd1, 0xfe, 0x94, 0xf4, 0x98, 0xd6, 0x7e, 0x97, 0x46, 0xff, 0xaa, 0x67, 0x7e,
+	0x58, 0xe8, 0x55, 0x94, 0xb9, 0x34, 0x4a, 0xd5, 0x83, 0xf2, 0x69, 0x0f, 0xfa, 0x55, 0x1a, 0xff,
+	0x8b, 0xd4, 0xa6, 0x09, 0xfe, 0x51, 0xce, 0x5c, 0x85, 0x45, 0x83, 0x04, 0x06, 0x31, 0xa5, 0x14,
+	0x49, 0xb2, 0x40, 0xe6, 0xf9, 0xae, 0x47, 0xda, 0x9c, 0xd3, 0x4d, 0xd7, 0xb6, 0x8c, 0x03, 0x21,
+	0x6e, 0xf8, 0x87, 0x21, 0xc7, 0x5f, 0xc8, 0x76, 0xfc, 0x42, 0x1a, 0xf6, 0x69, 0xa8, 0xec, 0x1e,
+	0x38, 0xc6, 0x2b, 0x5e, 0x74, 0xb8, 0x8f, 0x43, 0xc1, 0x0a, 0x69, 0x37, 0xa8, 0x22, 0x7e, 0xb0,
+	0x23, 0x42, 0xfb, 0x4f, 0x01, 0x56, 0x15, 0xdd, 0xd8, 0x82, 0x2c, 0xcd, 0xb2, 0xa2, 0xd4, 0x2a,
+	0x14, 0x4d, 0xff, 0x40, 0xef, 0x39, 0xc2, 0x01, 0x04, 0xc5, 0x04, 0x7b, 0x7e, 0xcf, 0x89, 0xe0,
+	0x97, 0xf4, 0x88, 0xc0, 0x7b, 0x50, 0x0a, 0x42, 0x56, 0x65, 0xb4, 0x0f, 0x38, 0xf0, 0x4a, 0xeb,
+	0x33, 0xb3, 0x6d, 0x3a, 0x83, 0xbe, 0x2b, 0x38, 0xea, 0x31, 0x6f, 0x7c, 0x87, 0xc5, 0xb4, 0x28,
+	0xd0, 0x05, 0xd5, 0xc5, 0xf5, 0x7c, 0xbd, 0xd2, 0xda, 0x9d, 0x5d, 0xd0, 0x2b, 0x1e, 0xf5, 0x23,
+	0xff, 0x12, 0xbc, 0xf5, 0x44, 0x0a, 0x0b, 0xa3, 0x5d, 0x11, 0x1f, 0x02, 0x51, 0x0d, 0x24, 0x03,
+	0xf8, 0x0b, 0x50, 0xb0, 0x9c, 0x3d, 0x37, 0xa8, 0x96, 0x39, 0x98, 0x17, 0x66, 0x03, 0x73, 0xcd,
+	0xd9, 0x73, 0xf5, 0x88, 0x21, 0xbe, 0x03, 0xcb, 0x3e, 0x0d, 0xfd, 0x03, 0x69, 0x85, 0x2a, 0x70,
+	0xbb, 0x7e, 0x76, 0x36, 0x09, 0xba, 0xca, 0x52, 0x4f, 0x4b, 0xc0, 0x5b, 0x50, 0x09, 0x12, 0x1f,
+	0xab, 0x56, 0xb8, 0xc0, 0x6a, 0x8a, 0x91, 0xe2, 0x83, 0xba, 0x3a, 0x79, 0xc8, 0xbb, 0x97, 0xb2,
+	0xbd, 0x7b, 0x79, 0x62, 0x56, 0x3b, 0x32, 0x45, 0x56, 0x5b, 0x19, 0xcc, 0x6a, 0xff, 0x46, 0xb0,
+	0x36, 0x14, 0x9c, 0x76, 0x3d, 0x9a, 0x79, 0x0c, 0x08, 0x2c, 0x04, 0x1e, 0x35, 0x78, 0xa6, 0xaa,
+	0xb4, 0xae, 0xcf, 0x2d, 0x5a, 0x71, 0xb9, 0x9c, 0x75, 0x56, 0x40, 0x9d, 0x31, 0x2e, 0xfc, 0x08,
+	0xc1, 0x47, 0x15, 0x99, 0x37, 0x49, 0x68, 0x74, 0xb2, 0x94, 0x65, 0xe7, 0x97, 0xcd, 0x11, 0x79,
+	0x39, 0x22, 0x98, 0x55, 0xf9, 0xc7, 0xad, 0x03, 0x8f, 0x01, 0x64, 0xbf, 0x24, 0x03, 0x33, 0x16,
+	0x4f, 0x3f, 0x47, 0x50, 0x53, 0x63, 0xb8, 0x6b, 0xdb, 0xaf, 0x13, 0x63, 0x3f, 0x0b, 0xe4, 0x11,
+	0xc8, 0x59, 0x26, 0x47, 0x98, 0xd7, 0x73, 0x96, 0x79, 0xc8, 0x60, 0x34, 0x08, 0xb7, 0x98, 0x0d,
+	0x77, 0x31, 0x0d, 0xf7, 0x83, 0x01, 0xb8, 0x32, 0x24, 0x64, 0xc0, 0x5d, 0x83, 0xb2, 0x33, 0x50,
+	0xc8, 0x26, 0x03, 0x23, 0x0a, 0xd8, 0xdc, 0x50, 0x01, 0x5b, 0x85, 0xc5, 0x7e, 0x7c, 0xcd, 0x61,
+	0x3f, 0x4b, 0x92, 0xa9, 0xd8, 0xf6, 0xdd, 0x9e, 0x27, 0x8c, 0x1e, 0x11, 0x0c, 0xc5, 0xbe, 0xe5,
+	0xb0, 0x92, 0x9c, 0xa3, 0x60, 0xdf, 0x87, 0xbf, 0xd8, 0xa4, 0xd4, 0xfe, 0x45, 0x0e, 0x3e, 0x36,
+	0x42, 0xed, 0x89, 0xfe, 0xf4, 0x70, 0xe8, 0x1e, 0x7b, 0xf5, 0xe2, 0x58, 0xaf, 0x2e, 0x4d, 0xf2,
+	0xea, 0x72, 0xb6, 0xbd, 0x20, 0x6d, 0xaf, 0x9f, 0xe6, 0x60, 0x7d, 0x84, 0xbd, 0x26, 0x97, 0x13,
+	0x0f, 0x8d, 0xc1, 0xf6, 0x5c, 0x5f, 0x78, 0x49, 0x49, 0x8f, 0x08, 0x76, 0xce, 0x5c, 0xdf, 0xeb,
+	0x10, 0x87, 0x7b, 0x47, 0x49, 0x17, 0xd4, 0x8c, 0xa6, 0xfa, 0x46, 0x0e, 0xaa, 0xd2, 0x3e, 0x97,
+	0x0d, 0x6e, 0xad, 0x9e, 0xf3, 0xf0, 0x9b, 0x68, 0x15, 0x8a, 0x84, 0xa3, 0x15, 0x4e, 0x25, 0xa8,
+	0x21, 0x63, 0x94, 0xb2, 0x8d, 0x51, 0x4e, 0x1b, 0xe3, 0x4d, 0x04, 0x27, 0xd3, 0xc6, 0x08, 0x76,
+	0xac, 0x20, 0x94, 0x97, 0x03, 0xbc, 0x07, 0x8b, 0x91, 0x9c, 0xa8, 0xb4, 0xab, 0xb4, 0x76, 0x66,
+	0x4d, 0xf8, 0x29, 0xc3, 0x4b, 0xe6, 0xda, 0x33, 0x70, 0x72, 0x64, 0x94, 0x13, 0x30, 0x6a, 0x50,
+	0x92, 0x45, 0x8e, 0xd8, 0x9a, 0x98, 0xd6, 0xde, 0x5c, 0x48, 0xa7, 0x1c, 0xd7, 0xdc, 0x71, 0xdb,
+	0x19, 0xf7, 0xfd, 0xec, 0xed, 0x64, 0xa6, 0x72, 0x4d, 0xe5, 0x6a, 0x2f, 0x49, 0xb6, 0xce, 0x70,
+	0x9d, 0x90, 0x58, 0x0e, 0xf5, 0x45, 0x56, 0x4c, 0x06, 0xd8, 0x36, 0x04, 0x96, 0x63, 0xd0, 0x5d,
+	0x6a, 0xb8, 0x8e, 0x19, 0xf0, 0xfd, 0xcc, 0xeb, 0xa9, 0x31, 0xfc, 0x32, 0x94, 0x39, 0x7d, 0xcb,
+	0xea, 0x46, 0x69, 0xa0, 0xd2, 0xda, 0x68, 0x44, 0x3d, 0xb8, 0x86, 0xda, 0x83, 0x4b, 0x6c, 0xc8,
+	0x7a, 0x70, 0x8d, 0xfe, 0xa5, 0x06, 0x5b, 0xa1, 0x27, 0x8b, 0x19, 0x96, 0x90, 0x58, 0xf6, 0x8e,
+	0xe5, 0xf0, 0xc2, 0x93, 0x89, 0x4a, 0x06, 0x98, 0xab, 0xec, 0xb9, 0xb6, 0xed, 0xde, 0x95, 0xe7,
+	0x26, 0xa2, 0xd8, 0xaa, 0x9e, 0x13, 0x5a, 0x36, 0x97, 0x1f, 0x39, 0x42, 0x32, 0xc0, 0x57, 0x59,
+	0x76, 0x48, 0x7d, 0x71, 0x60, 0x04, 0x15, 0x3b, 0x63, 0x85, 0x8f, 0xc6, 0xe7, 0x35, 0x72, 0xdb,
+	0x25, 0xd5, 0x6d, 0x07, 0x8f, 0xc2, 0xf2, 0x88, 0xde, 0x08, 0xef, 0xb2, 0xd1, 0xbe, 0xe5, 0xf6,
+	0x58, 0x4d, 0xc5, 0x4b, 0x0f, 0x49, 0x0f, 0xb9, 0xf2, 0x4a, 0xb6, 0x2b, 0x1f, 0x4d, 0xbb, 0xf2,
+	0xef, 0x11, 0x94, 0x76, 0xdc, 0xf6, 0x15, 0x27, 0xf4, 0x0f, 0xd8, 0x34, 0xb6, 0x37, 0xd4, 0x91,
+	0xfe, 0x22, 0x49, 0xb6, 0x09, 0xa1, 0xd5, 0xa5, 0xbb, 0x21, 0xe9, 0x7a, 0xa2, 0xc6, 0x3a, 0xd4,
+	0x26, 0xc4, 0x8b, 0x99, 0x61, 0x6c, 0x12, 0x84, 0xfc, 0xc4, 0x97, 0x74, 0xfe, 0xcd, 0x54, 0x88,
+	0x27, 0xec, 0x86, 0xbe, 0x38, 0xee, 0xa9, 0x31, 0xd5, 0xc5, 0x0a, 0x11, 0x36, 0x41, 0x6a, 0x5d,
+	0x78, 0x34, 0x2e, 0xfe, 0x6f, 0x51, 0xbf, 0x6b, 0x39, 0x24, 0x3b, 0x7a, 0x4f, 0xd1, 0xde, 0xcb,
+	0xb8, 0x7b, 0xba, 0xa9, 0x43, 0xc7, 0x6a, 0xe9, 0xdb, 0x96, 0x63, 0xba, 0x77, 0x33, 0x0e, 0xcf,
+	0x6c, 0x02, 0xff, 0x96, 0xee, 0xd0, 0x29, 0x12, 0xe3, 0x93, 0xfe, 0x32, 0x2c, 0xb3, 0x98, 0xd0,
+	0xa7, 0xe2, 0x07, 0x11, 0x76, 0xb4, 0x71, 0xcd, 0x92, 0x84, 0x87, 0x9e, 0x5e, 0x88, 0x77, 0x60,
+	0x85, 0x04, 0x81, 0xd5, 0x76, 0xa8, 0x29, 0x79, 0xe5, 0xa6, 0xe6, 0x35, 0xb8, 0x34, 0xba, 0x76,
+	0xf3, 0x19, 0x62, 0xbf, 0x25, 0xa9, 0x7d, 0x1d, 0xc1, 0x89, 0x91, 0x4c, 0xe2, 0x93, 0x83, 0x94,
+	0x30, 0xce, 0xfa, 0xc3, 0x46, 0x87, 0x9a, 0x3d, 0x9b, 0xca, 0x5e, 0x94, 0xa4, 0xd9, 0x6f, 0x66,
+	0x2f, 0xda, 0x7d, 0x91, 0x46, 0x62, 0x1a, 0x9f, 0x02, 0xe8, 0x12, 0xa7, 0x47, 0x6c, 0x0e, 0x61,
+	0x81, 0x43, 0x50, 0x46, 0xb4, 0x35, 0xa8, 0x8d, 0x72, 0x1d, 0xd1, 0xe3, 0x79, 0x3f, 0x07, 0x47,
+	0x64, 0x50, 0x15, 0xbb, 0x5b, 0x87, 0x15, 0xc5, 0x0c, 0x37, 0x92, 0x8d, 0x1e, 0x1c, 0x9e, 0x10,
+	0x30, 0xa5, 0x97, 0xe4, 0xd3, 0x4d, 0xf6, 0x7e, 0xaa, 0x4d, 0x3e, 0x75, 0xbe, 0x43, 0xf3, 0xa9,
+	0x1f, 0x87, 0x42, 0x51, 0x79, 0x44, 0x28, 0x5a, 0x85, 0x62, 0x87, 0x12, 0x3b, 0xec, 0xc8, 0x80,
+	0x17, 0x51, 0x6c, 0x3c, 0xa0, 0xc4, 0x37, 0x3a, 0x22, 0xe4, 0x09, 0x8a, 0xb5, 0x75, 0x2d, 0xc7,
+	0xf0, 0x69, 0x97, 0x3a, 0x21, 0xb1, 0x79, 0xe8, 0x2b, 0xe9, 0xea, 0x90, 0xf6, 0x35, 0xa8, 0x5e,
+	0x27, 0x0e, 0x69, 0x53, 0x33, 0x36, 0x76, 0xec, 0xd8, 0x5f, 0x56, 0x5b, 0x24, 0x33, 0x37, 0x24,
+	0xe2, 0x02, 0xcf, 0xda, 0xdb, 0x93, 0xed, 0x16, 0x1f, 0x4a, 0x3b, 0x96, 0xb3, 0xcf, 0x6e, 0xed,
+	0xcc, 0xce, 0xa1, 0x15, 0xda, 0x72, 0x4f, 0x23, 0x02, 0x1f, 0x85, 0x7c, 0xcf, 0xb7, 0x85, 0xdf,
+	0xb1, 0x4f, 0xa6, 0x93, 0x49, 0x03, 0xc3, 0xb7, 0x3c, 0xe1, 0x75, 0xbc, 0x55, 0xad, 0x0c, 0xb1,
+	0xdd, 0xb7, 0x0c, 0xd7, 0xd9, 0xb6, 0x49, 0x10, 0xc8, 0xb4, 0x17, 0x0f, 0x68, 0xcf, 0xc1, 0x32,
+	0x93, 0x99, 0xa8, 0x79, 0x3e, 0xad, 0xe6, 0x89, 0x14, 0x7c, 0x09, 0x4f, 0x22, 0x26, 0xf0, 0x08,
+	0xab, 0x36, 0x2e, 0x7b, 0x9e, 0x60, 0x32, 0x65, 0x11, 0x96, 0x1f, 0x95, 0xb5, 0x47, 0x76, 0x68,
+	0x5b, 0xff, 0x38, 0x0d, 0x58, 0x3d, 0x9d, 0xd4, 0xef, 0x5b, 0x06, 0xc5, 0xdf, 0x41, 0xb0, 0xc0,
+	0x44, 0xe3, 0xc7, 0xc6, 0x05, 0x03, 0x7e, 0x4a, 0x6a, 0xf3, 0xbb, 0x7e, 0x33, 0x69, 0xda, 0xda,
+	0x1b, 0x7f, 0x7f, 0xff, 0xbb, 0xb9, 0x55, 0x7c, 0x9c, 0xbf, 0xcb, 0xf5, 0x2f, 0xa9, 0x6f, 0x64,
+	0x01, 0x7e, 0x0b, 0x01, 0x16, 0xd5, 0x97, 0xf2, 0x72, 0x81, 0xcf, 0x8f, 0x83, 0x38, 0xe2, 0x85,
+	0xa3, 0xf6, 0x98, 0x92, 0xcb, 0x1a, 0x86, 0xeb, 0x53, 0x96, 0xb9, 0xf8, 0x04, 0x0e, 0x60, 0x83,
+	0x03, 0x38, 0x83, 0xb5, 0x51, 0x00, 0x9a, 0xf7, 0x98, 0x45, 0xef, 0x37, 0x69, 0x24, 0xf7, 0x1d,
+	0x04, 0x85, 0xdb, 0xfc, 0xe6, 0x32, 0xc1, 0x48, 0xbb, 0x73, 0x33, 0x12, 0x17, 0xc7, 0xd1, 0x6a,
+	0xa7, 0x39, 0xd2, 0xc7, 0xf0, 0x49, 0x89, 0x34, 0x08, 0x7d, 0x4a, 0xba, 0x29, 0xc0, 0x17, 0x11,
+	0x7e, 0x17, 0x41, 0x31, 0x6a, 0x59, 0xe3, 0xb3, 0xe3, 0x50, 0xa6, 0x5a, 0xda, 0xb5, 0xf9, 0xf5,
+	0x7f, 0xb5, 0x27, 0x39, 0xc6, 0xd3, 0xda, 0xc8, 0xed, 0xdc, 0x4a, 0x75, 0x87, 0xdf, 0x46, 0x90,
+	0xbf, 0x4a, 0x27, 0xfa, 0xdb, 0x1c, 0xc1, 0x0d, 0x19, 0x70, 0xc4, 0x56, 0xe3, 0x9f, 0x20, 0x78,
+	0xf4, 0x2a, 0x0d, 0x47, 0x27, 0x65, 0x5c, 0x9f, 0x9c, 0x29, 0x85, 0xdb, 0x9d, 0x9f, 0x62, 0x66,
+	0x9c, 0x8d, 0x9a, 0x1c, 0xd9, 0x93, 0xf8, 0x5c, 0x96, 0x13, 0xb2, 0x6e, 0xde, 0x5d, 0x81, 0xe3,
+	0x2f, 0x08, 0x8e, 0x0e, 0xbe, 0x50, 0xe2, 0x74, 0x1a, 0x1f, 0xf9, 0x80, 0x59, 0xbb, 0x31, 0x6b,
+	0x94, 0x4d, 0x33, 0xd5, 0x2e, 0x73, 0xe4, 0xcf, 0xe2, 0x67, 0xb2, 0x90, 0xc7, 0xfd, 0xbf, 0xe6,
+	0x3d, 0xf9, 0x79, 0xbf, 0xd9, 0x15, 0x2c, 0xf0, 0x5f, 0x11, 0x1c, 0x97, 0x7c, 0xb7, 0x3b, 0xc4,
+	0x0f, 0x5f, 0xa4, 0xac, 0x72, 0x0f, 0xa6, 0xd2, 0x67, 0xc6, 0xac, 0xa1, 0xca, 0xd3, 0xae, 0x70,
+	0x5d, 0x3e, 0x85, 0x9f, 0x3f, 0xb4, 0x2e, 0x06, 0x63, 0x63, 0x0a, 0xd8, 0x6f, 0x20, 0x58, 0xba,
+	0x4a, 0xc3, 0xeb, 0x71, 0x0f, 0xfa, 0xec, 0x54, 0xef, 0x5a, 0xb5, 0xb5, 0x86, 0xf2, 0x88, 0x2f,
+	0x7f, 0x8a, 0x5d, 0x64, 0x93, 0x83, 0x3b, 0x87, 0xcf, 0x66, 0x81, 0x4b, 0xfa, 0xde, 0xef, 0x20,
+	0x38, 0xa1, 0x82, 0x48, 0xde, 0x03, 0x3f, 0x71, 0xb8, 0x57, 0x36, 0xf1, 0x56, 0x37, 0x01, 0x5d,
+	0x8b, 0xa3, 0xbb, 0xa0, 0x8d, 0x76, 0xe0, 0xee, 0x10, 0x8a, 0x2d, 0xb4, 0x51, 0x47, 0xf8, 0x0f,
+	0x08, 0x8a, 0x51, 0x0b, 0x78, 0xbc, 0x8d, 0x52, 0xef, 0x57, 0xf3, 0x8c, 0x06, 0x62, 0xb7, 0x6b,
+	0x17, 0x47, 0x1b, 0x54, 0x5d, 0x2f, 0x5d, 0xb5, 0xc1, 0xad, 0x9c, 0x0e, 0x63, 0xbf, 0x46, 0x00,
+	0x49, 0x1b, 0x1b, 0x3f, 0x99, 0xad, 0x87, 0xd2, 0xea, 0xae, 0xcd, 0xb7, 0x91, 0xad, 0x35, 0xb8,
+	0x3e, 0xf5, 0xda, 0x7a, 0x66, 0x0c, 0xf1, 0xa8, 0xb1, 0x15, 0xb5, 0xbc, 0x7f, 0x8c, 0xa0, 0xc0,
+	0xbb, 0x87, 0xf8, 0xcc, 0x38, 0xcc, 0x6a, 0x73, 0x71, 0x9e, 0xa6, 0x7f, 0x82, 0x43, 0x5d, 0x6f,
+	0x65, 0x05, 0xe2, 0x2d, 0xb4, 0x81, 0xfb, 0x50, 0x8c, 0xfa, 0x75, 0xe3, 0xdd, 0x23, 0xd5, 0xcf,
+	0xab, 0xad, 0x67, 0x14, 0x06, 0x91, 0xa3, 0x8a, 0x1c, 0xb0, 0x31, 0x29, 0x07, 0x2c, 0xb0, 0x30,
+	0x8d, 0x4f, 0x67, 0x05, 0xf1, 0xff, 0x83, 0x61, 0xce, 0x73, 0x74, 0x67, 0xb5, 0xf5, 0x49, 0x79,
+	0x80, 0x59, 0xe7, 0x7b, 0x08, 0x8e, 0x0e, 0x16, 0xd7, 0xf8, 0xe4, 0x40, 0xcc, 0x54, 0x6f, 0x38,
+	0xb5, 0xb4, 0x15, 0xc7, 0x15, 0xe6, 0xda, 0xa7, 0x39, 0x8a, 0x2d, 0xfc, 0xf4, 0xc4, 0x93, 0x71,
+	0x43, 0x46, 0x1d, 0xc6, 0x68, 0x33, 0x79, 0x93, 0xfb, 0x0d, 0x82, 0x25, 0xc9, 0xf7, 0x96, 0x4f,
+	0x69, 0x36, 0xac, 0xf9, 0x1d, 0x04, 0x26, 0x4b, 0x7b, 0x8e, 0xc3, 0xff, 0x24, 0x7e, 0x6a, 0x4a,
+	0xf8, 0x12, 0xf6, 0x66, 0xc8, 0x90, 0xfe, 0x09, 0xc1, 0xb1, 0xdb, 0x91, 0xdf, 0x7f, 0x48, 0xf8,
+	0xb7, 0x39, 0xfe, 0xe7, 0xf1, 0xb3, 0x19, 0x75, 0xde, 0x24, 0x35, 0x2e, 0x22, 0xfc, 0x4b, 0x04,
+	0x25, 0xf9, 0x96, 0x83, 0xcf, 0x8d, 0x3d, 0x18, 0xe9, 0xd7, 0x9e, 0x79, 0x3a, 0xb3, 0x28, 0x6a,
+	0xb4, 0x33, 0x99, 0xe9, 0x54, 0xc8, 0x67, 0x0e, 0xfd, 0x36, 0x02, 0x1c, 0xdf, 0xd4, 0xe3, 0xbb,
+	0x3b, 0x7e, 0x22, 0x25, 0x6a, 0x6c, 0x3b, 0xa8, 0x76, 0x6e, 0xe2, 0xbc, 0x74, 0x2a, 0xdd, 0xc8,
+	0x4c, 0xa5, 0x6e, 0x2c, 0xff, 0x9b, 0x08, 0x2a, 0x57, 0x69, 0x7c, 0x07, 0xc9, 0xb0, 0x65, 0xfa,
+	0x29, 0xaa, 0x56, 0x9f, 0x3c, 0x51, 0x20, 0xba, 0xc0, 0x11, 0x3d, 0x81, 0xb3, 0x4d, 0x25, 0x01,
+	0xfc, 0x00, 0xc1, 0xf2, 0x4d, 0xd5, 0x45, 0xf1, 0x85, 0x49, 0x92, 0x52, 0x91, 0x7c, 0x7a, 0x5c,
+	0x1f, 0xe7, 0xb8, 0x36, 0xb5, 0xa9, 0x70, 0x6d, 0x89, 0x57, 0x9d, 0x1f, 0xa2, 0xe8, 0x12, 0x3b,
+	0xd0, 0x45, 0xff, 0x5f, 0xed, 0x96, 0xd1, 0x8c, 0xd7, 0x9e, 0xe2, 0xf8, 0x1a, 0xf8, 0xc2, 0x34,
+	0xf8, 0x9a, 0xa2, 0xb5, 0x8e, 0xbf, 0x8f, 0xe0, 0x18, 0x7f, 0xe1, 0x50, 0x19, 0x0f, 0xa4, 0x98,
+	0x71, 0xef, 0x21, 0x53, 0xa4, 0x18, 0x11, 0x7f, 0xb4, 0x43, 0x81, 0xda, 0x92, 0xaf, 0x17, 0xdf,
+	0x42, 0x70, 0x44, 0x26, 0x35, 0xb1, 0xbb, 0x9b, 0x93, 0x0c, 0x77, 0xd8, 0x24, 0x28, 0xdc, 0x6d,
+	0x63, 0x3a, 0x77, 0x7b, 0x17, 0xc1, 0xa2, 0x78, 0x43, 0xc8, 0x28, 0x15, 0x94, 0x47, 0x86, 0xda,
+	0x40, 0x8f, 0x43, 0xb4, 0xa0, 0xb5, 0x2f, 0x72, 0xb1, 0xaf, 0xe2, 0x66, 0x96, 0x58, 0xcf, 0x35,
+	0x83, 0xe6, 0x3d, 0xd1, 0xff, 0xbd, 0xdf, 0xb4, 0xdd, 0x76, 0xf0, 0x9a, 0x86, 0x33, 0x13, 0x22,
+	0x9b, 0x73, 0x11, 0xe1, 0x10, 0xca, 0xcc, 0x39, 0x78, 0xe3, 0x04, 0xa7, 0x8d, 0x30, 0xa2, 0xa7,
+	0x52, 0xab, 0x0d, 0x35, 0x62, 0x92, 0x0c, 0x28, 0xae, 0xb1, 0xf8, 0xf1, 0x4c, 0xb1, 0x5c, 0xd0,
+	0x5b, 0x08, 0x8e, 0xa9, 0xde, 0x1e, 0x89, 0x9f, 0xda, 0xd7, 0xb3, 0x50, 0x88, 0xa2, 0x1a, 0x6f,
+	0x4c, 0xe5, 0x48, 0x1c, 0xce, 0x0b, 0x2f, 0xfd, 0xf9, 0xc1, 0x29, 0xf4, 0xde, 0x83, 0x53, 0xe8,
+	0x9f, 0x0f, 0x4e, 0xa1, 0xd7, 0x9e, 0x9e, 0xee, 0x9f, 0xc9, 0x86, 0x6d, 0x51, 0x27, 0x54, 0xd9,
+	0xff, 0x77, 0x00, 0xe9, 0xa0, 0xc6, 0xe7, 0x7f, 0x2d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6391,6 +6425,37 @@ func (m *ResourcesQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Incremental != nil {
+		i--
+		if *m.Incremental {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Search != nil {
+		i -= len(*m.Search)
+		copy(dAtA[i:], *m.Search)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Search)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Health != nil {
+		i -= len(*m.Health)
+		copy(dAtA[i:], *m.Health)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Health)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ResourceName != nil {
+		i -= len(*m.ResourceName)
+		copy(dAtA[i:], *m.ResourceName)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceName)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Project != nil {
 		i -= len(*m.Project)
 		copy(dAtA[i:], *m.Project)
@@ -7674,6 +7739,21 @@ func (m *ResourcesQuery) Size() (n int) {
 		l = len(*m.Project)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if m.ResourceName != nil {
+		l = len(*m.ResourceName)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Health != nil {
+		l = len(*m.Health)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Search != nil {
+		l = len(*m.Search)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Incremental != nil {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14633,6 +14713,126 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Project = &s
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ResourceName = &s
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Health = &s
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Search = &s
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Incremental = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -4593,724 +4593,720 @@ func init() {
 }
 
 var fileDescriptor_030104ce3b95bcac = []byte{
-	// 11458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x1c, 0xc9,
-	0x75, 0x98, 0x66, 0x17, 0x0b, 0xec, 0x3e, 0x7c, 0x11, 0x4d, 0xf2, 0x0e, 0xa4, 0xee, 0x0e, 0xf4,
-	0x9c, 0x7d, 0x3a, 0x47, 0x77, 0x80, 0x8f, 0xba, 0x93, 0x2f, 0x3a, 0x4b, 0x32, 0x3e, 0x48, 0x10,
-	0x24, 0x40, 0xe0, 0x1a, 0x20, 0x29, 0x9d, 0x7c, 0x3a, 0x0d, 0x66, 0x1b, 0x8b, 0x21, 0x66, 0x67,
-	0xf6, 0x66, 0x66, 0x41, 0xe0, 0x2c, 0xc9, 0x92, 0x25, 0xd9, 0x72, 0xf4, 0x71, 0x8a, 0x94, 0xaa,
-	0x9c, 0x13, 0x4b, 0x91, 0x2d, 0x27, 0x95, 0x54, 0x4a, 0x15, 0x25, 0xf9, 0x11, 0xa7, 0x6c, 0x97,
-	0x2b, 0x76, 0xca, 0xa5, 0xc4, 0x49, 0xd9, 0x51, 0xa9, 0x2c, 0x25, 0xb1, 0x11, 0x89, 0x71, 0xca,
-	0xae, 0xfc, 0x70, 0x55, 0x9c, 0xfc, 0x48, 0x31, 0xf9, 0x91, 0xea, 0xef, 0x9e, 0xd9, 0x59, 0x60,
-	0x41, 0x0c, 0x48, 0x4a, 0xb9, 0x7f, 0xbb, 0xfd, 0x5e, 0xf7, 0xeb, 0xe9, 0x8f, 0xf7, 0x5e, 0xbf,
-	0x7e, 0xef, 0x35, 0x2c, 0x36, 0xbc, 0x64, 0xb3, 0xbd, 0x3e, 0xe9, 0x86, 0xcd, 0x29, 0x27, 0x6a,
-	0x84, 0xad, 0x28, 0xbc, 0xc9, 0x7e, 0x3c, 0xed, 0xd6, 0xa7, 0xb6, 0xcf, 0x4f, 0xb5, 0xb6, 0x1a,
-	0x53, 0x4e, 0xcb, 0x8b, 0xa7, 0x9c, 0x56, 0xcb, 0xf7, 0x5c, 0x27, 0xf1, 0xc2, 0x60, 0x6a, 0xfb,
-	0x19, 0xc7, 0x6f, 0x6d, 0x3a, 0xcf, 0x4c, 0x35, 0x48, 0x40, 0x22, 0x27, 0x21, 0xf5, 0xc9, 0x56,
-	0x14, 0x26, 0x21, 0xfa, 0x29, 0xdd, 0xda, 0xa4, 0x6c, 0x8d, 0xfd, 0x78, 0xc5, 0xad, 0x4f, 0x6e,
-	0x9f, 0x9f, 0x6c, 0x6d, 0x35, 0x26, 0x69, 0x6b, 0x93, 0x46, 0x6b, 0x93, 0xb2, 0xb5, 0xb3, 0x4f,
-	0x1b, 0x7d, 0x69, 0x84, 0x8d, 0x70, 0x8a, 0x35, 0xba, 0xde, 0xde, 0x60, 0xff, 0xd8, 0x1f, 0xf6,
-	0x8b, 0x13, 0x3b, 0x6b, 0x6f, 0x3d, 0x1f, 0x4f, 0x7a, 0x21, 0xed, 0xde, 0x94, 0x1b, 0x46, 0x64,
-	0x6a, 0xbb, 0xa3, 0x43, 0x67, 0x2f, 0x69, 0x1c, 0xb2, 0x93, 0x90, 0x20, 0xf6, 0xc2, 0x20, 0x7e,
-	0x9a, 0x76, 0x81, 0x44, 0xdb, 0x24, 0x32, 0x3f, 0xcf, 0x40, 0xc8, 0x6b, 0xe9, 0x59, 0xdd, 0x52,
-	0xd3, 0x71, 0x37, 0xbd, 0x80, 0x44, 0xbb, 0xba, 0x7a, 0x93, 0x24, 0x4e, 0x5e, 0xad, 0xa9, 0x6e,
-	0xb5, 0xa2, 0x76, 0x90, 0x78, 0x4d, 0xd2, 0x51, 0xe1, 0x9d, 0x07, 0x55, 0x88, 0xdd, 0x4d, 0xd2,
-	0x74, 0x3a, 0xea, 0xbd, 0xa3, 0x5b, 0xbd, 0x76, 0xe2, 0xf9, 0x53, 0x5e, 0x90, 0xc4, 0x49, 0x94,
-	0xad, 0x64, 0xff, 0x8a, 0x05, 0xc3, 0xd3, 0x37, 0x56, 0xa7, 0xdb, 0xc9, 0xe6, 0x6c, 0x18, 0x6c,
-	0x78, 0x0d, 0xf4, 0x1c, 0x0c, 0xba, 0x7e, 0x3b, 0x4e, 0x48, 0x74, 0xd5, 0x69, 0x92, 0x71, 0xeb,
-	0x9c, 0xf5, 0x64, 0x6d, 0xe6, 0xe4, 0x37, 0xf7, 0x26, 0xde, 0x72, 0x7b, 0x6f, 0x62, 0x70, 0x56,
-	0x83, 0xb0, 0x89, 0x87, 0x7e, 0x1c, 0x06, 0xa2, 0xd0, 0x27, 0xd3, 0xf8, 0xea, 0x78, 0x89, 0x55,
-	0x19, 0x15, 0x55, 0x06, 0x30, 0x2f, 0xc6, 0x12, 0x4e, 0x51, 0x5b, 0x51, 0xb8, 0xe1, 0xf9, 0x64,
-	0xbc, 0x9c, 0x46, 0x5d, 0xe1, 0xc5, 0x58, 0xc2, 0xed, 0x3f, 0x2e, 0x01, 0x4c, 0xb7, 0x5a, 0x2b,
-	0x51, 0x78, 0x93, 0xb8, 0x09, 0xfa, 0x10, 0x54, 0xe9, 0x30, 0xd7, 0x9d, 0xc4, 0x61, 0x1d, 0x1b,
-	0x3c, 0xff, 0x13, 0x93, 0xfc, 0xab, 0x27, 0xcd, 0xaf, 0xd6, 0x8b, 0x8c, 0x62, 0x4f, 0x6e, 0x3f,
-	0x33, 0xb9, 0xbc, 0x4e, 0xeb, 0x2f, 0x91, 0xc4, 0x99, 0x41, 0x82, 0x18, 0xe8, 0x32, 0xac, 0x5a,
-	0x45, 0x01, 0xf4, 0xc5, 0x2d, 0xe2, 0xb2, 0x6f, 0x18, 0x3c, 0xbf, 0x38, 0x79, 0x94, 0xd5, 0x3c,
-	0xa9, 0x7b, 0xbe, 0xda, 0x22, 0xee, 0xcc, 0x90, 0xa0, 0xdc, 0x47, 0xff, 0x61, 0x46, 0x07, 0x6d,
-	0x43, 0x7f, 0x9c, 0x38, 0x49, 0x3b, 0x66, 0x43, 0x31, 0x78, 0xfe, 0x6a, 0x61, 0x14, 0x59, 0xab,
-	0x33, 0x23, 0x82, 0x66, 0x3f, 0xff, 0x8f, 0x05, 0x35, 0xfb, 0x4f, 0x2d, 0x18, 0xd1, 0xc8, 0x8b,
-	0x5e, 0x9c, 0xa0, 0x9f, 0xe9, 0x18, 0xdc, 0xc9, 0xde, 0x06, 0x97, 0xd6, 0x66, 0x43, 0x7b, 0x42,
-	0x10, 0xab, 0xca, 0x12, 0x63, 0x60, 0x9b, 0x50, 0xf1, 0x12, 0xd2, 0x8c, 0xc7, 0x4b, 0xe7, 0xca,
-	0x4f, 0x0e, 0x9e, 0xbf, 0x54, 0xd4, 0x77, 0xce, 0x0c, 0x0b, 0xa2, 0x95, 0x05, 0xda, 0x3c, 0xe6,
-	0x54, 0xec, 0xbf, 0x1a, 0x36, 0xbf, 0x8f, 0x0e, 0x38, 0x7a, 0x06, 0x06, 0xe3, 0xb0, 0x1d, 0xb9,
-	0x04, 0x93, 0x56, 0x18, 0x8f, 0x5b, 0xe7, 0xca, 0x74, 0xe9, 0xd1, 0x45, 0xbd, 0xaa, 0x8b, 0xb1,
-	0x89, 0x83, 0x3e, 0x6f, 0xc1, 0x50, 0x9d, 0xc4, 0x89, 0x17, 0x30, 0xfa, 0xb2, 0xf3, 0x6b, 0x47,
-	0xee, 0xbc, 0x2c, 0x9c, 0xd3, 0x8d, 0xcf, 0x9c, 0x12, 0x1f, 0x32, 0x64, 0x14, 0xc6, 0x38, 0x45,
-	0x9f, 0x6e, 0xce, 0x3a, 0x89, 0xdd, 0xc8, 0x6b, 0xd1, 0xff, 0x62, 0xfb, 0xa8, 0xcd, 0x39, 0xa7,
-	0x41, 0xd8, 0xc4, 0x43, 0x01, 0x54, 0xe8, 0xe6, 0x8b, 0xc7, 0xfb, 0x58, 0xff, 0x17, 0x8e, 0xd6,
-	0x7f, 0x31, 0xa8, 0x74, 0x5f, 0xeb, 0xd1, 0xa7, 0xff, 0x62, 0xcc, 0xc9, 0xa0, 0xcf, 0x59, 0x30,
-	0x2e, 0x98, 0x03, 0x26, 0x7c, 0x40, 0x6f, 0x6c, 0x7a, 0x09, 0xf1, 0xbd, 0x38, 0x19, 0xaf, 0xb0,
-	0x3e, 0x4c, 0xf5, 0xb6, 0xb6, 0xe6, 0xa3, 0xb0, 0xdd, 0xba, 0xe2, 0x05, 0xf5, 0x99, 0x73, 0x82,
-	0xd2, 0xf8, 0x6c, 0x97, 0x86, 0x71, 0x57, 0x92, 0xe8, 0x4b, 0x16, 0x9c, 0x0d, 0x9c, 0x26, 0x89,
-	0x5b, 0x0e, 0x9d, 0x5a, 0x0e, 0x9e, 0xf1, 0x1d, 0x77, 0x8b, 0xf5, 0xa8, 0xff, 0xee, 0x7a, 0x64,
-	0x8b, 0x1e, 0x9d, 0xbd, 0xda, 0xb5, 0x69, 0xbc, 0x0f, 0x59, 0xf4, 0x35, 0x0b, 0xc6, 0xc2, 0xa8,
-	0xb5, 0xe9, 0x04, 0xa4, 0x2e, 0xa1, 0xf1, 0xf8, 0x00, 0xdb, 0x7a, 0x1f, 0x3c, 0xda, 0x14, 0x2d,
-	0x67, 0x9b, 0x5d, 0x0a, 0x03, 0x2f, 0x09, 0xa3, 0x55, 0x92, 0x24, 0x5e, 0xd0, 0x88, 0x67, 0x4e,
-	0xdf, 0xde, 0x9b, 0x18, 0xeb, 0xc0, 0xc2, 0x9d, 0xfd, 0x41, 0x3f, 0x0b, 0x83, 0xf1, 0x6e, 0xe0,
-	0xde, 0xf0, 0x82, 0x7a, 0x78, 0x2b, 0x1e, 0xaf, 0x16, 0xb1, 0x7d, 0x57, 0x55, 0x83, 0x62, 0x03,
-	0x6a, 0x02, 0xd8, 0xa4, 0x96, 0x3f, 0x71, 0x7a, 0x29, 0xd5, 0x8a, 0x9e, 0x38, 0xbd, 0x98, 0xf6,
-	0x21, 0x8b, 0x7e, 0xd1, 0x82, 0xe1, 0xd8, 0x6b, 0x04, 0x4e, 0xd2, 0x8e, 0xc8, 0x15, 0xb2, 0x1b,
-	0x8f, 0x03, 0xeb, 0xc8, 0xe5, 0x23, 0x8e, 0x8a, 0xd1, 0xe4, 0xcc, 0x69, 0xd1, 0xc7, 0x61, 0xb3,
-	0x34, 0xc6, 0x69, 0xba, 0x79, 0x1b, 0x4d, 0x2f, 0xeb, 0xc1, 0x62, 0x37, 0x9a, 0x5e, 0xd4, 0x5d,
-	0x49, 0xa2, 0x9f, 0x86, 0x13, 0xbc, 0x48, 0x8d, 0x6c, 0x3c, 0x3e, 0xc4, 0x18, 0xed, 0xa9, 0xdb,
-	0x7b, 0x13, 0x27, 0x56, 0x33, 0x30, 0xdc, 0x81, 0x8d, 0x5e, 0x85, 0x89, 0x16, 0x89, 0x9a, 0x5e,
-	0xb2, 0x1c, 0xf8, 0xbb, 0x92, 0x7d, 0xbb, 0x61, 0x8b, 0xd4, 0x45, 0x77, 0xe2, 0xf1, 0xe1, 0x73,
-	0xd6, 0x93, 0xd5, 0x99, 0xb7, 0x89, 0x6e, 0x4e, 0xac, 0xec, 0x8f, 0x8e, 0x0f, 0x6a, 0x0f, 0xfd,
-	0xbe, 0x05, 0x67, 0x0d, 0x2e, 0xbb, 0x4a, 0xa2, 0x6d, 0xcf, 0x25, 0xd3, 0xae, 0x1b, 0xb6, 0x83,
-	0x24, 0x1e, 0x1f, 0x61, 0xc3, 0xb8, 0x7e, 0x1c, 0x3c, 0x3f, 0x4d, 0x4a, 0xaf, 0xcb, 0xae, 0x28,
-	0x31, 0xde, 0xa7, 0xa7, 0xf6, 0xbf, 0x29, 0xc1, 0x89, 0xac, 0x06, 0x80, 0xfe, 0x81, 0x05, 0xa3,
-	0x37, 0x6f, 0x25, 0x6b, 0xe1, 0x16, 0x09, 0xe2, 0x99, 0x5d, 0xca, 0xa7, 0x99, 0xec, 0x1b, 0x3c,
-	0xef, 0x16, 0xab, 0x6b, 0x4c, 0x5e, 0x4e, 0x53, 0xb9, 0x10, 0x24, 0xd1, 0xee, 0xcc, 0xc3, 0xe2,
-	0x9b, 0x46, 0x2f, 0xdf, 0x58, 0x33, 0xa1, 0x38, 0xdb, 0xa9, 0xb3, 0x9f, 0xb1, 0xe0, 0x54, 0x5e,
-	0x13, 0xe8, 0x04, 0x94, 0xb7, 0xc8, 0x2e, 0xd7, 0x44, 0x31, 0xfd, 0x89, 0x5e, 0x86, 0xca, 0xb6,
-	0xe3, 0xb7, 0x89, 0x50, 0xd3, 0xe6, 0x8f, 0xf6, 0x21, 0xaa, 0x67, 0x98, 0xb7, 0xfa, 0xae, 0xd2,
-	0xf3, 0x96, 0xfd, 0x87, 0x65, 0x18, 0x34, 0x26, 0xed, 0x1e, 0xa8, 0x9e, 0x61, 0x4a, 0xf5, 0x5c,
-	0x2a, 0x6c, 0xbd, 0x75, 0xd5, 0x3d, 0x6f, 0x65, 0x74, 0xcf, 0xe5, 0xe2, 0x48, 0xee, 0xab, 0x7c,
-	0xa2, 0x04, 0x6a, 0x61, 0x8b, 0x1e, 0x43, 0xa8, 0x0e, 0xd3, 0x57, 0xc4, 0x14, 0x2e, 0xcb, 0xe6,
-	0x66, 0x86, 0x6f, 0xef, 0x4d, 0xd4, 0xd4, 0x5f, 0xac, 0x09, 0xd9, 0xdf, 0xb1, 0xe0, 0x94, 0xd1,
-	0xc7, 0xd9, 0x30, 0xa8, 0x7b, 0x6c, 0x6a, 0xcf, 0x41, 0x5f, 0xb2, 0xdb, 0x92, 0x47, 0x1d, 0x35,
-	0x52, 0x6b, 0xbb, 0x2d, 0x82, 0x19, 0x84, 0x9e, 0x58, 0x9a, 0x24, 0x8e, 0x9d, 0x06, 0xc9, 0x1e,
-	0x6e, 0x96, 0x78, 0x31, 0x96, 0x70, 0x14, 0x01, 0xf2, 0x9d, 0x38, 0x59, 0x8b, 0x9c, 0x20, 0x66,
-	0xcd, 0xaf, 0x79, 0x4d, 0x22, 0x06, 0xf8, 0xaf, 0xf5, 0xb6, 0x62, 0x68, 0x8d, 0x99, 0x87, 0x6e,
-	0xef, 0x4d, 0xa0, 0xc5, 0x8e, 0x96, 0x70, 0x4e, 0xeb, 0xf6, 0x97, 0x2c, 0x78, 0x28, 0x9f, 0xc1,
-	0xa0, 0x27, 0xa0, 0x9f, 0x9f, 0x73, 0xc5, 0xd7, 0xe9, 0x29, 0x61, 0xa5, 0x58, 0x40, 0xd1, 0x14,
-	0xd4, 0x94, 0xc0, 0x13, 0xdf, 0x38, 0x26, 0x50, 0x6b, 0x5a, 0x4a, 0x6a, 0x1c, 0x3a, 0x68, 0xf4,
-	0x8f, 0x50, 0x41, 0xd5, 0xa0, 0xb1, 0x83, 0x21, 0x83, 0xd8, 0xdf, 0xb6, 0xe0, 0x47, 0x7b, 0x61,
-	0x7b, 0xc7, 0xd7, 0xc7, 0x55, 0x38, 0x5d, 0x27, 0x1b, 0x4e, 0xdb, 0x4f, 0xd2, 0x14, 0x45, 0xa7,
-	0x1f, 0x15, 0x95, 0x4f, 0xcf, 0xe5, 0x21, 0xe1, 0xfc, 0xba, 0xf6, 0x7f, 0xb1, 0x60, 0xd4, 0xf8,
-	0xac, 0x7b, 0x70, 0x74, 0x0a, 0xd2, 0x47, 0xa7, 0x85, 0xc2, 0xb6, 0x69, 0x97, 0xb3, 0xd3, 0xe7,
-	0x2c, 0x38, 0x6b, 0x60, 0x2d, 0x39, 0x89, 0xbb, 0x79, 0x61, 0xa7, 0x15, 0x91, 0x38, 0xa6, 0x4b,
-	0xea, 0x51, 0x83, 0x1d, 0xcf, 0x0c, 0x8a, 0x16, 0xca, 0x57, 0xc8, 0x2e, 0xe7, 0xcd, 0x4f, 0x41,
-	0x95, 0xef, 0xb9, 0x30, 0x12, 0x93, 0xa4, 0xbe, 0x6d, 0x59, 0x94, 0x63, 0x85, 0x81, 0x6c, 0xe8,
-	0x67, 0x3c, 0x97, 0xf2, 0x20, 0xaa, 0x26, 0x00, 0x9d, 0xf7, 0xeb, 0xac, 0x04, 0x0b, 0x88, 0x1d,
-	0xa7, 0xba, 0xb3, 0x12, 0x11, 0xb6, 0x1e, 0xea, 0x17, 0x3d, 0xe2, 0xd7, 0x63, 0x7a, 0xac, 0x73,
-	0x82, 0x20, 0x4c, 0xc4, 0x09, 0xcd, 0x38, 0xd6, 0x4d, 0xeb, 0x62, 0x6c, 0xe2, 0x50, 0xa2, 0xbe,
-	0xb3, 0x4e, 0x7c, 0x3e, 0xa2, 0x82, 0xe8, 0x22, 0x2b, 0xc1, 0x02, 0x62, 0xdf, 0x2e, 0xb1, 0x03,
-	0xa4, 0xe2, 0x68, 0xe4, 0x5e, 0x58, 0x1f, 0xa2, 0x94, 0x08, 0x58, 0x29, 0x8e, 0x1f, 0x93, 0xee,
-	0x16, 0x88, 0xd7, 0x32, 0x52, 0x00, 0x17, 0x4a, 0x75, 0x7f, 0x2b, 0xc4, 0xc7, 0xca, 0x30, 0x91,
-	0xae, 0xd0, 0x21, 0x44, 0xe8, 0x91, 0xd7, 0x20, 0x94, 0xb5, 0x47, 0x19, 0xf8, 0xd8, 0xc4, 0xeb,
-	0xc2, 0x87, 0x4b, 0xc7, 0xc9, 0x87, 0x4d, 0x31, 0x51, 0x3e, 0x40, 0x4c, 0x3c, 0xa1, 0x46, 0xbd,
-	0x2f, 0xc3, 0xf3, 0xd2, 0xa2, 0xf2, 0x1c, 0xf4, 0xc5, 0x09, 0x69, 0x8d, 0x57, 0xd2, 0x6c, 0x76,
-	0x35, 0x21, 0x2d, 0xcc, 0x20, 0xe8, 0xdd, 0x30, 0x9a, 0x38, 0x51, 0x83, 0x24, 0x11, 0xd9, 0xf6,
-	0x98, 0xed, 0x92, 0x9d, 0x67, 0x6b, 0x33, 0x27, 0xa9, 0xd6, 0xb5, 0xc6, 0x40, 0x58, 0x82, 0x70,
-	0x16, 0xd7, 0xfe, 0xef, 0x25, 0x78, 0x38, 0x3d, 0x05, 0x5a, 0x30, 0xbe, 0x37, 0x25, 0x18, 0xdf,
-	0x6e, 0x0a, 0xc6, 0x3b, 0x7b, 0x13, 0x6f, 0xed, 0x52, 0xed, 0x07, 0x46, 0x6e, 0xa2, 0xf9, 0xcc,
-	0x24, 0x4c, 0xa5, 0x27, 0xe1, 0xce, 0xde, 0xc4, 0xa3, 0x5d, 0xbe, 0x31, 0x33, 0x4b, 0x4f, 0x40,
-	0x7f, 0x44, 0x9c, 0x38, 0x0c, 0xc4, 0x3c, 0xa9, 0xd9, 0xc4, 0xac, 0x14, 0x0b, 0xa8, 0xfd, 0xad,
-	0x5a, 0x76, 0xb0, 0xe7, 0xb9, 0x3d, 0x36, 0x8c, 0x90, 0x07, 0x7d, 0xec, 0xd4, 0xc6, 0x39, 0xcb,
-	0x95, 0xa3, 0xed, 0x42, 0x2a, 0x45, 0x54, 0xd3, 0x33, 0x55, 0x3a, 0x6b, 0xb4, 0x08, 0x33, 0x12,
-	0x68, 0x07, 0xaa, 0xae, 0x3c, 0x4c, 0x95, 0x8a, 0x30, 0x3b, 0x8a, 0xa3, 0x94, 0xa6, 0x38, 0x44,
-	0xd9, 0xbd, 0x3a, 0x81, 0x29, 0x6a, 0x88, 0x40, 0xb9, 0xe1, 0x25, 0x62, 0x5a, 0x8f, 0x78, 0x5c,
-	0x9e, 0xf7, 0x8c, 0x4f, 0x1c, 0xa0, 0x32, 0x68, 0xde, 0x4b, 0x30, 0x6d, 0x1f, 0x7d, 0xca, 0x82,
-	0xc1, 0xd8, 0x6d, 0xae, 0x44, 0xe1, 0xb6, 0x57, 0x27, 0x91, 0xd0, 0x31, 0x8f, 0xc8, 0xd9, 0x56,
-	0x67, 0x97, 0x64, 0x83, 0x9a, 0x2e, 0x37, 0x5f, 0x68, 0x08, 0x36, 0xe9, 0xd2, 0xb3, 0xd7, 0xc3,
-	0xe2, 0xdb, 0xe7, 0x88, 0xcb, 0x76, 0x9c, 0x3c, 0x33, 0xb3, 0x95, 0x72, 0x64, 0x9d, 0x7b, 0xae,
-	0xed, 0x6e, 0xd1, 0xfd, 0xa6, 0x3b, 0xf4, 0xd6, 0xdb, 0x7b, 0x13, 0x0f, 0xcf, 0xe6, 0xd3, 0xc4,
-	0xdd, 0x3a, 0xc3, 0x06, 0xac, 0xd5, 0xf6, 0x7d, 0x4c, 0x5e, 0x6d, 0x13, 0x66, 0x11, 0x2b, 0x60,
-	0xc0, 0x56, 0x74, 0x83, 0x99, 0x01, 0x33, 0x20, 0xd8, 0xa4, 0x8b, 0x5e, 0x85, 0xfe, 0xa6, 0x93,
-	0x44, 0xde, 0x8e, 0x30, 0x83, 0x1d, 0xf1, 0x14, 0xb4, 0xc4, 0xda, 0xd2, 0xc4, 0x99, 0xa0, 0xe7,
-	0x85, 0x58, 0x10, 0x42, 0x4d, 0xa8, 0x34, 0x49, 0xd4, 0x20, 0xe3, 0xd5, 0x22, 0x4c, 0xfe, 0x4b,
-	0xb4, 0x29, 0x4d, 0xb0, 0x46, 0x95, 0x2b, 0x56, 0x86, 0x39, 0x15, 0xf4, 0x32, 0x54, 0x63, 0xe2,
-	0x13, 0x97, 0xaa, 0x47, 0x35, 0x46, 0xf1, 0x1d, 0x3d, 0xaa, 0x8a, 0x54, 0x2f, 0x59, 0x15, 0x55,
-	0xf9, 0x06, 0x93, 0xff, 0xb0, 0x6a, 0x92, 0x0e, 0x60, 0xcb, 0x6f, 0x37, 0xbc, 0x60, 0x1c, 0x8a,
-	0x18, 0xc0, 0x15, 0xd6, 0x56, 0x66, 0x00, 0x79, 0x21, 0x16, 0x84, 0xec, 0xff, 0x66, 0x01, 0x4a,
-	0x33, 0xb5, 0x7b, 0xa0, 0x13, 0xbf, 0x9a, 0xd6, 0x89, 0x17, 0x8b, 0x54, 0x5a, 0xba, 0xa8, 0xc5,
-	0xbf, 0x59, 0x83, 0x8c, 0x38, 0xb8, 0x4a, 0xe2, 0x84, 0xd4, 0xdf, 0x64, 0xe1, 0x6f, 0xb2, 0xf0,
-	0x37, 0x59, 0xb8, 0x62, 0xe1, 0xeb, 0x19, 0x16, 0xfe, 0x1e, 0x63, 0xd7, 0xeb, 0xfb, 0xf5, 0x57,
-	0xd4, 0x05, 0xbc, 0xd9, 0x03, 0x03, 0x81, 0x72, 0x82, 0xcb, 0xab, 0xcb, 0x57, 0x73, 0x79, 0xf6,
-	0x2b, 0x69, 0x9e, 0x7d, 0x54, 0x12, 0xff, 0x3f, 0x70, 0xe9, 0xdf, 0xb7, 0xe0, 0x6d, 0x69, 0xee,
-	0x25, 0x57, 0xce, 0x42, 0x23, 0x08, 0x23, 0x32, 0xe7, 0x6d, 0x6c, 0x90, 0x88, 0x04, 0x2e, 0x89,
-	0x95, 0x6d, 0xc7, 0xea, 0x66, 0xdb, 0x41, 0xcf, 0xc2, 0xd0, 0xcd, 0x38, 0x0c, 0x56, 0x42, 0x2f,
-	0x10, 0x2c, 0x88, 0x9e, 0x38, 0x4e, 0xdc, 0xde, 0x9b, 0x18, 0xa2, 0x23, 0x2a, 0xcb, 0x71, 0x0a,
-	0x0b, 0xcd, 0xc2, 0xd8, 0xcd, 0x57, 0x57, 0x9c, 0xc4, 0xb0, 0x26, 0xc8, 0x73, 0x3f, 0xbb, 0x8f,
-	0xba, 0xfc, 0x62, 0x06, 0x88, 0x3b, 0xf1, 0xed, 0xbf, 0x5b, 0x82, 0x33, 0x99, 0x0f, 0x09, 0x7d,
-	0x3f, 0x6c, 0x27, 0xf4, 0x4c, 0x84, 0xbe, 0x62, 0xc1, 0x89, 0x66, 0xda, 0x60, 0x11, 0x0b, 0x73,
-	0xf7, 0xfb, 0x0a, 0x93, 0x11, 0x19, 0x8b, 0xc8, 0xcc, 0xb8, 0x18, 0xa1, 0x13, 0x19, 0x40, 0x8c,
-	0x3b, 0xfa, 0x82, 0x5e, 0x86, 0x5a, 0xd3, 0xd9, 0xb9, 0xd6, 0xaa, 0x3b, 0x89, 0x3c, 0x8e, 0x76,
-	0xb7, 0x22, 0xb4, 0x13, 0xcf, 0x9f, 0xe4, 0x9e, 0x1b, 0x93, 0x0b, 0x41, 0xb2, 0x1c, 0xad, 0x26,
-	0x91, 0x17, 0x34, 0xb8, 0x91, 0x73, 0x49, 0x36, 0x83, 0x75, 0x8b, 0xf6, 0x97, 0xad, 0xac, 0x90,
-	0x52, 0xa3, 0x13, 0x39, 0x09, 0x69, 0xec, 0xa2, 0x0f, 0x43, 0x85, 0x9e, 0x1b, 0xe5, 0xa8, 0xdc,
-	0x28, 0x52, 0x72, 0x1a, 0x33, 0xa1, 0x85, 0x28, 0xfd, 0x17, 0x63, 0x4e, 0xd4, 0xfe, 0x4a, 0x2d,
-	0xab, 0x2c, 0xb0, 0xbb, 0xf9, 0xf3, 0x00, 0x8d, 0x70, 0x8d, 0x34, 0x5b, 0x3e, 0x1d, 0x16, 0x8b,
-	0x5d, 0xf0, 0x28, 0x53, 0xc9, 0xbc, 0x82, 0x60, 0x03, 0x0b, 0xfd, 0x92, 0x05, 0xd0, 0x90, 0x6b,
-	0x5e, 0x2a, 0x02, 0xd7, 0x8a, 0xfc, 0x1c, 0xbd, 0xa3, 0x74, 0x5f, 0x14, 0x41, 0x6c, 0x10, 0x47,
-	0x3f, 0x6f, 0x41, 0x35, 0x91, 0xdd, 0xe7, 0xa2, 0x71, 0xad, 0xc8, 0x9e, 0xc8, 0x8f, 0xd6, 0x3a,
-	0x91, 0x1a, 0x12, 0x45, 0x17, 0xfd, 0x82, 0x05, 0x10, 0xef, 0x06, 0xee, 0x4a, 0xe8, 0x7b, 0xee,
-	0xae, 0x90, 0x98, 0xd7, 0x0b, 0x35, 0xe7, 0xa8, 0xd6, 0x67, 0x46, 0xe8, 0x68, 0xe8, 0xff, 0xd8,
-	0xa0, 0x8c, 0x3e, 0x0a, 0xd5, 0x58, 0x2c, 0x37, 0x21, 0x23, 0xd7, 0x8a, 0x35, 0x2a, 0xf1, 0xb6,
-	0x05, 0x7b, 0x15, 0xff, 0xb0, 0xa2, 0x89, 0xfe, 0xb6, 0x05, 0xa3, 0xad, 0xb4, 0x99, 0x50, 0x88,
-	0xc3, 0xe2, 0x78, 0x40, 0xc6, 0x0c, 0xc9, 0xad, 0x2d, 0x99, 0x42, 0x9c, 0xed, 0x05, 0xe5, 0x80,
-	0x7a, 0x05, 0x2f, 0xb7, 0xb8, 0xc9, 0x72, 0x40, 0x73, 0xc0, 0xf9, 0x2c, 0x10, 0x77, 0xe2, 0xa3,
-	0x15, 0x38, 0x45, 0x7b, 0xb7, 0xcb, 0xd5, 0x4f, 0x29, 0x5e, 0x62, 0x26, 0x0c, 0xab, 0x33, 0x8f,
-	0x88, 0x15, 0xc2, 0xee, 0x3a, 0xb2, 0x38, 0x38, 0xb7, 0x26, 0xfa, 0x43, 0x0b, 0x1e, 0xf1, 0x98,
-	0x18, 0x30, 0x0d, 0xf6, 0x5a, 0x22, 0x88, 0x8b, 0x76, 0x52, 0x28, 0xaf, 0xe8, 0x26, 0x7e, 0x66,
-	0x7e, 0x54, 0x7c, 0xc1, 0x23, 0x0b, 0xfb, 0x74, 0x09, 0xef, 0xdb, 0x61, 0xf4, 0x93, 0x30, 0x2c,
-	0xf7, 0xc5, 0x0a, 0x65, 0xc1, 0x4c, 0xd0, 0xd6, 0x66, 0xc6, 0x6e, 0xef, 0x4d, 0x0c, 0xaf, 0x99,
-	0x00, 0x9c, 0xc6, 0xb3, 0xff, 0x6d, 0x39, 0x75, 0x4b, 0xa4, 0x6c, 0x98, 0x8c, 0xdd, 0xb8, 0xd2,
-	0xfe, 0x23, 0xb9, 0x67, 0xa1, 0xec, 0x46, 0x59, 0x97, 0x34, 0xbb, 0x51, 0x45, 0x31, 0x36, 0x88,
-	0x53, 0xa5, 0x74, 0xcc, 0xc9, 0x5a, 0x4a, 0x05, 0x07, 0x7c, 0xb9, 0xc8, 0x2e, 0x75, 0xde, 0xe9,
-	0x9d, 0x11, 0x5d, 0x1b, 0xeb, 0x00, 0xe1, 0xce, 0x2e, 0xa1, 0x8f, 0x40, 0x2d, 0x52, 0x9e, 0x2d,
-	0xe5, 0x22, 0x8e, 0x6a, 0x72, 0xd9, 0x88, 0xee, 0xa8, 0x0b, 0x20, 0xed, 0xc3, 0xa2, 0x29, 0xda,
-	0x7f, 0x90, 0xbe, 0x18, 0x33, 0x78, 0x47, 0x0f, 0x97, 0x7e, 0x9f, 0xb7, 0x60, 0x30, 0x0a, 0x7d,
-	0xdf, 0x0b, 0x1a, 0x94, 0xcf, 0x09, 0x61, 0xfd, 0x81, 0x63, 0x91, 0x97, 0x82, 0xa1, 0x31, 0xcd,
-	0x1a, 0x6b, 0x9a, 0xd8, 0xec, 0x80, 0xfd, 0xa7, 0x16, 0x8c, 0x77, 0xe3, 0xc7, 0x88, 0xc0, 0x5b,
-	0x25, 0xb3, 0x51, 0x43, 0xb1, 0x1c, 0xcc, 0x11, 0x9f, 0x28, 0xb3, 0x79, 0x75, 0xe6, 0x71, 0xf1,
-	0x99, 0x6f, 0x5d, 0xe9, 0x8e, 0x8a, 0xf7, 0x6b, 0x07, 0xbd, 0x04, 0x27, 0x8c, 0xef, 0x8a, 0xd5,
-	0xc0, 0xd4, 0x66, 0x26, 0xa9, 0x02, 0x34, 0x9d, 0x81, 0xdd, 0xd9, 0x9b, 0x78, 0x28, 0x5b, 0x26,
-	0x04, 0x46, 0x47, 0x3b, 0xf6, 0xaf, 0x97, 0xb2, 0xb3, 0xa5, 0x64, 0xfd, 0x1b, 0x56, 0x87, 0x35,
-	0xe1, 0x7d, 0xc7, 0x21, 0x5f, 0x99, 0xdd, 0x41, 0xb9, 0x61, 0x74, 0xc7, 0xb9, 0x8f, 0xd7, 0xf6,
-	0xf6, 0xbf, 0xeb, 0x83, 0x7d, 0x7a, 0xd6, 0x83, 0xf2, 0x7e, 0xe8, 0x7b, 0xd4, 0xcf, 0x5a, 0xea,
-	0xc2, 0x8c, 0xef, 0xe1, 0xfa, 0x71, 0x8d, 0x3d, 0x3f, 0x3f, 0xc5, 0xdc, 0x75, 0x44, 0x59, 0xd1,
-	0xd3, 0x57, 0x73, 0xe8, 0xab, 0x56, 0xfa, 0xca, 0x8f, 0x3b, 0x35, 0x7a, 0xc7, 0xd6, 0x27, 0xe3,
-	0x1e, 0x91, 0x77, 0x4c, 0xdf, 0x3e, 0x75, 0xbb, 0x61, 0x9c, 0x04, 0xd8, 0xf0, 0x02, 0xc7, 0xf7,
-	0x5e, 0xa3, 0xa7, 0xa3, 0x0a, 0x13, 0xf0, 0x4c, 0x63, 0xba, 0xa8, 0x4a, 0xb1, 0x81, 0x71, 0xf6,
-	0xaf, 0xc3, 0xa0, 0xf1, 0xe5, 0x39, 0x1e, 0x2f, 0xa7, 0x4c, 0x8f, 0x97, 0x9a, 0xe1, 0xa8, 0x72,
-	0xf6, 0x3d, 0x70, 0x22, 0xdb, 0xc1, 0xc3, 0xd4, 0xb7, 0xff, 0xf7, 0x40, 0xf6, 0x0e, 0x6e, 0x8d,
-	0x44, 0x4d, 0xda, 0xb5, 0x37, 0x0d, 0x5b, 0x6f, 0x1a, 0xb6, 0xde, 0x34, 0x6c, 0x99, 0x77, 0x13,
-	0xc2, 0x68, 0x33, 0x70, 0x8f, 0x8c, 0x36, 0x29, 0x33, 0x54, 0xb5, 0x70, 0x33, 0x94, 0xfd, 0xa9,
-	0x0e, 0xcb, 0xfd, 0x5a, 0x44, 0x08, 0x0a, 0xa1, 0x12, 0x84, 0x75, 0x22, 0x75, 0xdc, 0xcb, 0xc5,
-	0x28, 0x6c, 0x57, 0xc3, 0xba, 0xe1, 0x2e, 0x4e, 0xff, 0xc5, 0x98, 0xd3, 0xb1, 0x6f, 0x57, 0x20,
-	0xa5, 0x4e, 0xf2, 0x79, 0xff, 0x71, 0x18, 0x88, 0x48, 0x2b, 0xbc, 0x86, 0x17, 0x85, 0x2c, 0xd3,
-	0x11, 0x25, 0xbc, 0x18, 0x4b, 0x38, 0x95, 0x79, 0x2d, 0x27, 0xd9, 0x14, 0xc2, 0x4c, 0xc9, 0xbc,
-	0x15, 0x27, 0xd9, 0xc4, 0x0c, 0x82, 0xde, 0x03, 0x23, 0x49, 0xea, 0x2a, 0x5c, 0x5c, 0xf9, 0x3e,
-	0x24, 0x70, 0x47, 0xd2, 0x17, 0xe5, 0x38, 0x83, 0x8d, 0x5e, 0x85, 0xbe, 0x4d, 0xe2, 0x37, 0xc5,
-	0xd4, 0xaf, 0x16, 0x27, 0x6b, 0xd8, 0xb7, 0x5e, 0x22, 0x7e, 0x93, 0x73, 0x42, 0xfa, 0x0b, 0x33,
-	0x52, 0x74, 0xdd, 0xd7, 0xb6, 0xda, 0x71, 0x12, 0x36, 0xbd, 0xd7, 0xa4, 0xa5, 0xf3, 0x7d, 0x05,
-	0x13, 0xbe, 0x22, 0xdb, 0xe7, 0x26, 0x25, 0xf5, 0x17, 0x6b, 0xca, 0xac, 0x1f, 0x75, 0x2f, 0x62,
-	0x4b, 0x66, 0x57, 0x18, 0x2c, 0x8b, 0xee, 0xc7, 0x9c, 0x6c, 0x9f, 0xf7, 0x43, 0xfd, 0xc5, 0x9a,
-	0x32, 0xda, 0x55, 0xfb, 0x6f, 0x90, 0xf5, 0xe1, 0x5a, 0xc1, 0x7d, 0xe0, 0x7b, 0x2f, 0x77, 0x1f,
-	0x3e, 0x0e, 0x15, 0x77, 0xd3, 0x89, 0x92, 0xf1, 0x21, 0xb6, 0x68, 0xd4, 0x2a, 0x9e, 0xa5, 0x85,
-	0x98, 0xc3, 0xd0, 0xa3, 0x50, 0x8e, 0xc8, 0x06, 0xf3, 0x4e, 0x36, 0xfc, 0xa2, 0x30, 0xd9, 0xc0,
-	0xb4, 0xdc, 0xfe, 0xd5, 0x52, 0x5a, 0x6d, 0x4b, 0x7f, 0x37, 0x5f, 0xed, 0x6e, 0x3b, 0x8a, 0xa5,
-	0xf9, 0xcb, 0x58, 0xed, 0xac, 0x18, 0x4b, 0x38, 0xfa, 0xb8, 0x05, 0x03, 0x37, 0xe3, 0x30, 0x08,
-	0x48, 0x22, 0x44, 0xe4, 0xf5, 0x82, 0x87, 0xe2, 0x32, 0x6f, 0x5d, 0xf7, 0x41, 0x14, 0x60, 0x49,
-	0x97, 0x76, 0x97, 0xec, 0xb8, 0x7e, 0xbb, 0xde, 0xe1, 0xea, 0x72, 0x81, 0x17, 0x63, 0x09, 0xa7,
-	0xa8, 0x5e, 0xc0, 0x51, 0xfb, 0xd2, 0xa8, 0x0b, 0x81, 0x40, 0x15, 0x70, 0xfb, 0xfb, 0x03, 0x70,
-	0x3a, 0x77, 0x73, 0x50, 0x85, 0x8a, 0xa9, 0x2c, 0x17, 0x3d, 0x9f, 0x48, 0x27, 0x2f, 0xa6, 0x50,
-	0x5d, 0x57, 0xa5, 0xd8, 0xc0, 0x40, 0x3f, 0x07, 0xd0, 0x72, 0x22, 0xa7, 0x49, 0x94, 0x79, 0xfa,
-	0xc8, 0x7a, 0x0b, 0xed, 0xc7, 0x8a, 0x6c, 0x53, 0x1f, 0xd1, 0x55, 0x51, 0x8c, 0x0d, 0x92, 0xe8,
-	0x39, 0x18, 0x8c, 0x88, 0x4f, 0x9c, 0x98, 0x39, 0xb7, 0x67, 0x23, 0x75, 0xb0, 0x06, 0x61, 0x13,
-	0x0f, 0x3d, 0xa1, 0xfc, 0xe1, 0x32, 0x7e, 0x41, 0x69, 0x9f, 0x38, 0xf4, 0xba, 0x05, 0x23, 0x1b,
-	0x9e, 0x4f, 0x34, 0x75, 0x11, 0x57, 0xb3, 0x7c, 0xf4, 0x8f, 0xbc, 0x68, 0xb6, 0xab, 0x39, 0x64,
-	0xaa, 0x38, 0xc6, 0x19, 0xf2, 0x74, 0x9a, 0xb7, 0x49, 0xc4, 0x58, 0x6b, 0x7f, 0x7a, 0x9a, 0xaf,
-	0xf3, 0x62, 0x2c, 0xe1, 0x68, 0x1a, 0x46, 0x5b, 0x4e, 0x1c, 0xcf, 0x46, 0xa4, 0x4e, 0x82, 0xc4,
-	0x73, 0x7c, 0x1e, 0xf5, 0x52, 0xd5, 0xce, 0xe2, 0x2b, 0x69, 0x30, 0xce, 0xe2, 0xa3, 0xf7, 0xc3,
-	0xc3, 0xdc, 0xfe, 0xb3, 0xe4, 0xc5, 0xb1, 0x17, 0x34, 0xf4, 0x32, 0x10, 0x66, 0xb0, 0x09, 0xd1,
-	0xd4, 0xc3, 0x0b, 0xf9, 0x68, 0xb8, 0x5b, 0x7d, 0xf4, 0x14, 0x54, 0xe3, 0x2d, 0xaf, 0x35, 0x1b,
-	0xd5, 0x63, 0x76, 0xf7, 0x53, 0xd5, 0x46, 0xd7, 0x55, 0x51, 0x8e, 0x15, 0x06, 0x72, 0x61, 0x88,
-	0x4f, 0x09, 0x77, 0xe8, 0x13, 0xfc, 0xf1, 0xe9, 0xae, 0x62, 0x5a, 0x04, 0x71, 0x4e, 0x62, 0xe7,
-	0xd6, 0x05, 0x79, 0x13, 0xc5, 0x2f, 0x4e, 0xae, 0x1b, 0xcd, 0xe0, 0x54, 0xa3, 0xe9, 0x13, 0xdb,
-	0x60, 0x0f, 0x27, 0xb6, 0xe7, 0x60, 0x70, 0xab, 0xbd, 0x4e, 0xc4, 0xc8, 0x0b, 0xb6, 0xa5, 0x56,
-	0xdf, 0x15, 0x0d, 0xc2, 0x26, 0x1e, 0xf3, 0xa5, 0x6c, 0x79, 0xe2, 0x5f, 0x3c, 0x3e, 0x6c, 0xf8,
-	0x52, 0xae, 0x2c, 0xc8, 0x62, 0x6c, 0xe2, 0xd0, 0xae, 0xd1, 0xb1, 0x58, 0x23, 0x31, 0x0b, 0x95,
-	0xa0, 0xc3, 0xa5, 0xba, 0xb6, 0x2a, 0x01, 0x58, 0xe3, 0xd8, 0xbf, 0x5c, 0x4a, 0x5b, 0x31, 0x4c,
-	0x86, 0x83, 0x62, 0xca, 0x56, 0x92, 0xeb, 0x4e, 0x24, 0x95, 0x8f, 0x23, 0x06, 0x1a, 0x89, 0x76,
-	0xaf, 0x3b, 0x91, 0xc9, 0xa0, 0x18, 0x01, 0x2c, 0x29, 0xa1, 0x9b, 0xd0, 0x97, 0xf8, 0x4e, 0x41,
-	0x91, 0x89, 0x06, 0x45, 0x6d, 0x54, 0x5a, 0x9c, 0x8e, 0x31, 0xa3, 0x81, 0x1e, 0xa1, 0x27, 0xa9,
-	0x75, 0x79, 0xeb, 0x25, 0x0e, 0x3f, 0xeb, 0x31, 0x66, 0xa5, 0xf6, 0x9f, 0x0d, 0xe6, 0xc8, 0x08,
-	0x25, 0x94, 0xd1, 0x79, 0x00, 0x3a, 0xc5, 0x2b, 0x11, 0xd9, 0xf0, 0x76, 0x84, 0x52, 0xa4, 0xf8,
-	0xd0, 0x55, 0x05, 0xc1, 0x06, 0x96, 0xac, 0xb3, 0xda, 0xde, 0xa0, 0x75, 0x4a, 0x9d, 0x75, 0x38,
-	0x04, 0x1b, 0x58, 0xe8, 0x59, 0xe8, 0xf7, 0x9a, 0x4e, 0x43, 0x39, 0xe5, 0x3e, 0x42, 0x19, 0xd0,
-	0x02, 0x2b, 0xb9, 0xb3, 0x37, 0x31, 0xa2, 0x3a, 0xc4, 0x8a, 0xb0, 0xc0, 0x45, 0xbf, 0x6e, 0xc1,
-	0x90, 0x1b, 0x36, 0x9b, 0x61, 0xc0, 0x8f, 0xb2, 0xe2, 0x5c, 0x7e, 0xf3, 0xb8, 0x54, 0x96, 0xc9,
-	0x59, 0x83, 0x18, 0x3f, 0x98, 0xab, 0x10, 0x4a, 0x13, 0x84, 0x53, 0xbd, 0x32, 0xf9, 0x54, 0xe5,
-	0x00, 0x3e, 0xf5, 0x1b, 0x16, 0x8c, 0xf1, 0xba, 0xc6, 0x09, 0x5b, 0x44, 0x0b, 0x86, 0xc7, 0xfc,
-	0x59, 0x1d, 0x46, 0x07, 0x65, 0x78, 0xed, 0x80, 0xe3, 0xce, 0x4e, 0xa2, 0x79, 0x18, 0xdb, 0x08,
-	0x23, 0x97, 0x98, 0x03, 0x21, 0x98, 0xac, 0x6a, 0xe8, 0x62, 0x16, 0x01, 0x77, 0xd6, 0x41, 0xd7,
-	0xe1, 0x21, 0xa3, 0xd0, 0x1c, 0x07, 0xce, 0x67, 0x1f, 0x13, 0xad, 0x3d, 0x74, 0x31, 0x17, 0x0b,
-	0x77, 0xa9, 0x9d, 0x66, 0x69, 0xb5, 0x1e, 0x58, 0xda, 0x2b, 0x70, 0xc6, 0xed, 0x1c, 0x99, 0xed,
-	0xb8, 0xbd, 0x1e, 0x73, 0xae, 0x5b, 0x9d, 0xf9, 0x11, 0xd1, 0xc0, 0x99, 0xd9, 0x6e, 0x88, 0xb8,
-	0x7b, 0x1b, 0xe8, 0xc3, 0x50, 0x8d, 0x08, 0x9b, 0x95, 0x58, 0x84, 0xce, 0x1d, 0xd1, 0xf2, 0xa0,
-	0xb5, 0x69, 0xde, 0xac, 0x96, 0x23, 0xa2, 0x20, 0xc6, 0x8a, 0x22, 0xba, 0x05, 0x03, 0x2d, 0x27,
-	0x71, 0x37, 0x45, 0xc0, 0xdc, 0x91, 0xed, 0xe4, 0x8a, 0x38, 0xbb, 0xd6, 0x30, 0x42, 0xec, 0x39,
-	0x11, 0x2c, 0xa9, 0x51, 0xcd, 0xca, 0x0d, 0x9b, 0xad, 0x30, 0x20, 0x41, 0x22, 0x59, 0xfe, 0x08,
-	0xbf, 0x7b, 0x90, 0xa5, 0xd8, 0xc0, 0x40, 0x2b, 0x70, 0x8a, 0xd9, 0xe1, 0x6e, 0x78, 0xc9, 0x66,
-	0xd8, 0x4e, 0xe4, 0xb1, 0x52, 0xf0, 0x7e, 0x75, 0xfb, 0xb4, 0x98, 0x83, 0x83, 0x73, 0x6b, 0x66,
-	0x85, 0xd5, 0xe8, 0xdd, 0x09, 0xab, 0x13, 0x07, 0x0b, 0xab, 0xb3, 0xef, 0x85, 0xb1, 0x0e, 0xa6,
-	0x71, 0x28, 0x63, 0xdb, 0x1c, 0x3c, 0x94, 0xbf, 0x3d, 0x0f, 0x65, 0x72, 0xfb, 0xe7, 0x19, 0x9f,
-	0x6b, 0xe3, 0xf8, 0xd1, 0x83, 0xf9, 0xd6, 0x81, 0x32, 0x09, 0xb6, 0x85, 0xb4, 0xba, 0x78, 0xb4,
-	0x55, 0x72, 0x21, 0xd8, 0xe6, 0xdc, 0x85, 0xd9, 0xa8, 0x2e, 0x04, 0xdb, 0x98, 0xb6, 0x8d, 0xbe,
-	0x68, 0xa5, 0xd4, 0x67, 0x6e, 0xf4, 0xfd, 0xe0, 0xb1, 0x9c, 0xb7, 0x7a, 0xd6, 0xa8, 0xed, 0x7f,
-	0x5f, 0x82, 0x73, 0x07, 0x35, 0xd2, 0xc3, 0xf0, 0x3d, 0x0e, 0xfd, 0x31, 0xf3, 0xa2, 0x10, 0xec,
-	0x7f, 0x90, 0xee, 0x0a, 0xee, 0x57, 0xf1, 0x0a, 0x16, 0x20, 0xe4, 0x43, 0xb9, 0xe9, 0xb4, 0x84,
-	0x2d, 0x70, 0xe1, 0xa8, 0xb1, 0x69, 0xf4, 0xbf, 0xe3, 0x2f, 0x39, 0x2d, 0xbe, 0x3c, 0x8d, 0x02,
-	0x4c, 0xc9, 0xa0, 0x04, 0x2a, 0x4e, 0x14, 0x39, 0xf2, 0xca, 0xfe, 0x4a, 0x31, 0xf4, 0xa6, 0x69,
-	0x93, 0xfc, 0xc6, 0x33, 0x55, 0x84, 0x39, 0x31, 0xfb, 0xb3, 0x03, 0xa9, 0x40, 0x26, 0xe6, 0x87,
-	0x11, 0x43, 0xbf, 0x30, 0x01, 0x5a, 0x45, 0x87, 0x04, 0xf2, 0x48, 0x61, 0x76, 0xba, 0x16, 0xf9,
-	0x16, 0x04, 0x29, 0xf4, 0x19, 0x8b, 0x65, 0x35, 0x90, 0xd1, 0x61, 0xe2, 0x4c, 0x7b, 0x3c, 0x49,
-	0x16, 0xcc, 0x5c, 0x09, 0xb2, 0x10, 0x9b, 0xd4, 0x45, 0x76, 0x12, 0xa6, 0xcb, 0x77, 0x66, 0x27,
-	0x61, 0xba, 0xb9, 0x84, 0xa3, 0x9d, 0x1c, 0x7f, 0x8b, 0x02, 0x22, 0xe3, 0x7b, 0xf0, 0xb0, 0xf8,
-	0xaa, 0x05, 0x63, 0x5e, 0xf6, 0xe2, 0x5c, 0x9c, 0x00, 0x6f, 0x14, 0x63, 0xaf, 0xeb, 0xbc, 0x97,
-	0x57, 0x8a, 0x43, 0x07, 0x08, 0x77, 0x76, 0x06, 0xd5, 0xa1, 0xcf, 0x0b, 0x36, 0x42, 0xa1, 0x2e,
-	0xcd, 0x1c, 0xad, 0x53, 0x0b, 0xc1, 0x46, 0xa8, 0x77, 0x33, 0xfd, 0x87, 0x59, 0xeb, 0x68, 0x11,
-	0x4e, 0xc9, 0x58, 0x96, 0x4b, 0x5e, 0x9c, 0x84, 0xd1, 0xee, 0xa2, 0xd7, 0xf4, 0x12, 0xa6, 0xea,
-	0x94, 0x67, 0xc6, 0xa9, 0x24, 0xc2, 0x39, 0x70, 0x9c, 0x5b, 0x0b, 0xbd, 0x06, 0x03, 0xf2, 0xb2,
-	0xba, 0x5a, 0xc4, 0x69, 0xba, 0x73, 0xfd, 0xab, 0xc5, 0xb4, 0x2a, 0x6e, 0xab, 0x25, 0x41, 0xfb,
-	0xf5, 0x41, 0xe8, 0xbc, 0x53, 0x4f, 0x5f, 0xa0, 0x5b, 0xf7, 0xfa, 0x02, 0x9d, 0x1e, 0x8d, 0x62,
-	0x7d, 0xf7, 0x5d, 0xc0, 0xda, 0x16, 0x54, 0xf5, 0xbd, 0xe6, 0x6e, 0xe0, 0x62, 0x46, 0x03, 0x45,
-	0xd0, 0xbf, 0x49, 0x1c, 0x3f, 0xd9, 0x2c, 0xe6, 0x0a, 0xe6, 0x12, 0x6b, 0x2b, 0x1b, 0x80, 0xc6,
-	0x4b, 0xb1, 0xa0, 0x84, 0x76, 0x60, 0x60, 0x93, 0x2f, 0x00, 0x71, 0x5a, 0x59, 0x3a, 0xea, 0xe0,
-	0xa6, 0x56, 0x95, 0x9e, 0x6e, 0x51, 0x80, 0x25, 0x39, 0xe6, 0xac, 0x65, 0xb8, 0x93, 0xf0, 0xad,
-	0x5b, 0x5c, 0xec, 0x5d, 0xef, 0xbe, 0x24, 0x1f, 0x82, 0xa1, 0x88, 0xb8, 0x61, 0xe0, 0x7a, 0x3e,
-	0xa9, 0x4f, 0xcb, 0xeb, 0x95, 0xc3, 0x84, 0x5c, 0x31, 0xeb, 0x05, 0x36, 0xda, 0xc0, 0xa9, 0x16,
-	0xd1, 0xa7, 0x2d, 0x18, 0x51, 0x61, 0xd8, 0x74, 0x42, 0x88, 0x30, 0xa3, 0x2f, 0x16, 0x14, 0xf4,
-	0xcd, 0xda, 0x9c, 0x41, 0xb7, 0xf7, 0x26, 0x46, 0xd2, 0x65, 0x38, 0x43, 0x17, 0xbd, 0x04, 0x10,
-	0xae, 0x73, 0x8f, 0xac, 0xe9, 0x44, 0xd8, 0xd4, 0x0f, 0xf3, 0xa9, 0x23, 0x3c, 0x74, 0x53, 0xb6,
-	0x80, 0x8d, 0xd6, 0xd0, 0x15, 0x00, 0xbe, 0x6d, 0xd6, 0x76, 0x5b, 0xf2, 0x48, 0x23, 0x63, 0xe6,
-	0x60, 0x55, 0x41, 0xee, 0xec, 0x4d, 0x74, 0xda, 0x38, 0x99, 0xdb, 0x89, 0x51, 0x1d, 0xfd, 0x2c,
-	0x0c, 0xc4, 0xed, 0x66, 0xd3, 0x51, 0x16, 0xf7, 0x02, 0x83, 0x41, 0x79, 0xbb, 0x06, 0x2b, 0xe2,
-	0x05, 0x58, 0x52, 0x44, 0x37, 0x29, 0x53, 0x8d, 0x85, 0xf1, 0x95, 0xed, 0x22, 0xae, 0x13, 0x70,
-	0xcb, 0xd3, 0x3b, 0xa5, 0x8a, 0x8f, 0x73, 0x70, 0xee, 0xec, 0x4d, 0x3c, 0x94, 0x2e, 0x5f, 0x0c,
-	0x45, 0x78, 0x66, 0x6e, 0x9b, 0xe8, 0xb2, 0xcc, 0xca, 0x44, 0x3f, 0x5b, 0x26, 0x0b, 0x79, 0x52,
-	0x67, 0x65, 0x62, 0xc5, 0xdd, 0xc7, 0xcc, 0xac, 0x8c, 0x96, 0xe0, 0xa4, 0x1b, 0x06, 0x49, 0x14,
-	0xfa, 0x3e, 0xcf, 0x4a, 0xc6, 0x4f, 0x97, 0xdc, 0x22, 0xff, 0x56, 0xd1, 0xed, 0x93, 0xb3, 0x9d,
-	0x28, 0x38, 0xaf, 0x9e, 0x1d, 0xa4, 0x6f, 0xc7, 0xc4, 0xe0, 0x3c, 0x0b, 0x43, 0x64, 0x27, 0x21,
-	0x51, 0xe0, 0xf8, 0xd7, 0xf0, 0xa2, 0xb4, 0x45, 0xb3, 0x3d, 0x70, 0xc1, 0x28, 0xc7, 0x29, 0x2c,
-	0x64, 0x2b, 0x93, 0x8a, 0x11, 0x72, 0xcc, 0x4d, 0x2a, 0xd2, 0x80, 0x62, 0x7f, 0xa3, 0x9c, 0x52,
-	0xc8, 0xee, 0xcb, 0x5d, 0x1c, 0xcb, 0x6d, 0x23, 0x93, 0x00, 0x31, 0x80, 0x38, 0x68, 0x14, 0x49,
-	0x59, 0xe5, 0xb6, 0x59, 0x36, 0x09, 0xe1, 0x34, 0x5d, 0xb4, 0x05, 0x95, 0xcd, 0x30, 0x4e, 0xe4,
-	0xf1, 0xe3, 0x88, 0x27, 0x9d, 0x4b, 0x61, 0x9c, 0x30, 0x2d, 0x42, 0x7d, 0x36, 0x2d, 0x89, 0x31,
-	0xa7, 0x41, 0xcf, 0xa0, 0xf1, 0xa6, 0x13, 0xd5, 0xe3, 0x59, 0x96, 0x20, 0xa0, 0x8f, 0xa9, 0x0f,
-	0x4a, 0x59, 0x5c, 0xd5, 0x20, 0x6c, 0xe2, 0xd9, 0x7f, 0x6e, 0xa5, 0x2e, 0x2c, 0x6e, 0x30, 0x6f,
-	0xef, 0x6d, 0x12, 0x50, 0x6e, 0x60, 0xfa, 0x97, 0xfd, 0x64, 0x26, 0x76, 0xf6, 0x6d, 0xdd, 0x72,
-	0xf5, 0xdd, 0xa2, 0x2d, 0x4c, 0xb2, 0x26, 0x0c, 0x57, 0xb4, 0x8f, 0x59, 0xe9, 0x20, 0xe8, 0x52,
-	0x11, 0xe7, 0x12, 0x33, 0x11, 0xc0, 0x81, 0xf1, 0xd4, 0xf6, 0x17, 0x2d, 0x18, 0x98, 0x71, 0xdc,
-	0xad, 0x70, 0x63, 0x03, 0x3d, 0x05, 0xd5, 0x7a, 0x3b, 0x32, 0xe3, 0xb1, 0x95, 0x65, 0x63, 0x4e,
-	0x94, 0x63, 0x85, 0x41, 0x97, 0xfe, 0x86, 0xe3, 0xca, 0x74, 0x00, 0x65, 0xbe, 0xf4, 0x2f, 0xb2,
-	0x12, 0x2c, 0x20, 0x74, 0xf8, 0x9b, 0xce, 0x8e, 0xac, 0x9c, 0xbd, 0x2d, 0x59, 0xd2, 0x20, 0x6c,
-	0xe2, 0xd9, 0xff, 0xda, 0x82, 0xf1, 0x19, 0x27, 0xf6, 0xdc, 0xe9, 0x76, 0xb2, 0x39, 0xe3, 0x25,
-	0xeb, 0x6d, 0x77, 0x8b, 0x24, 0x3c, 0x6d, 0x04, 0xed, 0x65, 0x3b, 0xa6, 0x3b, 0x50, 0x1d, 0x07,
-	0x55, 0x2f, 0xaf, 0x89, 0x72, 0xac, 0x30, 0xd0, 0x6b, 0x30, 0xd8, 0x72, 0xe2, 0xf8, 0x56, 0x18,
-	0xd5, 0x31, 0xd9, 0x28, 0x26, 0xb1, 0xcc, 0x2a, 0x71, 0x23, 0x92, 0x60, 0xb2, 0x21, 0x3c, 0x0b,
-	0x74, 0xfb, 0xd8, 0x24, 0x66, 0xff, 0x92, 0x05, 0xa7, 0x66, 0x88, 0x13, 0x91, 0x88, 0xe5, 0xa1,
-	0x51, 0x1f, 0x82, 0x5e, 0x85, 0x6a, 0x42, 0x4b, 0x68, 0x8f, 0xac, 0x62, 0x7b, 0xc4, 0x7c, 0x02,
-	0xd6, 0x44, 0xe3, 0x58, 0x91, 0xb1, 0x3f, 0x6f, 0xc1, 0x99, 0xbc, 0xbe, 0xcc, 0xfa, 0x61, 0xbb,
-	0x7e, 0x3f, 0x3a, 0xf4, 0x77, 0x2c, 0x18, 0x62, 0xf7, 0xac, 0x73, 0x24, 0x71, 0x3c, 0xbf, 0x23,
-	0x07, 0x9e, 0xd5, 0x63, 0x0e, 0xbc, 0x73, 0xd0, 0xb7, 0x19, 0x36, 0x49, 0xd6, 0x47, 0xe0, 0x52,
-	0xd8, 0x24, 0x98, 0x41, 0xd0, 0x33, 0x74, 0x11, 0x7a, 0x41, 0xe2, 0xd0, 0xed, 0x28, 0x6d, 0xdf,
-	0xa3, 0x7c, 0x01, 0xaa, 0x62, 0x6c, 0xe2, 0xd8, 0xff, 0xaa, 0x06, 0x03, 0xc2, 0xa1, 0xa5, 0xe7,
-	0x34, 0x26, 0xd2, 0x44, 0x51, 0xea, 0x6a, 0xa2, 0x88, 0xa1, 0xdf, 0x65, 0xc9, 0x38, 0x85, 0x26,
-	0x7c, 0xa5, 0x10, 0x0f, 0x28, 0x9e, 0xdf, 0x53, 0x77, 0x8b, 0xff, 0xc7, 0x82, 0x14, 0xfa, 0x82,
-	0x05, 0xa3, 0x6e, 0x18, 0x04, 0xc4, 0xd5, 0x6a, 0x5a, 0x5f, 0x11, 0x8e, 0x2e, 0xb3, 0xe9, 0x46,
-	0xf5, 0x25, 0x5f, 0x06, 0x80, 0xb3, 0xe4, 0xd1, 0x0b, 0x30, 0xcc, 0xc7, 0xec, 0x7a, 0xca, 0x60,
-	0xaf, 0x53, 0xa3, 0x99, 0x40, 0x9c, 0xc6, 0x45, 0x93, 0xfc, 0xe2, 0x43, 0x24, 0x21, 0xeb, 0xd7,
-	0x76, 0x4d, 0x23, 0xfd, 0x98, 0x81, 0x81, 0x22, 0x40, 0x11, 0xd9, 0x88, 0x48, 0xbc, 0x29, 0x1c,
-	0x7e, 0x98, 0x8a, 0x38, 0x70, 0x77, 0x09, 0x08, 0x70, 0x47, 0x4b, 0x38, 0xa7, 0x75, 0xb4, 0x25,
-	0xce, 0xc8, 0xd5, 0x22, 0xf8, 0xb9, 0x98, 0xe6, 0xae, 0x47, 0xe5, 0x09, 0xa8, 0x30, 0xd1, 0xc5,
-	0x54, 0xd3, 0x32, 0x0f, 0x7a, 0x63, 0x82, 0x0d, 0xf3, 0x72, 0x34, 0x07, 0x27, 0x32, 0x89, 0xdd,
-	0x62, 0x61, 0x58, 0x57, 0x01, 0x4e, 0x99, 0x94, 0x70, 0x31, 0xee, 0xa8, 0x61, 0xda, 0x4f, 0x06,
-	0x0f, 0xb0, 0x9f, 0xec, 0x2a, 0xb7, 0x52, 0x6e, 0xf2, 0x7e, 0xb1, 0x90, 0x01, 0xe8, 0xc9, 0x87,
-	0xf4, 0x73, 0x19, 0x1f, 0xd2, 0x61, 0xd6, 0x81, 0xeb, 0xc5, 0x74, 0xe0, 0xf0, 0x0e, 0xa3, 0xf7,
-	0xd3, 0x01, 0xf4, 0x7f, 0x59, 0x20, 0xe7, 0x75, 0xd6, 0x71, 0x37, 0x09, 0x5d, 0x32, 0xe8, 0x3d,
-	0x30, 0xa2, 0xac, 0x00, 0x5c, 0x25, 0xb2, 0xd8, 0xaa, 0x51, 0xde, 0x00, 0x38, 0x05, 0xc5, 0x19,
-	0x6c, 0x34, 0x05, 0x35, 0x3a, 0x4e, 0xbc, 0x2a, 0x97, 0xfb, 0xca, 0xd2, 0x30, 0xbd, 0xb2, 0x20,
-	0x6a, 0x69, 0x1c, 0x14, 0xc2, 0x98, 0xef, 0xc4, 0x09, 0xeb, 0xc1, 0xea, 0x6e, 0xe0, 0xde, 0x65,
-	0xfa, 0x0f, 0x16, 0x45, 0xb3, 0x98, 0x6d, 0x08, 0x77, 0xb6, 0x6d, 0xff, 0x87, 0x0a, 0x0c, 0xa7,
-	0x38, 0xe3, 0x21, 0x15, 0x86, 0xa7, 0xa0, 0x2a, 0x65, 0x78, 0x36, 0xcf, 0x91, 0x12, 0xf4, 0x0a,
-	0x83, 0x0a, 0xad, 0x75, 0x2d, 0x55, 0xb3, 0x0a, 0x8e, 0x21, 0x70, 0xb1, 0x89, 0xc7, 0x98, 0x72,
-	0xe2, 0xc7, 0xb3, 0xbe, 0x47, 0x82, 0x84, 0x77, 0xb3, 0x18, 0xa6, 0xbc, 0xb6, 0xb8, 0x6a, 0x36,
-	0xaa, 0x99, 0x72, 0x06, 0x80, 0xb3, 0xe4, 0xd1, 0x27, 0x2d, 0x18, 0x76, 0x6e, 0xc5, 0x3a, 0x63,
-	0xb4, 0xf0, 0x16, 0x3d, 0xa2, 0x90, 0x4a, 0x25, 0xa1, 0xe6, 0x56, 0xeb, 0x54, 0x11, 0x4e, 0x13,
-	0x45, 0x6f, 0x58, 0x80, 0xc8, 0x0e, 0x71, 0xa5, 0x3f, 0xab, 0xe8, 0x4b, 0x7f, 0x11, 0x87, 0xe5,
-	0x0b, 0x1d, 0xed, 0x72, 0xae, 0xde, 0x59, 0x8e, 0x73, 0xfa, 0x80, 0x2e, 0x03, 0xaa, 0x7b, 0xb1,
-	0xb3, 0xee, 0x93, 0xd9, 0xb0, 0x29, 0x23, 0x3f, 0xc5, 0xe5, 0xeb, 0x59, 0x31, 0xce, 0x68, 0xae,
-	0x03, 0x03, 0xe7, 0xd4, 0x62, 0xab, 0x2c, 0x0a, 0x77, 0x76, 0xaf, 0x45, 0x3e, 0x93, 0x12, 0xe6,
-	0x2a, 0x13, 0xe5, 0x58, 0x61, 0xd8, 0x7f, 0x51, 0x56, 0x5b, 0x59, 0x3b, 0x6f, 0x3b, 0x86, 0x13,
-	0xa9, 0x75, 0xf7, 0x4e, 0xa4, 0xda, 0x09, 0xa6, 0x33, 0x9e, 0x39, 0x15, 0xfe, 0x58, 0xba, 0x4f,
-	0xe1, 0x8f, 0x3f, 0x6f, 0xa5, 0x72, 0x89, 0x0d, 0x9e, 0x7f, 0xa9, 0x58, 0xc7, 0xf1, 0x49, 0xee,
-	0xa0, 0x93, 0x91, 0x2b, 0x19, 0xbf, 0xac, 0xa7, 0xa0, 0xba, 0xe1, 0x3b, 0x2c, 0x03, 0x06, 0xdb,
-	0xa8, 0x86, 0xf3, 0xd0, 0x45, 0x51, 0x8e, 0x15, 0x06, 0xe5, 0xfa, 0x46, 0xa3, 0x87, 0xe2, 0xda,
-	0xff, 0xa9, 0x0c, 0x83, 0x86, 0xc4, 0xcf, 0x55, 0xdf, 0xac, 0x07, 0x4c, 0x7d, 0x2b, 0x1d, 0x42,
-	0x7d, 0xfb, 0x39, 0xa8, 0xb9, 0x52, 0x1a, 0x15, 0x93, 0x1b, 0x3d, 0x2b, 0xe3, 0xb4, 0x40, 0x52,
-	0x45, 0x58, 0xd3, 0x44, 0xf3, 0xa9, 0x10, 0xbb, 0x94, 0x5d, 0x20, 0x2f, 0x06, 0x4e, 0x48, 0xb4,
-	0xce, 0x3a, 0xd9, 0x7b, 0xea, 0xca, 0xc1, 0xf7, 0xd4, 0xf6, 0x77, 0x2c, 0x35, 0xb9, 0xf7, 0x20,
-	0x97, 0xca, 0xcd, 0x74, 0x2e, 0x95, 0x0b, 0x85, 0x0c, 0x73, 0x97, 0x24, 0x2a, 0x57, 0x61, 0x60,
-	0x36, 0x6c, 0x36, 0x9d, 0xa0, 0x8e, 0x7e, 0x0c, 0x06, 0x5c, 0xfe, 0x53, 0xd8, 0xd0, 0xd8, 0x4d,
-	0xac, 0x80, 0x62, 0x09, 0x43, 0x8f, 0x40, 0x9f, 0x13, 0x35, 0xa4, 0xdd, 0x8c, 0x79, 0x4c, 0x4d,
-	0x47, 0x8d, 0x18, 0xb3, 0x52, 0xfb, 0x9f, 0xf5, 0x01, 0x73, 0x54, 0x70, 0x22, 0x52, 0x5f, 0x0b,
-	0x59, 0x4a, 0xd3, 0x63, 0xbd, 0xbf, 0xd4, 0x87, 0xba, 0x07, 0xf9, 0x0e, 0xd3, 0xb8, 0xc7, 0x2a,
-	0xdf, 0xe3, 0x7b, 0xac, 0x2e, 0x57, 0x93, 0x7d, 0x0f, 0xd0, 0xd5, 0xa4, 0xfd, 0x59, 0x0b, 0x90,
-	0xf2, 0x6e, 0xd1, 0xbe, 0x03, 0x53, 0x50, 0x53, 0x7e, 0x2e, 0x42, 0x01, 0xd4, 0x2c, 0x42, 0x02,
-	0xb0, 0xc6, 0xe9, 0xe1, 0x24, 0xff, 0xb8, 0xe4, 0xdf, 0xe5, 0xb4, 0xe3, 0x38, 0xe3, 0xfa, 0x82,
-	0x9d, 0xdb, 0xbf, 0x5b, 0x82, 0x87, 0xb8, 0xea, 0xb0, 0xe4, 0x04, 0x4e, 0x83, 0x34, 0x69, 0xaf,
-	0x7a, 0xf5, 0x06, 0x71, 0xe9, 0x11, 0xd2, 0x93, 0x8e, 0xe0, 0x47, 0xdd, 0xbb, 0x7c, 0xcf, 0xf1,
-	0x5d, 0xb6, 0x10, 0x78, 0x09, 0x66, 0x8d, 0xa3, 0x18, 0xaa, 0xf2, 0xe1, 0x10, 0xc1, 0x8b, 0x0b,
-	0x22, 0xa4, 0xd8, 0x92, 0x90, 0xb2, 0x04, 0x2b, 0x42, 0x54, 0x94, 0xfa, 0xa1, 0xbb, 0x85, 0x49,
-	0x2b, 0xcc, 0x8a, 0xd2, 0x45, 0x51, 0x8e, 0x15, 0x86, 0xdd, 0x84, 0x51, 0x39, 0x86, 0xad, 0x2b,
-	0x64, 0x17, 0x93, 0x0d, 0x2a, 0x7f, 0x5c, 0x59, 0x64, 0xbc, 0x65, 0xa2, 0xe4, 0xcf, 0xac, 0x09,
-	0xc4, 0x69, 0x5c, 0x99, 0xe5, 0xb4, 0x94, 0x9f, 0xe5, 0xd4, 0xfe, 0x5d, 0x0b, 0xb2, 0x02, 0xd0,
-	0xc8, 0xe9, 0x68, 0xed, 0x9b, 0xd3, 0xf1, 0x10, 0x59, 0x11, 0x7f, 0x06, 0x06, 0x9d, 0x84, 0x6a,
-	0x38, 0xdc, 0x1a, 0x51, 0xbe, 0xbb, 0x0b, 0xab, 0xa5, 0xb0, 0xee, 0x6d, 0x78, 0xcc, 0x0a, 0x61,
-	0x36, 0x67, 0xff, 0x55, 0x1f, 0x8c, 0x75, 0x44, 0x69, 0xa1, 0xe7, 0x61, 0x48, 0x0d, 0x85, 0xb4,
-	0xf3, 0xd5, 0x4c, 0xd7, 0x4a, 0x0d, 0xc3, 0x29, 0xcc, 0x1e, 0xf6, 0xc3, 0x02, 0x9c, 0x8c, 0xc8,
-	0xab, 0x6d, 0xd2, 0x26, 0xd3, 0x1b, 0x09, 0x89, 0x56, 0x89, 0x1b, 0x06, 0x75, 0x9e, 0x79, 0xb4,
-	0x3c, 0xf3, 0xf0, 0xed, 0xbd, 0x89, 0x93, 0xb8, 0x13, 0x8c, 0xf3, 0xea, 0xa0, 0x16, 0x0c, 0xfb,
-	0xa6, 0x82, 0x2a, 0xce, 0x45, 0x77, 0xa5, 0xdb, 0xaa, 0x25, 0x91, 0x2a, 0xc6, 0x69, 0x02, 0x69,
-	0x2d, 0xb7, 0x72, 0x9f, 0xb4, 0xdc, 0x4f, 0x68, 0x2d, 0x97, 0x7b, 0x56, 0x7c, 0xa0, 0xe0, 0x28,
-	0xbd, 0x5e, 0xd4, 0xdc, 0xa3, 0x28, 0xae, 0x2f, 0x42, 0x55, 0x7a, 0x9d, 0xf5, 0xe4, 0xad, 0x65,
-	0xb6, 0xd3, 0x85, 0x81, 0x3e, 0x01, 0x3f, 0x7a, 0x21, 0x8a, 0x8c, 0xc1, 0xbc, 0x1a, 0x26, 0xd3,
-	0xbe, 0x1f, 0xde, 0xa2, 0x3a, 0xc1, 0xb5, 0x98, 0x08, 0xc3, 0x93, 0x7d, 0xa7, 0x04, 0x39, 0x67,
-	0x38, 0xba, 0x1f, 0xb5, 0x22, 0x92, 0xda, 0x8f, 0x87, 0x53, 0x46, 0xd0, 0x0e, 0xf7, 0xcc, 0xe3,
-	0x22, 0xf7, 0xfd, 0x45, 0x9f, 0x41, 0xb5, 0xb3, 0x9e, 0x62, 0x47, 0xca, 0x61, 0xef, 0x3c, 0x80,
-	0xd6, 0x1f, 0x45, 0xe8, 0x88, 0xba, 0xf8, 0xd7, 0x6a, 0x26, 0x36, 0xb0, 0xd0, 0x73, 0x30, 0xe8,
-	0x05, 0x71, 0xe2, 0xf8, 0xfe, 0x25, 0x2f, 0x48, 0x84, 0x6d, 0x55, 0xe9, 0x16, 0x0b, 0x1a, 0x84,
-	0x4d, 0xbc, 0xb3, 0xef, 0x34, 0xe6, 0xef, 0x30, 0xf3, 0xbe, 0x09, 0x67, 0xe6, 0xbd, 0x44, 0x05,
-	0x3c, 0xa9, 0xf5, 0x46, 0xd5, 0x43, 0x15, 0xc0, 0x67, 0x75, 0x0d, 0xe0, 0x33, 0x02, 0x8e, 0x4a,
-	0xe9, 0xf8, 0xa8, 0x6c, 0xc0, 0x91, 0xfd, 0x3c, 0x9c, 0x9a, 0xf7, 0x92, 0x8b, 0x9e, 0x4f, 0x0e,
-	0x49, 0xc4, 0xfe, 0x9d, 0x7e, 0x18, 0x32, 0x43, 0x77, 0x0f, 0x13, 0x83, 0xf8, 0x79, 0xaa, 0x01,
-	0x8a, 0xaf, 0xf3, 0xd4, 0xb5, 0xe9, 0x8d, 0x23, 0xc7, 0x11, 0xe7, 0x8f, 0x98, 0xa1, 0x04, 0x6a,
-	0x9a, 0xd8, 0xec, 0x00, 0xba, 0x05, 0x95, 0x0d, 0x16, 0x10, 0x53, 0x2e, 0xc2, 0xb7, 0x24, 0x6f,
-	0x44, 0xf5, 0x76, 0xe4, 0x21, 0x35, 0x9c, 0x1e, 0x15, 0xdc, 0x51, 0x3a, 0xca, 0xd2, 0x70, 0x7c,
-	0x16, 0xf1, 0x95, 0x0a, 0xa3, 0x9b, 0x48, 0xa8, 0xdc, 0x85, 0x48, 0x48, 0x31, 0xe8, 0xfe, 0xfb,
-	0xc4, 0xa0, 0x59, 0x70, 0x53, 0xb2, 0xc9, 0xd4, 0x4a, 0x11, 0xa9, 0x31, 0xc0, 0x06, 0xc1, 0x08,
-	0x6e, 0x4a, 0x81, 0x71, 0x16, 0x1f, 0x7d, 0x54, 0xb1, 0xf8, 0x6a, 0x11, 0x66, 0x69, 0x73, 0x45,
-	0x1f, 0x37, 0x77, 0xff, 0x6c, 0x09, 0x46, 0xe6, 0x83, 0xf6, 0xca, 0xfc, 0x4a, 0x7b, 0xdd, 0xf7,
-	0xdc, 0x2b, 0x64, 0x97, 0xb2, 0xf0, 0x2d, 0xb2, 0xbb, 0x30, 0x27, 0x76, 0x90, 0x5a, 0x33, 0x57,
-	0x68, 0x21, 0xe6, 0x30, 0xca, 0x8c, 0x36, 0xbc, 0xa0, 0x41, 0xa2, 0x56, 0xe4, 0x09, 0x8b, 0xb1,
-	0xc1, 0x8c, 0x2e, 0x6a, 0x10, 0x36, 0xf1, 0x68, 0xdb, 0xe1, 0xad, 0x80, 0x44, 0x59, 0xfd, 0x7a,
-	0x99, 0x16, 0x62, 0x0e, 0xa3, 0x48, 0x49, 0xd4, 0x16, 0x06, 0x19, 0x03, 0x69, 0x8d, 0x16, 0x62,
-	0x0e, 0xa3, 0x3b, 0x3d, 0x6e, 0xaf, 0x33, 0xd7, 0x9d, 0x4c, 0x58, 0xc8, 0x2a, 0x2f, 0xc6, 0x12,
-	0x4e, 0x51, 0xb7, 0xc8, 0xee, 0x1c, 0x3d, 0x8c, 0x67, 0x22, 0xdd, 0xae, 0xf0, 0x62, 0x2c, 0xe1,
-	0x2c, 0x37, 0x6a, 0x7a, 0x38, 0x7e, 0xe0, 0x72, 0xa3, 0xa6, 0xbb, 0xdf, 0xe5, 0x58, 0xff, 0x6b,
-	0x16, 0x0c, 0x99, 0x0e, 0x77, 0xa8, 0x91, 0xd1, 0x85, 0x97, 0x3b, 0x52, 0x6b, 0xbf, 0x3b, 0xef,
-	0xd9, 0xc9, 0x86, 0x97, 0x84, 0xad, 0xf8, 0x69, 0x12, 0x34, 0xbc, 0x80, 0x30, 0x87, 0x08, 0xee,
-	0xa8, 0x97, 0xf2, 0xe6, 0x9b, 0x0d, 0xeb, 0xe4, 0x2e, 0x94, 0x69, 0xfb, 0x06, 0x8c, 0x75, 0x84,
-	0x37, 0xf6, 0xa0, 0x82, 0x1c, 0x18, 0x5c, 0x6e, 0x63, 0x18, 0xa4, 0x0d, 0xcb, 0xfc, 0x5c, 0xb3,
-	0x30, 0xc6, 0x37, 0x12, 0xa5, 0xb4, 0xea, 0x6e, 0x92, 0xa6, 0x0a, 0x59, 0x65, 0xd7, 0x13, 0xd7,
-	0xb3, 0x40, 0xdc, 0x89, 0x6f, 0x7f, 0xce, 0x82, 0xe1, 0x54, 0xc4, 0x69, 0x41, 0xca, 0x12, 0xdb,
-	0x69, 0x21, 0xf3, 0xff, 0x64, 0x4e, 0xf0, 0x65, 0x26, 0x4c, 0xf5, 0x4e, 0xd3, 0x20, 0x6c, 0xe2,
-	0xd9, 0x5f, 0x2c, 0x41, 0x55, 0xfa, 0xd0, 0xf4, 0xd0, 0x95, 0xcf, 0x58, 0x30, 0xac, 0xae, 0x84,
-	0x98, 0x0d, 0xaf, 0x54, 0x44, 0x48, 0x0d, 0xed, 0x81, 0xb2, 0x02, 0x04, 0x1b, 0xa1, 0xd6, 0xdc,
-	0xb1, 0x49, 0x0c, 0xa7, 0x69, 0xa3, 0xeb, 0x00, 0xf1, 0x6e, 0x9c, 0x90, 0xa6, 0x61, 0x4d, 0xb4,
-	0x8d, 0x1d, 0x37, 0xe9, 0x86, 0x11, 0xa1, 0xfb, 0xeb, 0x6a, 0x58, 0x27, 0xab, 0x0a, 0x53, 0xab,
-	0x50, 0xba, 0x0c, 0x1b, 0x2d, 0xd9, 0xff, 0xa4, 0x04, 0x27, 0xb2, 0x5d, 0x42, 0x1f, 0x80, 0x21,
-	0x49, 0xdd, 0x38, 0x75, 0x4a, 0x0f, 0xa0, 0x21, 0x6c, 0xc0, 0xee, 0xec, 0x4d, 0x4c, 0x74, 0x3e,
-	0x61, 0x3a, 0x69, 0xa2, 0xe0, 0x54, 0x63, 0xfc, 0x5e, 0x4e, 0x5c, 0x20, 0xcf, 0xec, 0x4e, 0xb7,
-	0x5a, 0xe2, 0x72, 0xcd, 0xb8, 0x97, 0x33, 0xa1, 0x38, 0x83, 0x8d, 0x56, 0xe0, 0x94, 0x51, 0x72,
-	0x95, 0x78, 0x8d, 0xcd, 0xf5, 0x30, 0x92, 0x27, 0xb0, 0x47, 0xb4, 0x6b, 0x5f, 0x27, 0x0e, 0xce,
-	0xad, 0x49, 0xa5, 0xbd, 0xeb, 0xb4, 0x1c, 0xd7, 0x4b, 0x76, 0x85, 0x79, 0x54, 0xf1, 0xa6, 0x59,
-	0x51, 0x8e, 0x15, 0x86, 0xbd, 0x04, 0x7d, 0x3d, 0xae, 0xa0, 0x9e, 0x34, 0xff, 0x17, 0xa1, 0x4a,
-	0x9b, 0x93, 0xea, 0x5d, 0x11, 0x4d, 0x86, 0x50, 0x95, 0x0f, 0x42, 0x21, 0x1b, 0xca, 0x9e, 0x23,
-	0xaf, 0x3e, 0xd5, 0x67, 0x2d, 0xc4, 0x71, 0x9b, 0x1d, 0xa6, 0x29, 0x10, 0x3d, 0x0e, 0x65, 0xb2,
-	0xd3, 0xca, 0xde, 0x71, 0x5e, 0xd8, 0x69, 0x79, 0x11, 0x89, 0x29, 0x12, 0xd9, 0x69, 0xa1, 0xb3,
-	0x50, 0xf2, 0xea, 0x42, 0x48, 0x81, 0xc0, 0x29, 0x2d, 0xcc, 0xe1, 0x92, 0x57, 0xb7, 0x77, 0xa0,
-	0xa6, 0x5e, 0xa0, 0x42, 0x5b, 0x92, 0x77, 0x5b, 0x45, 0x38, 0xbd, 0xc9, 0x76, 0xbb, 0x70, 0xed,
-	0x36, 0x80, 0x0e, 0x57, 0x2d, 0x8a, 0xbf, 0x9c, 0x83, 0x3e, 0x37, 0x14, 0x69, 0x01, 0xaa, 0xba,
-	0x19, 0xc6, 0xb4, 0x19, 0xc4, 0xbe, 0x01, 0x23, 0x57, 0x82, 0xf0, 0x16, 0x7b, 0x28, 0x82, 0xe5,
-	0x45, 0xa4, 0x0d, 0x6f, 0xd0, 0x1f, 0x59, 0x15, 0x81, 0x41, 0x31, 0x87, 0xa9, 0x8c, 0x6d, 0xa5,
-	0x6e, 0x19, 0xdb, 0xec, 0x8f, 0x59, 0x30, 0xa4, 0xe2, 0xde, 0xe6, 0xb7, 0xb7, 0x68, 0xbb, 0x8d,
-	0x28, 0x6c, 0xb7, 0xb2, 0xed, 0xb2, 0xc7, 0xee, 0x30, 0x87, 0x99, 0x01, 0xa1, 0xa5, 0x03, 0x02,
-	0x42, 0xcf, 0x41, 0xdf, 0x96, 0x17, 0xd4, 0xb3, 0x8f, 0x1e, 0x5d, 0xf1, 0x82, 0x3a, 0x66, 0x10,
-	0xda, 0x85, 0x13, 0xaa, 0x0b, 0x52, 0x20, 0x3c, 0x0f, 0x43, 0xeb, 0x6d, 0xcf, 0xaf, 0xcb, 0x84,
-	0x8f, 0x19, 0x8b, 0xca, 0x8c, 0x01, 0xc3, 0x29, 0x4c, 0x7a, 0xae, 0x5b, 0xf7, 0x02, 0x27, 0xda,
-	0x5d, 0xd1, 0x12, 0x48, 0x31, 0xa5, 0x19, 0x05, 0xc1, 0x06, 0x96, 0xfd, 0x7a, 0x19, 0x46, 0xd2,
-	0xd1, 0x7f, 0x3d, 0x1c, 0xaf, 0x1e, 0x87, 0x0a, 0x0b, 0x08, 0xcc, 0x4e, 0x2d, 0xcf, 0x91, 0xc8,
-	0x61, 0x28, 0x86, 0x7e, 0x9e, 0x16, 0xa5, 0x98, 0x07, 0xc3, 0x54, 0x27, 0x95, 0x1d, 0x86, 0xb9,
-	0x06, 0x8a, 0x4c, 0x2c, 0x82, 0x14, 0xfa, 0xa4, 0x05, 0x03, 0x61, 0xcb, 0xcc, 0xf4, 0xf5, 0xfe,
-	0x22, 0x23, 0x23, 0x45, 0xb8, 0x94, 0xd0, 0x88, 0xd5, 0xd4, 0xcb, 0xe9, 0x90, 0xa4, 0xcf, 0xbe,
-	0x0b, 0x86, 0x4c, 0xcc, 0x83, 0x94, 0xe2, 0xaa, 0xa9, 0x14, 0x7f, 0xc6, 0x5c, 0x14, 0x22, 0xf6,
-	0xb3, 0x87, 0xed, 0x76, 0x0d, 0x2a, 0xae, 0xf2, 0x9f, 0xb8, 0xab, 0x34, 0xc1, 0x2a, 0x4f, 0x09,
-	0xbb, 0x9b, 0xe2, 0xad, 0xd9, 0xdf, 0xb1, 0x8c, 0xf5, 0x81, 0x49, 0xbc, 0x50, 0x47, 0x11, 0x94,
-	0x1b, 0xdb, 0x5b, 0x42, 0x15, 0xbd, 0x5c, 0xd0, 0xf0, 0xce, 0x6f, 0x6f, 0xe9, 0x35, 0x6e, 0x96,
-	0x62, 0x4a, 0xac, 0x07, 0x63, 0x61, 0x2a, 0x44, 0xb8, 0x7c, 0x70, 0x88, 0xb0, 0xfd, 0x46, 0x09,
-	0xc6, 0x3a, 0x16, 0x15, 0x7a, 0x0d, 0x2a, 0x11, 0xfd, 0x4a, 0xf1, 0x79, 0x8b, 0x85, 0x05, 0xf5,
-	0xc6, 0x0b, 0x75, 0x2d, 0x77, 0xd3, 0xe5, 0x98, 0x93, 0x44, 0x97, 0x01, 0x69, 0x2f, 0x1f, 0x65,
-	0xa9, 0xe4, 0x9f, 0xac, 0x5c, 0x01, 0xa6, 0x3b, 0x30, 0x70, 0x4e, 0x2d, 0xf4, 0x42, 0xd6, 0xe0,
-	0x59, 0x4e, 0x9b, 0xb3, 0xf7, 0xb3, 0x5d, 0xda, 0xbf, 0x55, 0x82, 0xe1, 0x54, 0xe2, 0x35, 0xe4,
-	0x43, 0x95, 0xf8, 0xec, 0xae, 0x41, 0x0a, 0x9b, 0xa3, 0xa6, 0x51, 0x57, 0x02, 0xf2, 0x82, 0x68,
-	0x17, 0x2b, 0x0a, 0x0f, 0x86, 0x87, 0xc0, 0xf3, 0x30, 0x24, 0x3b, 0xf4, 0x7e, 0xa7, 0xe9, 0x8b,
-	0x01, 0x54, 0x6b, 0xf4, 0x82, 0x01, 0xc3, 0x29, 0x4c, 0xfb, 0xf7, 0xca, 0x30, 0xce, 0x2f, 0x67,
-	0xea, 0x6a, 0xe5, 0x2d, 0xc9, 0xf3, 0xd6, 0xdf, 0xd0, 0xe9, 0x11, 0xad, 0x22, 0xde, 0x0a, 0xed,
-	0x46, 0xa8, 0x27, 0xc7, 0xb6, 0xaf, 0x64, 0x1c, 0xdb, 0xb8, 0xda, 0xdd, 0x38, 0xa6, 0x1e, 0xfd,
-	0x60, 0x79, 0xba, 0xfd, 0xc3, 0x12, 0x8c, 0x66, 0x9e, 0x84, 0x41, 0xaf, 0xa7, 0xb3, 0x88, 0x5b,
-	0x45, 0xd8, 0xd4, 0xf7, 0x7d, 0x25, 0xe4, 0x70, 0xb9, 0xc4, 0xef, 0xd3, 0x56, 0xb1, 0xbf, 0x5d,
-	0x82, 0x91, 0xf4, 0x5b, 0x36, 0x0f, 0xe0, 0x48, 0xbd, 0x1d, 0x6a, 0xec, 0xb9, 0x06, 0xf6, 0x04,
-	0x33, 0x37, 0xc9, 0xf3, 0xcc, 0xf8, 0xb2, 0x10, 0x6b, 0xf8, 0x03, 0x91, 0xa2, 0xdd, 0xfe, 0xc7,
-	0x16, 0x9c, 0xe6, 0x5f, 0x99, 0x5d, 0x87, 0x7f, 0x33, 0x6f, 0x74, 0x5f, 0x2e, 0xb6, 0x83, 0x99,
-	0xb4, 0x9e, 0x07, 0x8d, 0x2f, 0x7b, 0x31, 0x55, 0xf4, 0x36, 0xbd, 0x14, 0x1e, 0xc0, 0xce, 0x1e,
-	0x6a, 0x31, 0xd8, 0xdf, 0x2e, 0x83, 0x7e, 0x24, 0x16, 0x79, 0x22, 0xca, 0xb5, 0x90, 0xf4, 0xa6,
-	0xab, 0xbb, 0x81, 0xab, 0x9f, 0xa3, 0xad, 0x66, 0x82, 0x5c, 0x7f, 0xd1, 0x82, 0x41, 0x2f, 0xf0,
-	0x12, 0xcf, 0x61, 0xc7, 0xe8, 0x62, 0x5e, 0x7a, 0x54, 0xe4, 0x16, 0x78, 0xcb, 0x61, 0x64, 0xde,
-	0xe3, 0x28, 0x62, 0xd8, 0xa4, 0x8c, 0x3e, 0x24, 0x7c, 0xcf, 0xcb, 0x85, 0xc5, 0x67, 0x57, 0x33,
-	0x0e, 0xe7, 0x2d, 0xaa, 0x78, 0x25, 0x51, 0x41, 0x69, 0x0d, 0x30, 0x6d, 0x4a, 0x65, 0xca, 0x56,
-	0xaa, 0x2d, 0x2b, 0xc6, 0x9c, 0x90, 0x1d, 0x03, 0xea, 0x1c, 0x8b, 0x43, 0xfa, 0xf5, 0x4e, 0x41,
-	0xcd, 0x69, 0x27, 0x61, 0x93, 0x0e, 0x93, 0xb8, 0x6a, 0xd2, 0x9e, 0xcb, 0x12, 0x80, 0x35, 0x8e,
-	0xfd, 0x7a, 0x05, 0x32, 0x61, 0xa7, 0x68, 0xc7, 0x7c, 0xe0, 0xd8, 0x2a, 0xf6, 0x81, 0x63, 0xd5,
-	0x99, 0xbc, 0x47, 0x8e, 0x51, 0x03, 0x2a, 0xad, 0x4d, 0x27, 0x96, 0x6a, 0xf5, 0x8b, 0xea, 0x1c,
-	0x47, 0x0b, 0xef, 0xec, 0x4d, 0xfc, 0x74, 0x6f, 0x56, 0x57, 0xba, 0x56, 0xa7, 0x78, 0xaa, 0x1c,
-	0x4d, 0x9a, 0xb5, 0x81, 0x79, 0xfb, 0x87, 0x79, 0xeb, 0xf2, 0xe3, 0xe2, 0x5d, 0x0a, 0x4c, 0xe2,
-	0xb6, 0x9f, 0x88, 0xd5, 0xf0, 0x62, 0x81, 0xbb, 0x8c, 0x37, 0xac, 0x13, 0x26, 0xf0, 0xff, 0xd8,
-	0x20, 0x8a, 0x3e, 0x00, 0xb5, 0x38, 0x71, 0xa2, 0xe4, 0x2e, 0x43, 0x9c, 0x75, 0x4a, 0x33, 0xd9,
-	0x08, 0xd6, 0xed, 0xa1, 0x97, 0x58, 0xb6, 0x67, 0x2f, 0xde, 0xbc, 0xcb, 0x90, 0x11, 0x99, 0x19,
-	0x5a, 0xb4, 0x80, 0x8d, 0xd6, 0xd0, 0x79, 0x00, 0xb6, 0xb6, 0xb9, 0xff, 0x61, 0x95, 0x59, 0x99,
-	0x14, 0x2b, 0xc4, 0x0a, 0x82, 0x0d, 0x2c, 0xfb, 0x27, 0x20, 0x9d, 0xf1, 0x03, 0x4d, 0xc8, 0x04,
-	0x23, 0xdc, 0x0a, 0xcd, 0x42, 0x3f, 0x52, 0xb9, 0x40, 0x7e, 0xc3, 0x02, 0x33, 0x2d, 0x09, 0x7a,
-	0x95, 0xe7, 0x3f, 0xb1, 0x8a, 0xb8, 0x39, 0x34, 0xda, 0x9d, 0x5c, 0x72, 0x5a, 0x99, 0x2b, 0x6c,
-	0x99, 0x04, 0xe5, 0xec, 0x3b, 0xa1, 0x2a, 0xa1, 0x87, 0x52, 0xea, 0x3e, 0x0a, 0x27, 0x65, 0x18,
-	0xa9, 0xb4, 0x9b, 0x8a, 0x5b, 0xa7, 0x83, 0x4d, 0x3f, 0xd2, 0x9e, 0x53, 0xea, 0x66, 0xcf, 0xe9,
-	0xe1, 0x99, 0xeb, 0xdf, 0xb4, 0xe0, 0x5c, 0xb6, 0x03, 0xf1, 0x52, 0x18, 0x78, 0x49, 0x18, 0xad,
-	0x92, 0x24, 0xf1, 0x82, 0x06, 0x4b, 0xfb, 0x76, 0xcb, 0x89, 0x64, 0x1a, 0x7e, 0xc6, 0x28, 0x6f,
-	0x38, 0x51, 0x80, 0x59, 0x29, 0xda, 0x85, 0x7e, 0xee, 0xa4, 0x26, 0xb4, 0xf5, 0x23, 0xee, 0x8d,
-	0x9c, 0xe1, 0xd0, 0xc7, 0x05, 0xee, 0x20, 0x87, 0x05, 0x41, 0xfb, 0x7b, 0x16, 0xa0, 0xe5, 0x6d,
-	0x12, 0x45, 0x5e, 0xdd, 0x70, 0xab, 0x63, 0xef, 0x3b, 0x19, 0xef, 0x38, 0x99, 0x41, 0xce, 0x99,
-	0xf7, 0x9d, 0x8c, 0x7f, 0xf9, 0xef, 0x3b, 0x95, 0x0e, 0xf7, 0xbe, 0x13, 0x5a, 0x86, 0xd3, 0x4d,
-	0x7e, 0xdc, 0xe0, 0x6f, 0xa6, 0xf0, 0xb3, 0x87, 0x8a, 0xc7, 0x3b, 0x73, 0x7b, 0x6f, 0xe2, 0xf4,
-	0x52, 0x1e, 0x02, 0xce, 0xaf, 0x67, 0xbf, 0x13, 0x10, 0xf7, 0xa6, 0x9b, 0xcd, 0xf3, 0x55, 0xea,
-	0x6a, 0x7e, 0xb1, 0xbf, 0x5c, 0x81, 0xd1, 0x4c, 0x92, 0x66, 0x7a, 0xd4, 0xeb, 0x74, 0x8e, 0x3a,
-	0xb2, 0xfc, 0xee, 0xec, 0x5e, 0x4f, 0xee, 0x56, 0x01, 0x54, 0xbc, 0xa0, 0xd5, 0x4e, 0x8a, 0x09,
-	0x07, 0xe6, 0x9d, 0x58, 0xa0, 0x0d, 0x1a, 0xe6, 0x62, 0xfa, 0x17, 0x73, 0x32, 0x45, 0x3a, 0x6f,
-	0xa5, 0x94, 0xf1, 0xbe, 0xfb, 0x64, 0x0e, 0xf8, 0xb8, 0x76, 0xa5, 0xaa, 0x14, 0x61, 0x58, 0xcc,
-	0x2c, 0x96, 0xe3, 0xbe, 0x6a, 0xff, 0x46, 0x09, 0x06, 0x8d, 0x49, 0x43, 0xbf, 0x9a, 0x4e, 0xda,
-	0x65, 0x15, 0xf7, 0x49, 0xac, 0xfd, 0x49, 0x9d, 0x96, 0x8b, 0x7f, 0xd2, 0x13, 0x9d, 0xf9, 0xba,
-	0xee, 0xec, 0x4d, 0x9c, 0xc8, 0x64, 0xe4, 0x4a, 0xe5, 0xf0, 0x3a, 0xfb, 0x11, 0x18, 0xcd, 0x34,
-	0x93, 0xf3, 0xc9, 0x6b, 0xe6, 0x27, 0x1f, 0xd9, 0x2c, 0x65, 0x0e, 0xd9, 0xd7, 0xe9, 0x90, 0x89,
-	0x28, 0xc4, 0xd0, 0x27, 0x3d, 0xd8, 0x60, 0x33, 0xc1, 0xc6, 0xa5, 0x1e, 0x83, 0x8d, 0x9f, 0x84,
-	0x6a, 0x2b, 0xf4, 0x3d, 0xd7, 0x53, 0x39, 0x34, 0x59, 0x78, 0xf3, 0x8a, 0x28, 0xc3, 0x0a, 0x8a,
-	0x6e, 0x41, 0xed, 0xe6, 0xad, 0x84, 0xdf, 0xfe, 0x08, 0xfb, 0x76, 0x51, 0x97, 0x3e, 0x4a, 0x69,
-	0x51, 0xd7, 0x4b, 0x58, 0xd3, 0x42, 0x36, 0xf4, 0x33, 0x21, 0x28, 0x23, 0x12, 0x98, 0xed, 0x9d,
-	0x49, 0xc7, 0x18, 0x0b, 0x88, 0xfd, 0xb5, 0x1a, 0x9c, 0xca, 0xcb, 0x94, 0x8f, 0x3e, 0x0c, 0xfd,
-	0xbc, 0x8f, 0xc5, 0x3c, 0xc6, 0x92, 0x47, 0x63, 0x9e, 0x35, 0x28, 0xba, 0xc5, 0x7e, 0x63, 0x41,
-	0x53, 0x50, 0xf7, 0x9d, 0x75, 0xb1, 0x42, 0x8e, 0x87, 0xfa, 0xa2, 0xa3, 0xa9, 0x2f, 0x3a, 0x9c,
-	0xba, 0xef, 0xac, 0xa3, 0x1d, 0xa8, 0x34, 0xbc, 0x84, 0x38, 0xc2, 0x88, 0x70, 0xe3, 0x58, 0x88,
-	0x13, 0x87, 0x6b, 0x69, 0xec, 0x27, 0xe6, 0x04, 0xd1, 0x57, 0x2d, 0x18, 0x5d, 0x4f, 0x67, 0x39,
-	0x10, 0xcc, 0xd3, 0x39, 0x86, 0xd7, 0x10, 0xd2, 0x84, 0xf8, 0x03, 0x67, 0x99, 0x42, 0x9c, 0xed,
-	0x0e, 0xfa, 0x84, 0x05, 0x03, 0x1b, 0x9e, 0x6f, 0x24, 0xa4, 0x3e, 0x86, 0xc9, 0xb9, 0xc8, 0x08,
-	0xe8, 0x13, 0x07, 0xff, 0x1f, 0x63, 0x49, 0xb9, 0x9b, 0xa4, 0xea, 0x3f, 0xaa, 0xa4, 0x1a, 0xb8,
-	0x4f, 0x92, 0xea, 0xd3, 0x16, 0xd4, 0xd4, 0x48, 0x8b, 0x68, 0xf1, 0x0f, 0x1c, 0xe3, 0x94, 0x73,
-	0xcb, 0x89, 0xfa, 0x8b, 0x35, 0x71, 0xf4, 0x05, 0x0b, 0x06, 0x9d, 0xd7, 0xda, 0x11, 0xa9, 0x93,
-	0xed, 0xb0, 0x15, 0x8b, 0xd7, 0x51, 0x5f, 0x2e, 0xbe, 0x33, 0xd3, 0x94, 0xc8, 0x1c, 0xd9, 0x5e,
-	0x6e, 0xc5, 0x22, 0x5a, 0x4a, 0x17, 0x60, 0xb3, 0x0b, 0xf6, 0x5e, 0x09, 0x26, 0x0e, 0x68, 0x01,
-	0x3d, 0x0f, 0x43, 0x61, 0xd4, 0x70, 0x02, 0xef, 0x35, 0x33, 0x6d, 0x89, 0xd2, 0xb2, 0x96, 0x0d,
-	0x18, 0x4e, 0x61, 0x9a, 0xf1, 0xec, 0xa5, 0x03, 0xe2, 0xd9, 0xcf, 0x41, 0x5f, 0x44, 0x5a, 0x61,
-	0xf6, 0xb0, 0xc0, 0x22, 0x15, 0x18, 0x04, 0x3d, 0x0a, 0x65, 0xa7, 0xe5, 0x09, 0x47, 0x34, 0x75,
-	0x06, 0x9a, 0x5e, 0x59, 0xc0, 0xb4, 0x3c, 0x95, 0x5e, 0xa3, 0x72, 0x4f, 0xd2, 0x6b, 0x50, 0x31,
-	0x20, 0xee, 0x2e, 0xfa, 0xb5, 0x18, 0x48, 0xdf, 0x29, 0xd8, 0x6f, 0x94, 0xe1, 0xd1, 0x7d, 0xd7,
-	0x8b, 0xf6, 0xc3, 0xb3, 0xf6, 0xf1, 0xc3, 0x93, 0xc3, 0x53, 0x3a, 0x68, 0x78, 0xca, 0x5d, 0x86,
-	0xe7, 0x13, 0x74, 0x1b, 0xc8, 0x74, 0x2f, 0xc5, 0xbc, 0x6f, 0xd9, 0x2d, 0x7b, 0x8c, 0xd8, 0x01,
-	0x12, 0x8a, 0x35, 0x5d, 0x7a, 0x06, 0x48, 0xc5, 0x72, 0x57, 0x8a, 0x10, 0x03, 0x5d, 0x53, 0xae,
-	0xf0, 0xb5, 0xdf, 0x2d, 0x40, 0xdc, 0xfe, 0xed, 0x3e, 0x78, 0xbc, 0x07, 0xee, 0x6d, 0xae, 0x62,
-	0xab, 0xc7, 0x55, 0xfc, 0x03, 0x3e, 0x4d, 0x9f, 0xca, 0x9d, 0x26, 0x5c, 0xfc, 0x34, 0xed, 0x3f,
-	0x43, 0xe8, 0x29, 0xa8, 0x7a, 0x41, 0x4c, 0xdc, 0x76, 0xc4, 0x7d, 0x92, 0x8d, 0x30, 0xa6, 0x05,
-	0x51, 0x8e, 0x15, 0x06, 0x3d, 0xd3, 0xb9, 0x0e, 0xdd, 0xfe, 0x03, 0x05, 0xc5, 0xee, 0x9a, 0x11,
-	0x51, 0x5c, 0xa5, 0x98, 0x9d, 0xa6, 0x1c, 0x80, 0x93, 0xb1, 0xff, 0x96, 0x05, 0x67, 0xbb, 0x8b,
-	0x58, 0xf4, 0x0c, 0x0c, 0xae, 0x47, 0x4e, 0xe0, 0x6e, 0xb2, 0x97, 0x8d, 0xe5, 0xd2, 0x61, 0xdf,
-	0xab, 0x8b, 0xb1, 0x89, 0x83, 0x66, 0x61, 0x8c, 0x7b, 0x6e, 0x18, 0x18, 0x32, 0xf2, 0xf7, 0xf6,
-	0xde, 0xc4, 0xd8, 0x5a, 0x16, 0x88, 0x3b, 0xf1, 0xed, 0xef, 0x97, 0xf3, 0xbb, 0xc5, 0x55, 0xb1,
-	0xc3, 0xac, 0x66, 0xb1, 0x56, 0x4b, 0x3d, 0x70, 0xdc, 0xf2, 0xbd, 0xe6, 0xb8, 0x7d, 0xdd, 0x38,
-	0x2e, 0x9a, 0x83, 0x13, 0xc6, 0xd3, 0x53, 0x3c, 0x9a, 0x9b, 0xbb, 0x25, 0xab, 0x54, 0x2c, 0x2b,
-	0x19, 0x38, 0xee, 0xa8, 0xf1, 0x80, 0x2f, 0xbd, 0x5f, 0x2b, 0xc1, 0x99, 0xae, 0xda, 0xef, 0x3d,
-	0x92, 0x28, 0xe6, 0xf4, 0xf7, 0xdd, 0x9b, 0xe9, 0x37, 0x27, 0xa5, 0x72, 0xd0, 0xa4, 0xd8, 0x7f,
-	0x5c, 0xea, 0xba, 0x11, 0xe8, 0x49, 0xe8, 0x87, 0x76, 0x94, 0x5e, 0x80, 0x61, 0xa7, 0xd5, 0xe2,
-	0x78, 0xcc, 0x8b, 0x36, 0x93, 0xfa, 0x69, 0xda, 0x04, 0xe2, 0x34, 0x6e, 0x4f, 0x3a, 0xcd, 0x9f,
-	0x58, 0x50, 0xc3, 0x64, 0x83, 0x73, 0x23, 0x74, 0x53, 0x0c, 0x91, 0x55, 0x44, 0x9e, 0x5b, 0x3a,
-	0xb0, 0xb1, 0xc7, 0xf2, 0xbf, 0xe6, 0x0d, 0x76, 0xe7, 0x53, 0x64, 0xa5, 0x43, 0x3d, 0x45, 0xa6,
-	0x1e, 0xa3, 0x2a, 0x77, 0x7f, 0x8c, 0xca, 0xfe, 0xee, 0x00, 0xfd, 0xbc, 0x56, 0x38, 0x1b, 0x91,
-	0x7a, 0x4c, 0xe7, 0xb7, 0x1d, 0xf9, 0x62, 0x91, 0xa8, 0xf9, 0xbd, 0x86, 0x17, 0x31, 0x2d, 0x4f,
-	0x5d, 0x90, 0x95, 0x0e, 0x95, 0xf8, 0xa6, 0x7c, 0x60, 0xe2, 0x9b, 0x17, 0x60, 0x38, 0x8e, 0x37,
-	0x57, 0x22, 0x6f, 0xdb, 0x49, 0xc8, 0x15, 0xb2, 0x2b, 0x74, 0x5f, 0x9d, 0x04, 0x62, 0xf5, 0x92,
-	0x06, 0xe2, 0x34, 0x2e, 0x9a, 0x87, 0x31, 0x9d, 0x7e, 0x86, 0x44, 0x09, 0x8b, 0xb9, 0xe0, 0x2b,
-	0x41, 0x45, 0x7c, 0xeb, 0x84, 0x35, 0x02, 0x01, 0x77, 0xd6, 0xa1, 0xfc, 0x34, 0x55, 0x48, 0x3b,
-	0xd2, 0x9f, 0xe6, 0xa7, 0xa9, 0x76, 0x68, 0x5f, 0x3a, 0x6a, 0xa0, 0x25, 0x38, 0xc9, 0x17, 0xc6,
-	0x74, 0xab, 0x65, 0x7c, 0xd1, 0x40, 0x3a, 0xbf, 0xe8, 0x7c, 0x27, 0x0a, 0xce, 0xab, 0x87, 0x9e,
-	0x83, 0x41, 0x55, 0xbc, 0x30, 0x27, 0xee, 0x76, 0x94, 0x6d, 0x49, 0x35, 0xb3, 0x50, 0xc7, 0x26,
-	0x1e, 0x7a, 0x3f, 0x3c, 0xac, 0xff, 0xf2, 0xc0, 0x3c, 0x7e, 0xe1, 0x39, 0x27, 0x32, 0x7b, 0xa9,
-	0xa7, 0x8f, 0xe6, 0x73, 0xd1, 0xea, 0xb8, 0x5b, 0x7d, 0xb4, 0x0e, 0x67, 0x15, 0xe8, 0x42, 0x90,
-	0xb0, 0x28, 0x9b, 0x98, 0xcc, 0x38, 0x31, 0xb9, 0x16, 0xf9, 0xe2, 0x09, 0x6d, 0xf5, 0x3a, 0xee,
-	0xbc, 0x97, 0x5c, 0xca, 0xc3, 0xc4, 0x8b, 0x78, 0x9f, 0x56, 0xd0, 0x14, 0xd4, 0x48, 0xe0, 0xac,
-	0xfb, 0x64, 0x79, 0x76, 0x81, 0x65, 0x08, 0x33, 0xee, 0x57, 0x2f, 0x48, 0x00, 0xd6, 0x38, 0xca,
-	0xef, 0x77, 0xa8, 0xeb, 0x4b, 0xcd, 0x2b, 0x70, 0xaa, 0xe1, 0xb6, 0xa8, 0x46, 0xe8, 0xb9, 0x64,
-	0xda, 0x65, 0x6e, 0x8e, 0x74, 0x62, 0x78, 0xe2, 0x57, 0xe5, 0xd4, 0x3e, 0x3f, 0xbb, 0xd2, 0x81,
-	0x83, 0x73, 0x6b, 0x32, 0x77, 0xd8, 0x28, 0xdc, 0xd9, 0x1d, 0x3f, 0x99, 0x71, 0x87, 0xa5, 0x85,
-	0x98, 0xc3, 0xd0, 0x65, 0x40, 0x2c, 0x42, 0xe2, 0x52, 0x92, 0xb4, 0x94, 0x0a, 0x3a, 0x7e, 0x2a,
-	0x9d, 0xe7, 0xe7, 0x62, 0x07, 0x06, 0xce, 0xa9, 0x45, 0x35, 0x9a, 0x20, 0x64, 0xad, 0x8f, 0x3f,
-	0x9c, 0xd6, 0x68, 0xae, 0xf2, 0x62, 0x2c, 0xe1, 0xf6, 0x7f, 0xb6, 0x60, 0x58, 0x6d, 0xed, 0x7b,
-	0x10, 0x4e, 0xe4, 0xa7, 0xc3, 0x89, 0xe6, 0x8f, 0xce, 0x1c, 0x59, 0xcf, 0xbb, 0xf8, 0xa4, 0x7f,
-	0x63, 0x10, 0x40, 0x33, 0x50, 0x25, 0xbb, 0xac, 0xae, 0xb2, 0xeb, 0x81, 0x65, 0x5e, 0x79, 0x19,
-	0x79, 0x2a, 0xf7, 0x37, 0x23, 0xcf, 0x2a, 0x9c, 0x96, 0x9a, 0x05, 0xbf, 0xec, 0xbb, 0x14, 0xc6,
-	0x8a, 0x17, 0x56, 0x67, 0x1e, 0x15, 0x0d, 0x9d, 0x5e, 0xc8, 0x43, 0xc2, 0xf9, 0x75, 0x53, 0x0a,
-	0xcd, 0xc0, 0x81, 0x5a, 0xa6, 0xda, 0xfe, 0x8b, 0x1b, 0xf2, 0x09, 0xa1, 0xcc, 0xf6, 0x5f, 0xbc,
-	0xb8, 0x8a, 0x35, 0x4e, 0xbe, 0x0c, 0xa8, 0x15, 0x24, 0x03, 0xe0, 0xd0, 0x32, 0x40, 0x72, 0xa3,
-	0xc1, 0xae, 0xdc, 0x48, 0x5e, 0x2a, 0x0c, 0x75, 0xbd, 0x54, 0x78, 0x0f, 0x8c, 0x78, 0xc1, 0x26,
-	0x89, 0xbc, 0x84, 0xd4, 0xd9, 0x5e, 0x60, 0x9c, 0xaa, 0xaa, 0x35, 0x80, 0x85, 0x14, 0x14, 0x67,
-	0xb0, 0xd3, 0x2c, 0x74, 0xa4, 0x07, 0x16, 0xda, 0x45, 0x70, 0x8d, 0x16, 0x23, 0xb8, 0x4e, 0x1c,
-	0x5d, 0x70, 0x8d, 0x1d, 0xab, 0xe0, 0x42, 0x85, 0x08, 0xae, 0x9e, 0x64, 0x82, 0x71, 0x32, 0x3d,
-	0x75, 0xc0, 0xc9, 0xb4, 0x9b, 0xd4, 0x3a, 0x7d, 0xd7, 0x52, 0x2b, 0x5f, 0x20, 0x3d, 0x74, 0xdc,
-	0x02, 0xe9, 0xd3, 0x25, 0x38, 0xad, 0x59, 0x36, 0xdd, 0x28, 0xde, 0x06, 0x65, 0x5a, 0xec, 0xc1,
-	0x3a, 0x7e, 0x47, 0x67, 0x04, 0xc2, 0xe9, 0x98, 0x3a, 0x05, 0xc1, 0x06, 0x16, 0x8b, 0x27, 0x23,
-	0x11, 0xcb, 0x7e, 0x9d, 0xe5, 0xe7, 0xb3, 0xa2, 0x1c, 0x2b, 0x0c, 0xba, 0x14, 0xe9, 0x6f, 0x11,
-	0xa3, 0x9b, 0xcd, 0xab, 0x38, 0xab, 0x41, 0xd8, 0xc4, 0x43, 0x4f, 0x72, 0x22, 0x8c, 0x97, 0x50,
-	0x9e, 0x3e, 0x24, 0x5e, 0x05, 0x97, 0xec, 0x43, 0x41, 0x65, 0x77, 0x58, 0xe0, 0x60, 0xa5, 0xb3,
-	0x3b, 0xcc, 0xdd, 0x4d, 0x61, 0xd8, 0xff, 0xd3, 0x82, 0x33, 0xb9, 0x43, 0x71, 0x0f, 0xe4, 0xf4,
-	0x4e, 0x5a, 0x4e, 0xaf, 0x16, 0x75, 0x88, 0x31, 0xbe, 0xa2, 0x8b, 0xcc, 0xfe, 0x8f, 0x16, 0x8c,
-	0x68, 0xfc, 0x7b, 0xf0, 0xa9, 0x5e, 0xfa, 0x53, 0x8b, 0x3b, 0xaf, 0xd5, 0x3a, 0xbe, 0xed, 0xf7,
-	0x4a, 0xa0, 0x72, 0x9d, 0x4e, 0xbb, 0x32, 0x93, 0xf4, 0x01, 0xb7, 0xc6, 0xbb, 0xd0, 0xcf, 0x2e,
-	0xbd, 0xe3, 0x62, 0x1c, 0x7a, 0xd2, 0xf4, 0xd9, 0x05, 0xba, 0x76, 0x28, 0x60, 0x7f, 0x63, 0x2c,
-	0x08, 0xb2, 0xdc, 0xec, 0x3c, 0x8d, 0x64, 0x5d, 0x84, 0xe0, 0xe9, 0xdc, 0xec, 0xa2, 0x1c, 0x2b,
-	0x0c, 0x2a, 0x49, 0x3c, 0x37, 0x0c, 0x66, 0x7d, 0x27, 0x96, 0x2f, 0xce, 0x2a, 0x49, 0xb2, 0x20,
-	0x01, 0x58, 0xe3, 0xb0, 0xfb, 0x70, 0x2f, 0x6e, 0xf9, 0xce, 0xae, 0x71, 0x2a, 0x37, 0x72, 0x51,
-	0x28, 0x10, 0x36, 0xf1, 0xec, 0x26, 0x8c, 0xa7, 0x3f, 0x62, 0x8e, 0x6c, 0x30, 0x67, 0xd4, 0x9e,
-	0x86, 0x73, 0x0a, 0x6a, 0x0e, 0xab, 0xb5, 0xd8, 0x76, 0x04, 0x4f, 0xd0, 0x2e, 0x99, 0x12, 0x80,
-	0x35, 0x8e, 0xfd, 0x8f, 0x2c, 0x38, 0x99, 0x33, 0x68, 0x05, 0x86, 0x38, 0x26, 0x9a, 0xdb, 0xe4,
-	0xe9, 0x00, 0x3f, 0x0e, 0x03, 0x75, 0xb2, 0xe1, 0x48, 0x77, 0x47, 0x83, 0x7b, 0xce, 0xf1, 0x62,
-	0x2c, 0xe1, 0xf6, 0x6f, 0x95, 0x60, 0x34, 0xdd, 0xd7, 0x98, 0x85, 0x0d, 0xf1, 0x61, 0xf2, 0x62,
-	0x37, 0xdc, 0x26, 0xd1, 0x2e, 0xfd, 0x72, 0x2b, 0x13, 0x36, 0xd4, 0x81, 0x81, 0x73, 0x6a, 0xb1,
-	0x4c, 0xc7, 0x75, 0x35, 0xda, 0x72, 0x45, 0x5e, 0x2f, 0x72, 0x45, 0xea, 0xc9, 0x34, 0x5d, 0x23,
-	0x14, 0x49, 0x6c, 0xd2, 0xa7, 0xba, 0x08, 0xf3, 0xc3, 0x9e, 0x69, 0x7b, 0x7e, 0xe2, 0x05, 0xe2,
-	0x93, 0xc5, 0x5a, 0x55, 0xba, 0xc8, 0x52, 0x27, 0x0a, 0xce, 0xab, 0x67, 0x7f, 0xaf, 0x0f, 0x54,
-	0x48, 0x35, 0x73, 0x5d, 0x2b, 0xc8, 0xf1, 0xef, 0xb0, 0xc1, 0x67, 0x6a, 0x6d, 0xf5, 0xed, 0xe7,
-	0x4b, 0xc2, 0x4d, 0x39, 0xa6, 0x3d, 0x57, 0x0d, 0xd8, 0x9a, 0x06, 0x61, 0x13, 0x8f, 0xf6, 0xc4,
-	0xf7, 0xb6, 0x09, 0xaf, 0xd4, 0x9f, 0xee, 0xc9, 0xa2, 0x04, 0x60, 0x8d, 0x43, 0x7b, 0x52, 0xf7,
-	0x36, 0x36, 0x84, 0x5d, 0x42, 0xf5, 0x84, 0x8e, 0x0e, 0x66, 0x10, 0x9e, 0x0b, 0x3f, 0xdc, 0x12,
-	0xfa, 0xb7, 0x91, 0x0b, 0x3f, 0xdc, 0xc2, 0x0c, 0x42, 0x67, 0x29, 0x08, 0xa3, 0xa6, 0xe3, 0x7b,
-	0xaf, 0x91, 0xba, 0xa2, 0x22, 0xf4, 0x6e, 0x35, 0x4b, 0x57, 0x3b, 0x51, 0x70, 0x5e, 0x3d, 0xba,
-	0xa0, 0x5b, 0x11, 0xa9, 0x7b, 0x6e, 0x62, 0xb6, 0x06, 0xe9, 0x05, 0xbd, 0xd2, 0x81, 0x81, 0x73,
-	0x6a, 0xa1, 0x69, 0x18, 0x95, 0x21, 0xf1, 0x32, 0xe1, 0xd1, 0x60, 0x3a, 0xc1, 0x0a, 0x4e, 0x83,
-	0x71, 0x16, 0x9f, 0x32, 0xc9, 0xa6, 0xc8, 0x89, 0xc6, 0xd4, 0x74, 0x83, 0x49, 0xca, 0x5c, 0x69,
-	0x58, 0x61, 0xd8, 0x1f, 0x2f, 0x53, 0xa1, 0xde, 0x25, 0xf5, 0xe0, 0x3d, 0x73, 0x34, 0x4d, 0xaf,
-	0xc8, 0xbe, 0x1e, 0x56, 0xe4, 0xb3, 0x30, 0x74, 0x33, 0x0e, 0x03, 0xe5, 0xc4, 0x59, 0xe9, 0xea,
-	0xc4, 0x69, 0x60, 0xe5, 0x3b, 0x71, 0xf6, 0x17, 0xe5, 0xc4, 0x39, 0x70, 0x97, 0x4e, 0x9c, 0x7f,
-	0x50, 0x01, 0xf5, 0xae, 0xd0, 0x55, 0x92, 0xdc, 0x0a, 0xa3, 0x2d, 0x2f, 0x68, 0xb0, 0x54, 0x02,
-	0x5f, 0xb5, 0x60, 0x88, 0xef, 0x97, 0x45, 0x33, 0x08, 0x6f, 0xa3, 0xa0, 0x07, 0x6b, 0x52, 0xc4,
-	0x26, 0xd7, 0x0c, 0x42, 0x99, 0x37, 0x87, 0x4d, 0x10, 0x4e, 0xf5, 0x08, 0x7d, 0x04, 0x40, 0x1a,
-	0x71, 0x37, 0x24, 0x07, 0x5e, 0x28, 0xa6, 0x7f, 0x98, 0x6c, 0x68, 0x95, 0x7a, 0x4d, 0x11, 0xc1,
-	0x06, 0x41, 0xf4, 0x69, 0x1d, 0xa0, 0xc8, 0xa3, 0x3d, 0x3e, 0x74, 0x2c, 0x63, 0xd3, 0x4b, 0x78,
-	0x22, 0x86, 0x01, 0x2f, 0x68, 0xd0, 0x75, 0x22, 0x9c, 0xdd, 0xde, 0x96, 0x97, 0x86, 0x63, 0x31,
-	0x74, 0xea, 0x33, 0x8e, 0xef, 0x04, 0x2e, 0x89, 0x16, 0x38, 0xba, 0x96, 0xa0, 0xa2, 0x00, 0xcb,
-	0x86, 0x3a, 0x5e, 0x64, 0xaa, 0xf4, 0xf2, 0x22, 0xd3, 0xd9, 0xf7, 0xc2, 0x58, 0xc7, 0x64, 0x1e,
-	0x2a, 0x1a, 0xf1, 0xee, 0x03, 0x19, 0xed, 0xdf, 0xee, 0xd7, 0x42, 0xeb, 0x6a, 0x58, 0xe7, 0x0f,
-	0xfc, 0x44, 0x7a, 0x46, 0x85, 0xca, 0x5c, 0xe0, 0x12, 0x51, 0x62, 0xc6, 0x28, 0xc4, 0x26, 0x49,
-	0xba, 0x46, 0x5b, 0x4e, 0x44, 0x82, 0xe3, 0x5e, 0xa3, 0x2b, 0x8a, 0x08, 0x36, 0x08, 0xa2, 0xcd,
-	0x54, 0x38, 0xd2, 0xc5, 0xa3, 0x87, 0x23, 0xb1, 0x04, 0x65, 0x79, 0xef, 0x60, 0x7c, 0xc1, 0x82,
-	0x91, 0x20, 0xb5, 0x72, 0x8b, 0xf1, 0x40, 0xce, 0xdf, 0x15, 0xfc, 0x59, 0xba, 0x74, 0x19, 0xce,
-	0xd0, 0xcf, 0x13, 0x69, 0x95, 0x43, 0x8a, 0x34, 0xfd, 0xc0, 0x58, 0x7f, 0xb7, 0x07, 0xc6, 0x50,
-	0xa0, 0x5e, 0x58, 0x1c, 0x28, 0xfc, 0x85, 0x45, 0xc8, 0x79, 0x5d, 0xf1, 0x06, 0xd4, 0xdc, 0x88,
-	0x38, 0xc9, 0x5d, 0x3e, 0xb6, 0xc7, 0x7c, 0x3b, 0x66, 0x65, 0x03, 0x58, 0xb7, 0x65, 0xff, 0x9f,
-	0x3e, 0x38, 0x21, 0x47, 0x44, 0x46, 0x2f, 0x50, 0xf9, 0xc8, 0xe9, 0x6a, 0x5d, 0x59, 0xc9, 0xc7,
-	0x4b, 0x12, 0x80, 0x35, 0x0e, 0xd5, 0xc7, 0xda, 0x31, 0x59, 0x6e, 0x91, 0x60, 0xd1, 0x5b, 0x8f,
-	0xc5, 0x65, 0xac, 0xda, 0x28, 0xd7, 0x34, 0x08, 0x9b, 0x78, 0x54, 0xb7, 0x77, 0x0c, 0xa5, 0xd5,
-	0xd0, 0xed, 0xa5, 0xa2, 0x2a, 0xe1, 0xe8, 0x97, 0x73, 0x73, 0x21, 0x17, 0x13, 0xf3, 0xd7, 0x11,
-	0xb4, 0x71, 0xc8, 0xf7, 0x59, 0xff, 0xbe, 0x05, 0xa7, 0x79, 0xa9, 0x1c, 0xc9, 0x6b, 0xad, 0xba,
-	0x93, 0x90, 0xb8, 0x98, 0x37, 0x14, 0x72, 0xfa, 0xa7, 0xcd, 0xcb, 0x79, 0x64, 0x71, 0x7e, 0x6f,
-	0xd0, 0xeb, 0x16, 0x8c, 0x6e, 0xa5, 0xd2, 0xc5, 0x48, 0xd1, 0x71, 0xd4, 0x4c, 0x0e, 0xa9, 0x46,
-	0xf5, 0x56, 0x4b, 0x97, 0xc7, 0x38, 0x4b, 0xdd, 0xfe, 0x1f, 0x16, 0x98, 0x6c, 0xf4, 0xde, 0x67,
-	0x99, 0x39, 0xbc, 0x2a, 0x28, 0xb5, 0xcb, 0x4a, 0x57, 0xed, 0xf2, 0x51, 0x28, 0xb7, 0xbd, 0xba,
-	0x38, 0x5f, 0xe8, 0x2b, 0xe2, 0x85, 0x39, 0x4c, 0xcb, 0xed, 0x7f, 0x59, 0xd1, 0x66, 0x10, 0x11,
-	0x52, 0xf7, 0x43, 0xf1, 0xd9, 0x1b, 0x2a, 0x4f, 0x1d, 0xff, 0xf2, 0xab, 0x1d, 0x79, 0xea, 0x7e,
-	0xea, 0xf0, 0x11, 0x93, 0x7c, 0x80, 0xba, 0xa5, 0xa9, 0x1b, 0x38, 0x20, 0x5c, 0xf2, 0x26, 0x54,
-	0xe9, 0x11, 0x8c, 0xd9, 0x33, 0xab, 0xa9, 0x4e, 0x55, 0x2f, 0x89, 0xf2, 0x3b, 0x7b, 0x13, 0xef,
-	0x3a, 0x7c, 0xb7, 0x64, 0x6d, 0xac, 0xda, 0x47, 0x31, 0xd4, 0xe8, 0x6f, 0x16, 0xd9, 0x29, 0x0e,
-	0x77, 0xd7, 0x14, 0xcf, 0x94, 0x80, 0x42, 0xc2, 0x46, 0x35, 0x1d, 0x14, 0x40, 0x8d, 0x3d, 0x65,
-	0xcd, 0x88, 0xf2, 0x33, 0xe0, 0x8a, 0x8a, 0xaf, 0x94, 0x80, 0x3b, 0x7b, 0x13, 0x2f, 0x1c, 0x9e,
-	0xa8, 0xaa, 0x8e, 0x35, 0x09, 0xfb, 0x8b, 0x7d, 0x7a, 0xed, 0x8a, 0xf4, 0x84, 0x3f, 0x14, 0x6b,
-	0xf7, 0xf9, 0xcc, 0xda, 0x3d, 0xd7, 0xb1, 0x76, 0x47, 0xf4, 0x93, 0xcb, 0xa9, 0xd5, 0x78, 0xaf,
-	0x15, 0x81, 0x83, 0xed, 0x0d, 0x4c, 0x03, 0x7a, 0xb5, 0xed, 0x45, 0x24, 0x5e, 0x89, 0xda, 0x81,
-	0x17, 0x34, 0xd8, 0x72, 0xac, 0x9a, 0x1a, 0x50, 0x0a, 0x8c, 0xb3, 0xf8, 0xf4, 0x50, 0x4f, 0xe7,
-	0xfc, 0x86, 0xb3, 0xcd, 0x57, 0x95, 0x91, 0xb1, 0x6d, 0x55, 0x94, 0x63, 0x85, 0x61, 0x7f, 0x9d,
-	0xdd, 0xa2, 0x1b, 0x21, 0xe5, 0x74, 0x4d, 0xf8, 0xec, 0xed, 0x70, 0x9e, 0xee, 0x4d, 0xad, 0x09,
-	0xfe, 0x60, 0x38, 0x87, 0xa1, 0x5b, 0x30, 0xb0, 0xce, 0x5f, 0xc1, 0x2c, 0x26, 0xe3, 0xbe, 0x78,
-	0x52, 0x93, 0xbd, 0x2f, 0x24, 0xdf, 0xd7, 0xbc, 0xa3, 0x7f, 0x62, 0x49, 0xcd, 0xfe, 0x56, 0x05,
-	0x46, 0x33, 0xaf, 0x4b, 0xa7, 0x12, 0xed, 0x96, 0x0e, 0x4c, 0xb4, 0xfb, 0x41, 0x80, 0x3a, 0x69,
-	0xf9, 0xe1, 0x2e, 0x53, 0xc7, 0xfa, 0x0e, 0xad, 0x8e, 0x29, 0x0d, 0x7e, 0x4e, 0xb5, 0x82, 0x8d,
-	0x16, 0x45, 0x8e, 0x3b, 0x9e, 0xb7, 0x37, 0x93, 0xe3, 0xce, 0x78, 0x97, 0xa3, 0xff, 0xde, 0xbe,
-	0xcb, 0xe1, 0xc1, 0x28, 0xef, 0xa2, 0x0a, 0xdc, 0xbe, 0x8b, 0xf8, 0x6c, 0x16, 0xfa, 0x32, 0x97,
-	0x6e, 0x06, 0x67, 0xdb, 0xbd, 0x9f, 0x8f, 0xc7, 0xa3, 0xb7, 0x43, 0x4d, 0xce, 0x73, 0x3c, 0x5e,
-	0xd3, 0xc9, 0x2f, 0xe4, 0x32, 0x60, 0x8f, 0xba, 0x8b, 0x9f, 0x1d, 0x39, 0x28, 0xe0, 0x7e, 0xe5,
-	0xa0, 0xb0, 0x3f, 0x5f, 0xa2, 0x7a, 0x3c, 0xef, 0x97, 0x4a, 0xa7, 0xf4, 0x04, 0xf4, 0x3b, 0xed,
-	0x64, 0x33, 0xec, 0x78, 0x47, 0x73, 0x9a, 0x95, 0x62, 0x01, 0x45, 0x8b, 0xd0, 0x57, 0xd7, 0x29,
-	0x72, 0x0e, 0x33, 0x9f, 0xda, 0x24, 0xea, 0x24, 0x04, 0xb3, 0x56, 0xd0, 0x23, 0xd0, 0x97, 0x38,
-	0x0d, 0x19, 0xad, 0xc7, 0x22, 0xb4, 0xd7, 0x9c, 0x46, 0x8c, 0x59, 0xa9, 0x29, 0xbe, 0xfb, 0x0e,
-	0x10, 0xdf, 0x2f, 0xc0, 0x70, 0xec, 0x35, 0x02, 0x27, 0x69, 0x47, 0xc4, 0xb8, 0x35, 0xd4, 0x3e,
-	0x23, 0x26, 0x10, 0xa7, 0x71, 0xed, 0xdf, 0x19, 0x82, 0x53, 0xab, 0xb3, 0x4b, 0x32, 0xf1, 0xfb,
-	0xb1, 0x05, 0xdc, 0xe5, 0xd1, 0xb8, 0x77, 0x01, 0x77, 0x5d, 0xa8, 0xfb, 0x46, 0xc0, 0x9d, 0x6f,
-	0x04, 0xdc, 0xa5, 0xa3, 0x9f, 0xca, 0x45, 0x44, 0x3f, 0xe5, 0xf5, 0xa0, 0x97, 0xe8, 0xa7, 0x63,
-	0x8b, 0xc0, 0xdb, 0xb7, 0x43, 0x87, 0x8a, 0xc0, 0x53, 0xe1, 0x89, 0x85, 0xc4, 0xa5, 0x74, 0x99,
-	0xaa, 0xdc, 0xf0, 0x44, 0x15, 0x1a, 0xc6, 0x63, 0xae, 0x04, 0xab, 0x7f, 0xb9, 0xf8, 0x0e, 0xf4,
-	0x10, 0x1a, 0x26, 0xc2, 0xbe, 0xcc, 0x70, 0xc4, 0x81, 0x22, 0xc2, 0x11, 0xf3, 0xba, 0x73, 0x60,
-	0x38, 0xe2, 0x0b, 0x30, 0xec, 0xfa, 0x61, 0x40, 0x56, 0xa2, 0x30, 0x09, 0xdd, 0x50, 0xbe, 0xe4,
-	0xa7, 0x1f, 0xa2, 0x31, 0x81, 0x38, 0x8d, 0xdb, 0x2d, 0x96, 0xb1, 0x76, 0xd4, 0x58, 0x46, 0xb8,
-	0x4f, 0xb1, 0x8c, 0xbf, 0xa0, 0xa3, 0xee, 0x07, 0xd9, 0x8c, 0x7c, 0xb0, 0xf8, 0x19, 0xe9, 0xe9,
-	0xa9, 0xbe, 0x37, 0xf8, 0x43, 0x96, 0x54, 0x31, 0x9e, 0x0d, 0x9b, 0x54, 0xf1, 0x1b, 0x62, 0x43,
-	0xf2, 0xca, 0x31, 0x2c, 0xd8, 0x1b, 0xab, 0x9a, 0x8c, 0x7a, 0xdc, 0x52, 0x17, 0xe1, 0x74, 0x47,
-	0x8e, 0x92, 0x15, 0xe0, 0xcb, 0x25, 0xf8, 0x91, 0x03, 0xbb, 0x80, 0x6e, 0x01, 0x24, 0x4e, 0x43,
-	0x2c, 0x54, 0x71, 0x61, 0x72, 0x44, 0xc7, 0xce, 0x35, 0xd9, 0x1e, 0x4f, 0x67, 0xa3, 0xfe, 0xb2,
-	0xab, 0x08, 0xf9, 0x9b, 0xf9, 0x73, 0x86, 0x7e, 0x47, 0xd6, 0x4f, 0x1c, 0xfa, 0x04, 0x33, 0x08,
-	0x15, 0xff, 0x11, 0x69, 0xe8, 0x57, 0xe0, 0xd5, 0xf4, 0x61, 0x56, 0x8a, 0x05, 0x14, 0x3d, 0x07,
-	0x83, 0x8e, 0xef, 0xf3, 0xa0, 0x21, 0x12, 0x8b, 0x17, 0xa2, 0x74, 0xfa, 0x41, 0x0d, 0xc2, 0x26,
-	0x9e, 0xfd, 0x97, 0x25, 0x98, 0x38, 0x80, 0xa7, 0x74, 0x04, 0x8b, 0x56, 0x7a, 0x0e, 0x16, 0x15,
-	0x81, 0x14, 0xfd, 0x5d, 0x02, 0x29, 0x9e, 0x83, 0xc1, 0x84, 0x38, 0x4d, 0xe1, 0x0a, 0x26, 0x2c,
-	0x01, 0xfa, 0x06, 0x58, 0x83, 0xb0, 0x89, 0x47, 0xb9, 0xd8, 0x88, 0xe3, 0xba, 0x24, 0x8e, 0x65,
-	0xa4, 0x84, 0xb0, 0xa6, 0x16, 0x16, 0x86, 0xc1, 0x8c, 0xd4, 0xd3, 0x29, 0x12, 0x38, 0x43, 0x32,
-	0x3b, 0xe0, 0xb5, 0x1e, 0x07, 0xfc, 0x6b, 0x25, 0x78, 0x74, 0x5f, 0xe9, 0xd6, 0x73, 0x10, 0x4b,
-	0x3b, 0x26, 0x51, 0x76, 0xe1, 0x5c, 0x8b, 0x49, 0x84, 0x19, 0x84, 0x8f, 0x52, 0xab, 0x65, 0xbc,
-	0xb2, 0x5f, 0x74, 0x44, 0x17, 0x1f, 0xa5, 0x14, 0x09, 0x9c, 0x21, 0x79, 0xb7, 0xcb, 0xf2, 0x5b,
-	0x7d, 0xf0, 0x78, 0x0f, 0x3a, 0x40, 0x81, 0x91, 0x6f, 0xe9, 0x28, 0xcd, 0xf2, 0x7d, 0x8a, 0xd2,
-	0xbc, 0xbb, 0xe1, 0x7a, 0x33, 0xb8, 0xb3, 0xa7, 0x08, 0xbb, 0xaf, 0x97, 0xe0, 0x6c, 0x77, 0x85,
-	0x05, 0xbd, 0x1b, 0x46, 0x23, 0xe5, 0xfa, 0x66, 0x06, 0x78, 0x9e, 0xe4, 0xf6, 0x96, 0x14, 0x08,
-	0x67, 0x71, 0xd1, 0x24, 0x40, 0xcb, 0x49, 0x36, 0xe3, 0x0b, 0x3b, 0x5e, 0x9c, 0x88, 0x34, 0x4f,
-	0x23, 0xfc, 0x86, 0x4f, 0x96, 0x62, 0x03, 0x83, 0x92, 0x63, 0xff, 0xe6, 0xc2, 0xab, 0x61, 0xc2,
-	0x2b, 0xf1, 0xc3, 0xd6, 0x49, 0xf9, 0x28, 0x8e, 0x01, 0xc2, 0x59, 0x5c, 0x4a, 0x8e, 0xdd, 0x21,
-	0xf3, 0x8e, 0xf2, 0x53, 0x18, 0x23, 0xb7, 0xa8, 0x4a, 0xb1, 0x81, 0x91, 0x0d, 0x5d, 0xad, 0x1c,
-	0x1c, 0xba, 0x6a, 0xff, 0x8b, 0x12, 0x9c, 0xe9, 0xaa, 0xf0, 0xf6, 0xc6, 0xa6, 0x1e, 0xbc, 0x70,
-	0xd3, 0xbb, 0xdc, 0x61, 0x87, 0x0b, 0x53, 0xfc, 0x93, 0x2e, 0x2b, 0x4d, 0x84, 0x29, 0xde, 0x7d,
-	0xf6, 0x85, 0x07, 0x6f, 0x3c, 0x3b, 0x22, 0x13, 0xfb, 0x0e, 0x11, 0x99, 0x98, 0x99, 0x8c, 0x4a,
-	0x8f, 0xd2, 0xe1, 0xcf, 0xfa, 0xba, 0x0e, 0x2f, 0x3d, 0x20, 0xf7, 0x64, 0xcd, 0x9e, 0x83, 0x13,
-	0x5e, 0xc0, 0x1e, 0x48, 0x5b, 0x6d, 0xaf, 0x8b, 0xcc, 0x3f, 0x3c, 0xbd, 0xa5, 0x0a, 0x7f, 0x58,
-	0xc8, 0xc0, 0x71, 0x47, 0x8d, 0x07, 0x30, 0x52, 0xf4, 0xee, 0x86, 0xf4, 0x90, 0x9c, 0x7b, 0x19,
-	0x4e, 0xcb, 0xa1, 0xd8, 0x74, 0x22, 0x52, 0x17, 0xc2, 0x36, 0x16, 0x01, 0x2f, 0x67, 0x78, 0xd0,
-	0x4c, 0x0e, 0x02, 0xce, 0xaf, 0xc7, 0xde, 0xa4, 0x0a, 0x5b, 0x9e, 0x2b, 0x8e, 0x82, 0xfa, 0x4d,
-	0x2a, 0x5a, 0x88, 0x39, 0x4c, 0xcb, 0x8b, 0xda, 0xbd, 0x91, 0x17, 0x1f, 0x84, 0x9a, 0x1a, 0x6f,
-	0xee, 0xbb, 0xaf, 0x16, 0x79, 0x87, 0xef, 0xbe, 0x5a, 0xe1, 0x06, 0xd6, 0x41, 0x8f, 0xa6, 0xbe,
-	0x03, 0x86, 0x94, 0xf5, 0xab, 0xd7, 0x97, 0xc1, 0xec, 0x3f, 0xef, 0x87, 0xe1, 0x54, 0xb6, 0xcf,
-	0x94, 0xd9, 0xdb, 0x3a, 0xd0, 0xec, 0xcd, 0xc2, 0x36, 0xda, 0x81, 0x7c, 0x36, 0xd0, 0x08, 0xdb,
-	0x68, 0x07, 0x04, 0x73, 0x18, 0x3d, 0x74, 0xd4, 0xa3, 0x5d, 0xdc, 0x0e, 0x84, 0x1f, 0xaa, 0x3a,
-	0x74, 0xcc, 0xb1, 0x52, 0x2c, 0xa0, 0xe8, 0x63, 0x16, 0x0c, 0xc5, 0xec, 0x4e, 0x85, 0x5f, 0x1a,
-	0x88, 0x45, 0x7e, 0xf9, 0xe8, 0xc9, 0x4c, 0x55, 0x66, 0x5b, 0xe6, 0xb7, 0x64, 0x96, 0xe0, 0x14,
-	0x45, 0xf4, 0x49, 0x0b, 0x6a, 0xea, 0x75, 0x23, 0xf1, 0x06, 0xe8, 0x6a, 0xb1, 0xc9, 0x54, 0xb9,
-	0xb5, 0x59, 0x5d, 0x4f, 0xa9, 0xac, 0x96, 0x58, 0x13, 0x46, 0xb1, 0xb2, 0xe8, 0x0f, 0x1c, 0x8f,
-	0x45, 0x1f, 0x72, 0xac, 0xf9, 0x6f, 0x87, 0x5a, 0xd3, 0x09, 0xbc, 0x0d, 0x12, 0x27, 0xdc, 0xc8,
-	0x2e, 0x73, 0x3c, 0xcb, 0x42, 0xac, 0xe1, 0x54, 0x01, 0x88, 0xd9, 0x87, 0x25, 0x86, 0x55, 0x9c,
-	0x29, 0x00, 0xab, 0xba, 0x18, 0x9b, 0x38, 0xa6, 0x09, 0x1f, 0xee, 0xab, 0x09, 0x7f, 0xf0, 0x00,
-	0x13, 0xfe, 0x2a, 0x9c, 0x76, 0xda, 0x49, 0x78, 0x89, 0x38, 0xfe, 0x34, 0x7f, 0xd0, 0x57, 0x3c,
-	0x50, 0x3f, 0xc4, 0xcc, 0x42, 0xca, 0xd3, 0x62, 0x95, 0xf8, 0x1b, 0x1d, 0x48, 0x38, 0xbf, 0xae,
-	0xfd, 0x4f, 0x2d, 0x38, 0x9d, 0xbb, 0x14, 0x1e, 0x5c, 0x1f, 0x57, 0xfb, 0x4b, 0x15, 0x38, 0x99,
-	0x93, 0x0b, 0x18, 0xed, 0x9a, 0x9b, 0xc4, 0x2a, 0xc2, 0x5d, 0x24, 0xed, 0xfd, 0x20, 0xe7, 0x26,
-	0x67, 0x67, 0x1c, 0xee, 0x56, 0x4e, 0xdf, 0x8c, 0x95, 0xef, 0xed, 0xcd, 0x98, 0xb1, 0xd6, 0xfb,
-	0xee, 0xeb, 0x5a, 0xaf, 0x1c, 0xb0, 0xd6, 0xbf, 0x61, 0xc1, 0x78, 0xb3, 0xcb, 0x03, 0x14, 0xc2,
-	0xc6, 0x7c, 0xfd, 0x78, 0x9e, 0xb7, 0x98, 0x79, 0xe4, 0xf6, 0xde, 0x44, 0xd7, 0x77, 0x3f, 0x70,
-	0xd7, 0x5e, 0xd9, 0xdf, 0x2b, 0x03, 0x4b, 0x44, 0xcd, 0xf2, 0x3d, 0xee, 0xa2, 0x8f, 0x9a, 0x29,
-	0xc5, 0xad, 0xa2, 0xd2, 0x5f, 0xf3, 0xc6, 0x55, 0x4a, 0x72, 0x3e, 0x82, 0x79, 0x19, 0xca, 0xb3,
-	0x9c, 0xb0, 0xd4, 0x03, 0x27, 0xf4, 0x65, 0xee, 0xf6, 0x72, 0xf1, 0xb9, 0xdb, 0x6b, 0xd9, 0xbc,
-	0xed, 0xfb, 0x4f, 0x71, 0xdf, 0x03, 0x39, 0xc5, 0xbf, 0x62, 0x71, 0xc6, 0x93, 0x99, 0x05, 0xad,
-	0x6e, 0x58, 0xfb, 0xa8, 0x1b, 0x4f, 0x41, 0x35, 0x16, 0x9c, 0x59, 0xa8, 0x25, 0xda, 0x55, 0x41,
-	0x94, 0x63, 0x85, 0xc1, 0x1e, 0x77, 0xf6, 0xfd, 0xf0, 0xd6, 0x85, 0x66, 0x2b, 0xd9, 0x15, 0x0a,
-	0x8a, 0x7e, 0xdc, 0x59, 0x41, 0xb0, 0x81, 0x65, 0xff, 0xbd, 0x12, 0x5f, 0x81, 0xc2, 0xdf, 0xe5,
-	0xf9, 0xcc, 0x73, 0x9c, 0xbd, 0xbb, 0x8a, 0x7c, 0x18, 0xc0, 0x0d, 0x9b, 0x2d, 0xaa, 0xbc, 0xae,
-	0x85, 0xe2, 0xfa, 0xef, 0xd2, 0x91, 0x1f, 0xff, 0x17, 0xed, 0xe9, 0xcf, 0xd0, 0x65, 0xd8, 0xa0,
-	0x97, 0xe2, 0xa5, 0xe5, 0x03, 0x79, 0x69, 0x8a, 0xad, 0xf4, 0xed, 0xcf, 0x56, 0xec, 0xbf, 0xb4,
-	0x20, 0xa5, 0x66, 0xa1, 0x16, 0x54, 0x68, 0x77, 0x77, 0xc5, 0x0e, 0x5d, 0x2e, 0x4e, 0xa7, 0xa3,
-	0xac, 0x51, 0x2c, 0x7b, 0xf6, 0x13, 0x73, 0x42, 0xc8, 0x17, 0x6e, 0x31, 0x7c, 0x54, 0xaf, 0x16,
-	0x47, 0xf0, 0x52, 0x18, 0x6e, 0xf1, 0x3b, 0x6c, 0xed, 0x62, 0x63, 0x3f, 0x0f, 0x63, 0x1d, 0x9d,
-	0x62, 0x2f, 0xef, 0x85, 0x54, 0xfa, 0x64, 0x96, 0x2b, 0x8b, 0x12, 0xc6, 0x1c, 0x66, 0x7f, 0xdd,
-	0x82, 0x13, 0xd9, 0xe6, 0xd1, 0x1b, 0x16, 0x8c, 0xc5, 0xd9, 0xf6, 0x8e, 0x6b, 0xec, 0x94, 0x6b,
-	0x6b, 0x07, 0x08, 0x77, 0x76, 0xc2, 0xfe, 0xbf, 0x62, 0xf1, 0xdf, 0xf0, 0x82, 0x7a, 0x78, 0x4b,
-	0x29, 0x26, 0x56, 0x57, 0xc5, 0x84, 0xee, 0x47, 0x77, 0x93, 0xd4, 0xdb, 0x7e, 0x47, 0xcc, 0xf1,
-	0xaa, 0x28, 0xc7, 0x0a, 0x83, 0x85, 0x58, 0xb6, 0xc5, 0xe3, 0x0e, 0x99, 0x45, 0x39, 0x27, 0xca,
-	0xb1, 0xc2, 0x40, 0xcf, 0xc2, 0x90, 0xf1, 0x91, 0x72, 0x5d, 0x32, 0x2d, 0xdf, 0x10, 0x99, 0x31,
-	0x4e, 0x61, 0xa1, 0x49, 0x00, 0xa5, 0xe4, 0x48, 0x11, 0xc9, 0xac, 0x5d, 0x8a, 0x13, 0xc5, 0xd8,
-	0xc0, 0x60, 0x01, 0xcd, 0x7e, 0x3b, 0x66, 0xd7, 0x39, 0xfd, 0x3a, 0xe1, 0xf0, 0xac, 0x28, 0xc3,
-	0x0a, 0x4a, 0xb9, 0x49, 0xd3, 0x09, 0xda, 0x8e, 0x4f, 0x47, 0x48, 0x9c, 0x5f, 0xd5, 0x36, 0x5c,
-	0x52, 0x10, 0x6c, 0x60, 0xd1, 0x2f, 0x4e, 0xbc, 0x26, 0x79, 0x29, 0x0c, 0xa4, 0x4b, 0xa2, 0xbe,
-	0xe1, 0x13, 0xe5, 0x58, 0x61, 0xd8, 0x7f, 0x61, 0xc1, 0xa8, 0xce, 0xa4, 0xc0, 0xdf, 0xd8, 0x37,
-	0x8f, 0xdb, 0xd6, 0x81, 0xc7, 0xed, 0x74, 0xdc, 0x78, 0xa9, 0xa7, 0xb8, 0x71, 0x33, 0xa4, 0xbb,
-	0xbc, 0x6f, 0x48, 0xf7, 0x8f, 0xe9, 0xf7, 0x9b, 0x79, 0xec, 0xf7, 0x60, 0xde, 0xdb, 0xcd, 0xc8,
-	0x86, 0x7e, 0xd7, 0x51, 0x19, 0x87, 0x86, 0xf8, 0x81, 0x64, 0x76, 0x9a, 0x21, 0x09, 0x88, 0xbd,
-	0x0c, 0x35, 0x75, 0xd1, 0x25, 0x4f, 0xbf, 0x56, 0xfe, 0xe9, 0xb7, 0xa7, 0xd0, 0xd2, 0x99, 0xf5,
-	0x6f, 0x7e, 0xff, 0xb1, 0xb7, 0xfc, 0xd1, 0xf7, 0x1f, 0x7b, 0xcb, 0x77, 0xbf, 0xff, 0xd8, 0x5b,
-	0x3e, 0x76, 0xfb, 0x31, 0xeb, 0x9b, 0xb7, 0x1f, 0xb3, 0xfe, 0xe8, 0xf6, 0x63, 0xd6, 0x77, 0x6f,
-	0x3f, 0x66, 0x7d, 0xef, 0xf6, 0x63, 0xd6, 0x17, 0xfe, 0xeb, 0x63, 0x6f, 0x79, 0x29, 0xd7, 0x27,
-	0x95, 0xfe, 0x78, 0xda, 0xad, 0x4f, 0x6d, 0x9f, 0x67, 0x6e, 0x91, 0x74, 0x7b, 0x4d, 0x19, 0x6b,
-	0x6a, 0x4a, 0x6e, 0xaf, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0x45, 0x1c, 0x89, 0x97, 0xf1, 0xeb,
-	0x00, 0x00,
+	// 11404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x70, 0x1c, 0xd9,
+	0x75, 0x18, 0xac, 0x9e, 0x07, 0x30, 0x73, 0x01, 0x82, 0x64, 0x93, 0xdc, 0x1d, 0x52, 0xbb, 0x0b,
+	0xba, 0x57, 0x5e, 0xc9, 0x9f, 0xb5, 0xa0, 0x45, 0xc9, 0xf2, 0x7e, 0x92, 0x25, 0x1b, 0x0f, 0x3e,
+	0xb0, 0x04, 0x08, 0xec, 0x01, 0x48, 0xea, 0xe1, 0xdd, 0x55, 0x63, 0xe6, 0x62, 0xd0, 0x44, 0x4f,
+	0xf7, 0x6c, 0x77, 0x0f, 0x48, 0xac, 0x25, 0x59, 0xb2, 0x22, 0x5b, 0x8e, 0x9e, 0x91, 0x52, 0x15,
+	0x39, 0xb1, 0x14, 0xd9, 0x72, 0x52, 0x49, 0xa5, 0x54, 0x51, 0x92, 0xaa, 0xc4, 0x29, 0xc7, 0xe5,
+	0x8a, 0x9d, 0x72, 0x29, 0x71, 0x52, 0x76, 0x54, 0x2a, 0x47, 0x49, 0x1c, 0x46, 0xcb, 0x38, 0x65,
+	0x57, 0x7e, 0xb8, 0x2a, 0x4e, 0x7e, 0xa4, 0x98, 0xfc, 0x48, 0x9d, 0xfb, 0xee, 0x9e, 0x1e, 0x60,
+	0x00, 0x34, 0x40, 0x4a, 0xd9, 0x5f, 0xc0, 0xdc, 0x73, 0xee, 0x3d, 0xb7, 0xef, 0xe3, 0xdc, 0x73,
+	0xcf, 0xeb, 0x92, 0x85, 0xb6, 0x97, 0x6c, 0xf4, 0xd6, 0xa6, 0x9a, 0x61, 0xe7, 0x82, 0x1b, 0xb5,
+	0xc3, 0x6e, 0x14, 0xde, 0x66, 0xff, 0x3c, 0xdb, 0x6c, 0x5d, 0xd8, 0xba, 0x78, 0xa1, 0xbb, 0xd9,
+	0xbe, 0xe0, 0x76, 0xbd, 0xf8, 0x82, 0xdb, 0xed, 0xfa, 0x5e, 0xd3, 0x4d, 0xbc, 0x30, 0xb8, 0xb0,
+	0xf5, 0x36, 0xd7, 0xef, 0x6e, 0xb8, 0x6f, 0xbb, 0xd0, 0xa6, 0x01, 0x8d, 0xdc, 0x84, 0xb6, 0xa6,
+	0xba, 0x51, 0x98, 0x84, 0xf6, 0x4f, 0xea, 0xd6, 0xa6, 0x64, 0x6b, 0xec, 0x9f, 0x97, 0x9b, 0xad,
+	0xa9, 0xad, 0x8b, 0x53, 0xdd, 0xcd, 0xf6, 0x14, 0xb6, 0x36, 0x65, 0xb4, 0x36, 0x25, 0x5b, 0x3b,
+	0xf7, 0xac, 0xd1, 0x97, 0x76, 0xd8, 0x0e, 0x2f, 0xb0, 0x46, 0xd7, 0x7a, 0xeb, 0xec, 0x17, 0xfb,
+	0xc1, 0xfe, 0xe3, 0xc4, 0xce, 0x39, 0x9b, 0xcf, 0xc5, 0x53, 0x5e, 0x88, 0xdd, 0xbb, 0xd0, 0x0c,
+	0x23, 0x7a, 0x61, 0xab, 0xaf, 0x43, 0xe7, 0xae, 0x6a, 0x1c, 0x7a, 0x37, 0xa1, 0x41, 0xec, 0x85,
+	0x41, 0xfc, 0x2c, 0x76, 0x81, 0x46, 0x5b, 0x34, 0x32, 0x3f, 0xcf, 0x40, 0xc8, 0x6b, 0xe9, 0x1d,
+	0xba, 0xa5, 0x8e, 0xdb, 0xdc, 0xf0, 0x02, 0x1a, 0x6d, 0xeb, 0xea, 0x1d, 0x9a, 0xb8, 0x79, 0xb5,
+	0x2e, 0x0c, 0xaa, 0x15, 0xf5, 0x82, 0xc4, 0xeb, 0xd0, 0xbe, 0x0a, 0xef, 0xdc, 0xad, 0x42, 0xdc,
+	0xdc, 0xa0, 0x1d, 0xb7, 0xaf, 0xde, 0xdb, 0x07, 0xd5, 0xeb, 0x25, 0x9e, 0x7f, 0xc1, 0x0b, 0x92,
+	0x38, 0x89, 0xb2, 0x95, 0x9c, 0x5f, 0xb1, 0xc8, 0xb1, 0xe9, 0x5b, 0x2b, 0xd3, 0xbd, 0x64, 0x63,
+	0x36, 0x0c, 0xd6, 0xbd, 0xb6, 0xfd, 0xe3, 0x64, 0xac, 0xe9, 0xf7, 0xe2, 0x84, 0x46, 0xd7, 0xdd,
+	0x0e, 0x6d, 0x58, 0xe7, 0xad, 0xb7, 0xd4, 0x67, 0x4e, 0x7d, 0xeb, 0xde, 0xe4, 0x1b, 0xee, 0xdf,
+	0x9b, 0x1c, 0x9b, 0xd5, 0x20, 0x30, 0xf1, 0xec, 0x1f, 0x21, 0xa3, 0x51, 0xe8, 0xd3, 0x69, 0xb8,
+	0xde, 0x28, 0xb1, 0x2a, 0xc7, 0x45, 0x95, 0x51, 0xe0, 0xc5, 0x20, 0xe1, 0x88, 0xda, 0x8d, 0xc2,
+	0x75, 0xcf, 0xa7, 0x8d, 0x72, 0x1a, 0x75, 0x99, 0x17, 0x83, 0x84, 0x3b, 0x7f, 0x54, 0x22, 0x64,
+	0xba, 0xdb, 0x5d, 0x8e, 0xc2, 0xdb, 0xb4, 0x99, 0xd8, 0x1f, 0x22, 0x35, 0x1c, 0xe6, 0x96, 0x9b,
+	0xb8, 0xac, 0x63, 0x63, 0x17, 0x7f, 0x6c, 0x8a, 0x7f, 0xf5, 0x94, 0xf9, 0xd5, 0x7a, 0x91, 0x21,
+	0xf6, 0xd4, 0xd6, 0xdb, 0xa6, 0x96, 0xd6, 0xb0, 0xfe, 0x22, 0x4d, 0xdc, 0x19, 0x5b, 0x10, 0x23,
+	0xba, 0x0c, 0x54, 0xab, 0x76, 0x40, 0x2a, 0x71, 0x97, 0x36, 0xd9, 0x37, 0x8c, 0x5d, 0x5c, 0x98,
+	0x3a, 0xc8, 0x6a, 0x9e, 0xd2, 0x3d, 0x5f, 0xe9, 0xd2, 0xe6, 0xcc, 0xb8, 0xa0, 0x5c, 0xc1, 0x5f,
+	0xc0, 0xe8, 0xd8, 0x5b, 0x64, 0x24, 0x4e, 0xdc, 0xa4, 0x17, 0xb3, 0xa1, 0x18, 0xbb, 0x78, 0xbd,
+	0x30, 0x8a, 0xac, 0xd5, 0x99, 0x09, 0x41, 0x73, 0x84, 0xff, 0x06, 0x41, 0xcd, 0xf9, 0x4f, 0x16,
+	0x99, 0xd0, 0xc8, 0x0b, 0x5e, 0x9c, 0xd8, 0x3f, 0xd3, 0x37, 0xb8, 0x53, 0xc3, 0x0d, 0x2e, 0xd6,
+	0x66, 0x43, 0x7b, 0x42, 0x10, 0xab, 0xc9, 0x12, 0x63, 0x60, 0x3b, 0xa4, 0xea, 0x25, 0xb4, 0x13,
+	0x37, 0x4a, 0xe7, 0xcb, 0x6f, 0x19, 0xbb, 0x78, 0xb5, 0xa8, 0xef, 0x9c, 0x39, 0x26, 0x88, 0x56,
+	0xe7, 0xb1, 0x79, 0xe0, 0x54, 0x9c, 0xbf, 0x38, 0x66, 0x7e, 0x1f, 0x0e, 0xb8, 0xfd, 0x36, 0x32,
+	0x16, 0x87, 0xbd, 0xa8, 0x49, 0x81, 0x76, 0xc3, 0xb8, 0x61, 0x9d, 0x2f, 0xe3, 0xd2, 0xc3, 0x45,
+	0xbd, 0xa2, 0x8b, 0xc1, 0xc4, 0xb1, 0x3f, 0x67, 0x91, 0xf1, 0x16, 0x8d, 0x13, 0x2f, 0x60, 0xf4,
+	0x65, 0xe7, 0x57, 0x0f, 0xdc, 0x79, 0x59, 0x38, 0xa7, 0x1b, 0x9f, 0x39, 0x2d, 0x3e, 0x64, 0xdc,
+	0x28, 0x8c, 0x21, 0x45, 0x1f, 0x37, 0x67, 0x8b, 0xc6, 0xcd, 0xc8, 0xeb, 0xe2, 0xef, 0x46, 0x39,
+	0xbd, 0x39, 0xe7, 0x34, 0x08, 0x4c, 0x3c, 0x3b, 0x20, 0x55, 0xdc, 0x7c, 0x71, 0xa3, 0xc2, 0xfa,
+	0x3f, 0x7f, 0xb0, 0xfe, 0x8b, 0x41, 0xc5, 0x7d, 0xad, 0x47, 0x1f, 0x7f, 0xc5, 0xc0, 0xc9, 0xd8,
+	0x9f, 0xb5, 0x48, 0x43, 0x30, 0x07, 0xa0, 0x7c, 0x40, 0x6f, 0x6d, 0x78, 0x09, 0xf5, 0xbd, 0x38,
+	0x69, 0x54, 0x59, 0x1f, 0x2e, 0x0c, 0xb7, 0xb6, 0xae, 0x44, 0x61, 0xaf, 0x7b, 0xcd, 0x0b, 0x5a,
+	0x33, 0xe7, 0x05, 0xa5, 0xc6, 0xec, 0x80, 0x86, 0x61, 0x20, 0x49, 0xfb, 0x4b, 0x16, 0x39, 0x17,
+	0xb8, 0x1d, 0x1a, 0x77, 0xdd, 0x26, 0x95, 0xe0, 0x19, 0xdf, 0x6d, 0x6e, 0xb2, 0x1e, 0x8d, 0xec,
+	0xaf, 0x47, 0x8e, 0xe8, 0xd1, 0xb9, 0xeb, 0x03, 0x9b, 0x86, 0x1d, 0xc8, 0xda, 0x5f, 0xb7, 0xc8,
+	0xc9, 0x30, 0xea, 0x6e, 0xb8, 0x01, 0x6d, 0x49, 0x68, 0xdc, 0x18, 0x65, 0x5b, 0xef, 0xa5, 0x83,
+	0x4d, 0xd1, 0x52, 0xb6, 0xd9, 0xc5, 0x30, 0xf0, 0x92, 0x30, 0x5a, 0xa1, 0x49, 0xe2, 0x05, 0xed,
+	0x78, 0xe6, 0xcc, 0xfd, 0x7b, 0x93, 0x27, 0xfb, 0xb0, 0xa0, 0xbf, 0x3f, 0xf6, 0xcf, 0x92, 0xb1,
+	0x78, 0x3b, 0x68, 0xde, 0xf2, 0x82, 0x56, 0x78, 0x27, 0x6e, 0xd4, 0x8a, 0xd8, 0xbe, 0x2b, 0xaa,
+	0x41, 0xb1, 0x01, 0x35, 0x01, 0x30, 0xa9, 0xe5, 0x4f, 0x9c, 0x5e, 0x4a, 0xf5, 0xa2, 0x27, 0x4e,
+	0x2f, 0xa6, 0x1d, 0xc8, 0xda, 0xbf, 0x68, 0x91, 0x63, 0xb1, 0xd7, 0x0e, 0xdc, 0xa4, 0x17, 0xd1,
+	0x6b, 0x74, 0x3b, 0x6e, 0x10, 0xd6, 0x91, 0xe7, 0x0f, 0x38, 0x2a, 0x46, 0x93, 0x33, 0x67, 0x44,
+	0x1f, 0x8f, 0x99, 0xa5, 0x31, 0xa4, 0xe9, 0xe6, 0x6d, 0x34, 0xbd, 0xac, 0xc7, 0x8a, 0xdd, 0x68,
+	0x7a, 0x51, 0x0f, 0x24, 0x69, 0xff, 0x34, 0x39, 0xc1, 0x8b, 0xd4, 0xc8, 0xc6, 0x8d, 0x71, 0xc6,
+	0x68, 0x4f, 0xdf, 0xbf, 0x37, 0x79, 0x62, 0x25, 0x03, 0x83, 0x3e, 0x6c, 0xfb, 0x15, 0x32, 0xd9,
+	0xa5, 0x51, 0xc7, 0x4b, 0x96, 0x02, 0x7f, 0x5b, 0xb2, 0xef, 0x66, 0xd8, 0xa5, 0x2d, 0xd1, 0x9d,
+	0xb8, 0x71, 0xec, 0xbc, 0xf5, 0x96, 0xda, 0xcc, 0x9b, 0x45, 0x37, 0x27, 0x97, 0x77, 0x46, 0x87,
+	0xdd, 0xda, 0xb3, 0x7f, 0xcf, 0x22, 0xe7, 0x0c, 0x2e, 0xbb, 0x42, 0xa3, 0x2d, 0xaf, 0x49, 0xa7,
+	0x9b, 0xcd, 0xb0, 0x17, 0x24, 0x71, 0x63, 0x82, 0x0d, 0xe3, 0xda, 0x61, 0xf0, 0xfc, 0x34, 0x29,
+	0xbd, 0x2e, 0x07, 0xa2, 0xc4, 0xb0, 0x43, 0x4f, 0x9d, 0x7f, 0x59, 0x22, 0x27, 0xb2, 0x12, 0x80,
+	0xfd, 0xb7, 0x2d, 0x72, 0xfc, 0xf6, 0x9d, 0x64, 0x35, 0xdc, 0xa4, 0x41, 0x3c, 0xb3, 0x8d, 0x7c,
+	0x9a, 0x9d, 0x7d, 0x63, 0x17, 0x9b, 0xc5, 0xca, 0x1a, 0x53, 0xcf, 0xa7, 0xa9, 0x5c, 0x0a, 0x92,
+	0x68, 0x7b, 0xe6, 0x71, 0xf1, 0x4d, 0xc7, 0x9f, 0xbf, 0xb5, 0x6a, 0x42, 0x21, 0xdb, 0xa9, 0x73,
+	0x9f, 0xb6, 0xc8, 0xe9, 0xbc, 0x26, 0xec, 0x13, 0xa4, 0xbc, 0x49, 0xb7, 0xb9, 0x24, 0x0a, 0xf8,
+	0xaf, 0xfd, 0x22, 0xa9, 0x6e, 0xb9, 0x7e, 0x8f, 0x0a, 0x31, 0xed, 0xca, 0xc1, 0x3e, 0x44, 0xf5,
+	0x0c, 0x78, 0xab, 0xef, 0x2a, 0x3d, 0x67, 0x39, 0x7f, 0x50, 0x26, 0x63, 0xc6, 0xa4, 0x1d, 0x81,
+	0xe8, 0x19, 0xa6, 0x44, 0xcf, 0xc5, 0xc2, 0xd6, 0xdb, 0x40, 0xd9, 0xf3, 0x4e, 0x46, 0xf6, 0x5c,
+	0x2a, 0x8e, 0xe4, 0x8e, 0xc2, 0xa7, 0x9d, 0x90, 0x7a, 0xd8, 0xa5, 0x11, 0x43, 0x6d, 0x54, 0x8a,
+	0x98, 0xc2, 0x25, 0xd9, 0xdc, 0xcc, 0xb1, 0xfb, 0xf7, 0x26, 0xeb, 0xea, 0x27, 0x68, 0x42, 0xce,
+	0xbf, 0xb3, 0xc8, 0x69, 0xa3, 0x8f, 0xb3, 0x61, 0xd0, 0xf2, 0xd8, 0xd4, 0x9e, 0x27, 0x95, 0x64,
+	0xbb, 0x2b, 0xaf, 0x3a, 0x6a, 0xa4, 0x56, 0xb7, 0xbb, 0x14, 0x18, 0x04, 0x6f, 0x2c, 0x1d, 0x1a,
+	0xc7, 0x6e, 0x9b, 0x66, 0x2f, 0x37, 0x8b, 0xbc, 0x18, 0x24, 0xdc, 0x8e, 0x88, 0xed, 0xbb, 0x71,
+	0xb2, 0x1a, 0xb9, 0x41, 0xcc, 0x9a, 0x5f, 0xf5, 0x3a, 0x54, 0x0c, 0xf0, 0xff, 0x37, 0xdc, 0x8a,
+	0xc1, 0x1a, 0x33, 0x8f, 0xdd, 0xbf, 0x37, 0x69, 0x2f, 0xf4, 0xb5, 0x04, 0x39, 0xad, 0x3b, 0x5f,
+	0xb2, 0xc8, 0x63, 0xf9, 0x0c, 0xc6, 0x7e, 0x86, 0x8c, 0xf0, 0x7b, 0xae, 0xf8, 0x3a, 0x3d, 0x25,
+	0xac, 0x14, 0x04, 0xd4, 0xbe, 0x40, 0xea, 0xea, 0xc0, 0x13, 0xdf, 0x78, 0x52, 0xa0, 0xd6, 0xf5,
+	0x29, 0xa9, 0x71, 0x70, 0xd0, 0x02, 0x57, 0x7c, 0x99, 0x31, 0x68, 0x88, 0x0b, 0x0c, 0xe2, 0x7c,
+	0xc7, 0x22, 0x6f, 0x1a, 0x86, 0xed, 0x1d, 0x5e, 0x1f, 0x57, 0xc8, 0x99, 0x16, 0x5d, 0x77, 0x7b,
+	0x7e, 0x92, 0xa6, 0x28, 0x3a, 0xfd, 0xa4, 0xa8, 0x7c, 0x66, 0x2e, 0x0f, 0x09, 0xf2, 0xeb, 0x3a,
+	0xff, 0xd9, 0x22, 0xc7, 0x8d, 0xcf, 0x3a, 0x82, 0xab, 0x53, 0x90, 0xbe, 0x3a, 0xcd, 0x17, 0xb6,
+	0x4d, 0x07, 0xdc, 0x9d, 0x3e, 0x6b, 0x91, 0x73, 0x06, 0xd6, 0xa2, 0x9b, 0x34, 0x37, 0x2e, 0xdd,
+	0xed, 0x46, 0x34, 0x8e, 0x71, 0x49, 0x3d, 0x69, 0xb0, 0xe3, 0x99, 0x31, 0xd1, 0x42, 0xf9, 0x1a,
+	0xdd, 0xe6, 0xbc, 0xf9, 0xad, 0xa4, 0xc6, 0xf7, 0x5c, 0x18, 0x89, 0x49, 0x52, 0xdf, 0xb6, 0x24,
+	0xca, 0x41, 0x61, 0xd8, 0x0e, 0x19, 0x61, 0x3c, 0x17, 0x79, 0x10, 0x8a, 0x09, 0x04, 0xe7, 0xfd,
+	0x26, 0x2b, 0x01, 0x01, 0x71, 0xe2, 0x54, 0x77, 0x96, 0x23, 0xca, 0xd6, 0x43, 0xeb, 0xb2, 0x47,
+	0xfd, 0x56, 0x8c, 0xd7, 0x3a, 0x37, 0x08, 0xc2, 0x44, 0xdc, 0xd0, 0x8c, 0x6b, 0xdd, 0xb4, 0x2e,
+	0x06, 0x13, 0x07, 0x89, 0xfa, 0xee, 0x1a, 0xf5, 0xf9, 0x88, 0x0a, 0xa2, 0x0b, 0xac, 0x04, 0x04,
+	0xc4, 0xb9, 0x5f, 0x22, 0x13, 0x06, 0xd5, 0x15, 0x7a, 0x14, 0xda, 0x87, 0x28, 0x75, 0x04, 0x2c,
+	0x17, 0xc7, 0x8f, 0xe9, 0x60, 0x0d, 0xc4, 0xab, 0x99, 0x53, 0x00, 0x0a, 0xa5, 0xba, 0xb3, 0x16,
+	0xe2, 0x63, 0x65, 0x32, 0x99, 0xae, 0xd0, 0x77, 0x88, 0xe0, 0x95, 0xd7, 0x20, 0x94, 0xd5, 0x47,
+	0x19, 0xf8, 0x60, 0xe2, 0x0d, 0xe0, 0xc3, 0xa5, 0xc3, 0xe4, 0xc3, 0xe6, 0x31, 0x51, 0xde, 0xe5,
+	0x98, 0x78, 0x46, 0x8d, 0x7a, 0x25, 0xc3, 0xf3, 0xd2, 0x47, 0xe5, 0x79, 0x52, 0x89, 0x13, 0xda,
+	0x6d, 0x54, 0xd3, 0x6c, 0x76, 0x25, 0xa1, 0x5d, 0x60, 0x10, 0xfb, 0x3d, 0xe4, 0x78, 0xe2, 0x46,
+	0x6d, 0x9a, 0x44, 0x74, 0xcb, 0x63, 0xba, 0x4b, 0x76, 0x9f, 0xad, 0xcf, 0x9c, 0x42, 0xa9, 0x6b,
+	0x95, 0x81, 0x40, 0x82, 0x20, 0x8b, 0xeb, 0xfc, 0xb7, 0x12, 0x79, 0x3c, 0x3d, 0x05, 0xfa, 0x60,
+	0xfc, 0xa9, 0xd4, 0xc1, 0xf8, 0xa3, 0xe6, 0xc1, 0xf8, 0xe0, 0xde, 0xe4, 0x1b, 0x07, 0x54, 0xfb,
+	0xbe, 0x39, 0x37, 0xed, 0x2b, 0x99, 0x49, 0xb8, 0x90, 0x9e, 0x84, 0x07, 0xf7, 0x26, 0x9f, 0x1c,
+	0xf0, 0x8d, 0x99, 0x59, 0x7a, 0x86, 0x8c, 0x44, 0xd4, 0x8d, 0xc3, 0xa0, 0x51, 0x4d, 0xcf, 0x26,
+	0xb0, 0x52, 0x10, 0x50, 0xe7, 0xdb, 0xf5, 0xec, 0x60, 0x5f, 0xe1, 0xfa, 0xd8, 0x30, 0xb2, 0x3d,
+	0x52, 0x61, 0xb7, 0x36, 0xce, 0x59, 0xae, 0x1d, 0x6c, 0x17, 0xe2, 0x29, 0xa2, 0x9a, 0x9e, 0xa9,
+	0xe1, 0xac, 0x61, 0x11, 0x30, 0x12, 0xf6, 0x5d, 0x52, 0x6b, 0xca, 0xcb, 0x54, 0xa9, 0x08, 0xb5,
+	0xa3, 0xb8, 0x4a, 0x69, 0x8a, 0xe3, 0xc8, 0xee, 0xd5, 0x0d, 0x4c, 0x51, 0xb3, 0x29, 0x29, 0xb7,
+	0xbd, 0x44, 0x4c, 0xeb, 0x01, 0xaf, 0xcb, 0x57, 0x3c, 0xe3, 0x13, 0x47, 0xf1, 0x0c, 0xba, 0xe2,
+	0x25, 0x80, 0xed, 0xdb, 0x9f, 0xb4, 0xc8, 0x58, 0xdc, 0xec, 0x2c, 0x47, 0xe1, 0x96, 0xd7, 0xa2,
+	0x51, 0xa3, 0x52, 0x04, 0x67, 0x5b, 0x99, 0x5d, 0x94, 0x0d, 0x6a, 0xba, 0x5c, 0x7d, 0xa1, 0x21,
+	0x60, 0xd2, 0xc5, 0xbb, 0xd7, 0xe3, 0xe2, 0xdb, 0xe7, 0x68, 0x93, 0xed, 0x38, 0x79, 0x67, 0x6e,
+	0x54, 0x8b, 0x90, 0xb9, 0xe7, 0x7a, 0xcd, 0x4d, 0xdc, 0x6f, 0xba, 0x43, 0x6f, 0xbc, 0x7f, 0x6f,
+	0xf2, 0xf1, 0xd9, 0x7c, 0x9a, 0x30, 0xa8, 0x33, 0x6c, 0xc0, 0xba, 0x3d, 0xdf, 0x07, 0xfa, 0x4a,
+	0x8f, 0x32, 0x8d, 0x58, 0x01, 0x03, 0xb6, 0xac, 0x1b, 0xcc, 0x0c, 0x98, 0x01, 0x01, 0x93, 0xae,
+	0xfd, 0x0a, 0x19, 0xe9, 0xb8, 0x49, 0xe4, 0xdd, 0x6d, 0x8c, 0x16, 0x71, 0x0b, 0x5a, 0x64, 0x6d,
+	0x69, 0xe2, 0xec, 0xa0, 0xe7, 0x85, 0x20, 0x08, 0xa1, 0x62, 0xba, 0x43, 0xa3, 0x36, 0x6d, 0xd4,
+	0x8a, 0x50, 0xf9, 0x2f, 0x62, 0x53, 0x9a, 0x60, 0x1d, 0x85, 0x2b, 0x56, 0x06, 0x9c, 0x8a, 0xfd,
+	0x22, 0xa9, 0xc5, 0xd4, 0xa7, 0x4d, 0x14, 0x8f, 0xea, 0x8c, 0xe2, 0xdb, 0x87, 0x14, 0x15, 0x51,
+	0x2e, 0x59, 0x11, 0x55, 0xf9, 0x06, 0x93, 0xbf, 0x40, 0x35, 0x89, 0x03, 0xd8, 0xf5, 0x7b, 0x6d,
+	0x2f, 0x68, 0x90, 0x22, 0x06, 0x70, 0x99, 0xb5, 0x95, 0x19, 0x40, 0x5e, 0x08, 0x82, 0x90, 0xf3,
+	0x5f, 0x2d, 0x62, 0xa7, 0x99, 0xda, 0x11, 0xc8, 0xc4, 0xaf, 0xa4, 0x65, 0xe2, 0x85, 0x22, 0x85,
+	0x96, 0x01, 0x62, 0xf1, 0x6f, 0xd6, 0x49, 0xe6, 0x38, 0xb8, 0x4e, 0xe3, 0x84, 0xb6, 0x5e, 0x67,
+	0xe1, 0xaf, 0xb3, 0xf0, 0xd7, 0x59, 0xb8, 0xfc, 0x61, 0xaf, 0x65, 0x58, 0xf8, 0x7b, 0x8d, 0x5d,
+	0xaf, 0xed, 0xeb, 0x2f, 0x2b, 0x03, 0xbc, 0xd9, 0x03, 0x03, 0x01, 0x39, 0xc1, 0xf3, 0x2b, 0x4b,
+	0xd7, 0x73, 0x79, 0xf6, 0xcb, 0x69, 0x9e, 0x7d, 0x50, 0x12, 0xff, 0x2f, 0x70, 0xe9, 0xdf, 0xb3,
+	0xc8, 0x9b, 0xd3, 0xdc, 0x4b, 0xae, 0x9c, 0xf9, 0x76, 0x10, 0x46, 0x74, 0xce, 0x5b, 0x5f, 0xa7,
+	0x11, 0x0d, 0x50, 0x07, 0x2f, 0x75, 0x3b, 0xd6, 0x20, 0xdd, 0x8e, 0xfd, 0x0e, 0x32, 0x7e, 0x3b,
+	0x0e, 0x83, 0xe5, 0xd0, 0x0b, 0x04, 0x0b, 0xc2, 0x1b, 0xc7, 0x09, 0xb4, 0x5e, 0xe2, 0x88, 0xca,
+	0x72, 0x48, 0x61, 0xd9, 0xb3, 0xe4, 0xe4, 0xed, 0x57, 0x96, 0xdd, 0xc4, 0xd0, 0x26, 0xc8, 0x7b,
+	0x3f, 0xb3, 0x47, 0x3d, 0xff, 0x42, 0x06, 0x08, 0xfd, 0xf8, 0xce, 0xdf, 0x28, 0x91, 0xb3, 0x99,
+	0x0f, 0x09, 0x7d, 0x3f, 0xec, 0x25, 0x78, 0x27, 0xb2, 0xbf, 0x6a, 0x91, 0x13, 0x9d, 0xb4, 0xc2,
+	0x22, 0x16, 0xea, 0xee, 0xf7, 0x15, 0x76, 0x46, 0x64, 0x34, 0x22, 0x33, 0x0d, 0x31, 0x42, 0x27,
+	0x32, 0x80, 0x18, 0xfa, 0xfa, 0x62, 0xbf, 0x48, 0xea, 0x1d, 0xf7, 0xee, 0x8d, 0x6e, 0xcb, 0x4d,
+	0xe4, 0x75, 0x74, 0xb0, 0x16, 0xa1, 0x97, 0x78, 0xfe, 0x14, 0xf7, 0xdc, 0x98, 0x9a, 0x0f, 0x92,
+	0xa5, 0x68, 0x25, 0x89, 0xbc, 0xa0, 0xcd, 0x95, 0x9c, 0x8b, 0xb2, 0x19, 0xd0, 0x2d, 0x3a, 0x5f,
+	0xb1, 0xc8, 0x93, 0x03, 0x46, 0x27, 0x72, 0x13, 0xda, 0xde, 0xb6, 0x3f, 0x4c, 0xaa, 0x78, 0x6f,
+	0x94, 0xa3, 0x72, 0xab, 0xc8, 0x93, 0xd3, 0x98, 0x09, 0x7d, 0x88, 0xe2, 0xaf, 0x18, 0x38, 0x51,
+	0xe7, 0xab, 0xf5, 0xac, 0xb0, 0xc0, 0x6c, 0xf3, 0x17, 0x09, 0x69, 0x87, 0xab, 0xb4, 0xd3, 0xf5,
+	0xdd, 0x84, 0xaf, 0xbb, 0x9a, 0x56, 0x95, 0x5c, 0x51, 0x10, 0x30, 0xb0, 0xec, 0x5f, 0xb2, 0x08,
+	0x69, 0xcb, 0x35, 0x2f, 0x05, 0x81, 0x1b, 0x45, 0x7e, 0x8e, 0xde, 0x51, 0xba, 0x2f, 0x8a, 0x20,
+	0x18, 0xc4, 0xed, 0x9f, 0xb7, 0x48, 0x2d, 0x91, 0xdd, 0xe7, 0x47, 0xe3, 0x6a, 0x91, 0x3d, 0x91,
+	0x1f, 0xad, 0x65, 0x22, 0x35, 0x24, 0x8a, 0xae, 0xfd, 0x0b, 0x16, 0x21, 0x68, 0x3c, 0x5d, 0x0e,
+	0x7d, 0xaf, 0xb9, 0x2d, 0x4e, 0xcc, 0x9b, 0x85, 0xaa, 0x73, 0x54, 0xeb, 0x33, 0x13, 0x38, 0x1a,
+	0xfa, 0x37, 0x18, 0x94, 0xed, 0x8f, 0x92, 0x5a, 0x2c, 0x96, 0x5b, 0xa3, 0x5a, 0xfc, 0x60, 0xc8,
+	0xa5, 0x2c, 0xd8, 0xab, 0xf8, 0x05, 0x8a, 0xa6, 0xfd, 0xd7, 0x2c, 0x72, 0xbc, 0x9b, 0x56, 0x13,
+	0x8a, 0xe3, 0xb0, 0x38, 0x1e, 0x90, 0x51, 0x43, 0x72, 0x6d, 0x4b, 0xa6, 0x10, 0xb2, 0xbd, 0x40,
+	0x0e, 0xa8, 0x57, 0xf0, 0x52, 0x97, 0xab, 0x2c, 0x47, 0x35, 0x07, 0xbc, 0x92, 0x05, 0x42, 0x3f,
+	0xbe, 0xbd, 0x4c, 0x4e, 0x63, 0xef, 0xb6, 0xb9, 0xf8, 0x29, 0x8f, 0x97, 0x98, 0x1d, 0x86, 0xb5,
+	0x99, 0x27, 0xc4, 0x0a, 0x39, 0x3d, 0x9d, 0x83, 0x03, 0xb9, 0x35, 0xed, 0x3f, 0xb0, 0xc8, 0x13,
+	0x1e, 0x3b, 0x06, 0x4c, 0x85, 0xbd, 0x3e, 0x11, 0x84, 0xa1, 0x9d, 0x16, 0xca, 0x2b, 0x06, 0x1d,
+	0x3f, 0x33, 0x6f, 0x12, 0x5f, 0xf0, 0xc4, 0xfc, 0x0e, 0x5d, 0x82, 0x1d, 0x3b, 0x6c, 0xff, 0x04,
+	0x39, 0x26, 0xf7, 0xc5, 0x32, 0xb2, 0x60, 0x76, 0xd0, 0xd6, 0x67, 0x4e, 0xa2, 0x45, 0x7d, 0xd5,
+	0x04, 0x40, 0x1a, 0xcf, 0xf9, 0x57, 0x65, 0x72, 0x3a, 0xbb, 0xdc, 0x98, 0x8e, 0x07, 0xd9, 0x4d,
+	0x53, 0xea, 0x7f, 0x24, 0xf7, 0x2c, 0x94, 0xdd, 0x28, 0xed, 0x92, 0x66, 0x37, 0xaa, 0x28, 0x06,
+	0x83, 0x38, 0x0a, 0xa5, 0x27, 0xdd, 0xac, 0xa6, 0x54, 0x70, 0xc0, 0x17, 0x8b, 0xec, 0x52, 0xbf,
+	0x4d, 0xef, 0xac, 0xe8, 0xda, 0xc9, 0x3e, 0x10, 0xf4, 0x77, 0xc9, 0xfe, 0x08, 0xa9, 0x47, 0xca,
+	0xb3, 0xa5, 0x5c, 0xc4, 0x55, 0x4d, 0x2e, 0x1b, 0xd1, 0x1d, 0x65, 0x00, 0xd2, 0x3e, 0x2c, 0x9a,
+	0xa2, 0xf3, 0xfb, 0x69, 0xc3, 0x98, 0xc1, 0x3b, 0x86, 0x30, 0xfa, 0x7d, 0xce, 0x22, 0x63, 0x51,
+	0xe8, 0xfb, 0x5e, 0xd0, 0x46, 0x3e, 0x27, 0x0e, 0xeb, 0x0f, 0x1e, 0xca, 0x79, 0x29, 0x18, 0x1a,
+	0x93, 0xac, 0x41, 0xd3, 0x04, 0xb3, 0x03, 0xe8, 0xb3, 0xd7, 0x18, 0xc4, 0x8f, 0x6d, 0x4a, 0xde,
+	0x28, 0x99, 0x8d, 0x1a, 0x8a, 0xa5, 0x60, 0x8e, 0xfa, 0x54, 0xa9, 0xcd, 0x6b, 0x33, 0x4f, 0x8b,
+	0xcf, 0x7c, 0xe3, 0xf2, 0x60, 0x54, 0xd8, 0xa9, 0x1d, 0xfb, 0x03, 0xe4, 0x84, 0xf1, 0x5d, 0xb1,
+	0x1a, 0x98, 0xfa, 0xcc, 0x14, 0x0a, 0x40, 0xd3, 0x19, 0xd8, 0x83, 0x7b, 0x93, 0x8f, 0x65, 0xcb,
+	0xc4, 0x81, 0xd1, 0xd7, 0x8e, 0xf3, 0xeb, 0xa5, 0xec, 0x6c, 0xa9, 0xb3, 0xfe, 0xcb, 0x56, 0x9f,
+	0x36, 0xe1, 0x7d, 0x87, 0x71, 0xbe, 0x32, 0xbd, 0x83, 0x72, 0xc3, 0x18, 0x8c, 0xf3, 0x10, 0xcd,
+	0xf6, 0xce, 0xbf, 0xae, 0x90, 0x1d, 0x7a, 0x36, 0x84, 0xf0, 0xbe, 0x67, 0x3b, 0xea, 0x67, 0x2c,
+	0x65, 0x30, 0xe3, 0x7b, 0xb8, 0x75, 0x58, 0x63, 0xcf, 0xef, 0x4f, 0x31, 0x77, 0x1d, 0x51, 0x5a,
+	0xf4, 0xb4, 0x69, 0xce, 0xfe, 0x9a, 0x95, 0x36, 0xf9, 0x71, 0xa7, 0x46, 0xef, 0xd0, 0xfa, 0x64,
+	0xd8, 0x11, 0x79, 0xc7, 0xb4, 0xf5, 0x69, 0x90, 0x85, 0x71, 0x8a, 0x90, 0x75, 0x2f, 0x70, 0x7d,
+	0xef, 0x55, 0xbc, 0x1d, 0x55, 0xd9, 0x01, 0xcf, 0x24, 0xa6, 0xcb, 0xaa, 0x14, 0x0c, 0x8c, 0x73,
+	0xff, 0x3f, 0x19, 0x33, 0xbe, 0x3c, 0xc7, 0xe3, 0xe5, 0xb4, 0xe9, 0xf1, 0x52, 0x37, 0x1c, 0x55,
+	0xce, 0xbd, 0x97, 0x9c, 0xc8, 0x76, 0x70, 0x2f, 0xf5, 0x9d, 0xff, 0x35, 0x9a, 0xb5, 0xc1, 0xad,
+	0xd2, 0xa8, 0x83, 0x5d, 0x7b, 0x5d, 0xb1, 0xf5, 0xba, 0x62, 0xeb, 0x75, 0xc5, 0x96, 0x69, 0x9b,
+	0x10, 0x4a, 0x9b, 0xd1, 0x23, 0x52, 0xda, 0xa4, 0xd4, 0x50, 0xb5, 0xc2, 0xd5, 0x50, 0xce, 0x27,
+	0xfb, 0x34, 0xf7, 0xab, 0x11, 0xa5, 0x76, 0x48, 0xaa, 0x41, 0xd8, 0xa2, 0x52, 0xc6, 0x7d, 0xbe,
+	0x18, 0x81, 0xed, 0x7a, 0xd8, 0x32, 0xdc, 0xc5, 0xf1, 0x57, 0x0c, 0x9c, 0x8e, 0x73, 0xbf, 0x4a,
+	0x52, 0xe2, 0x24, 0x9f, 0x77, 0x8c, 0x28, 0xa1, 0xdd, 0xf0, 0x06, 0x2c, 0x34, 0xac, 0xb4, 0xf1,
+	0x18, 0x78, 0x31, 0x48, 0x38, 0x9e, 0x79, 0x5d, 0x37, 0xd9, 0x68, 0x94, 0xd2, 0x67, 0x1e, 0xaa,
+	0x8e, 0x80, 0x41, 0xec, 0xf7, 0x92, 0x89, 0x24, 0x65, 0x0a, 0x17, 0x26, 0xdf, 0xc7, 0x04, 0xee,
+	0x44, 0xda, 0x50, 0x0e, 0x19, 0x6c, 0xfb, 0x15, 0x52, 0xd9, 0xa0, 0x7e, 0x47, 0x4c, 0xfd, 0x4a,
+	0x71, 0x67, 0x0d, 0xfb, 0xd6, 0xab, 0xd4, 0xef, 0x70, 0x4e, 0x88, 0xff, 0x01, 0x23, 0x85, 0xeb,
+	0xbe, 0xbe, 0xd9, 0x8b, 0x93, 0xb0, 0xe3, 0xbd, 0x2a, 0x35, 0x9d, 0xef, 0x2b, 0x98, 0xf0, 0x35,
+	0xd9, 0x3e, 0x57, 0x29, 0xa9, 0x9f, 0xa0, 0x29, 0xb3, 0x7e, 0xb4, 0xbc, 0x88, 0x2d, 0x99, 0xed,
+	0x06, 0x39, 0x94, 0x7e, 0xcc, 0xc9, 0xf6, 0x79, 0x3f, 0xd4, 0x4f, 0xd0, 0x94, 0xed, 0x6d, 0xb5,
+	0xff, 0xc6, 0xce, 0x5b, 0xc5, 0xde, 0xbd, 0x58, 0x1f, 0xf8, 0xde, 0xcb, 0xdd, 0x87, 0x4f, 0x93,
+	0x6a, 0x73, 0xc3, 0x8d, 0x92, 0xc6, 0x38, 0x5b, 0x34, 0x6a, 0x15, 0xcf, 0x62, 0x21, 0x70, 0x18,
+	0xfa, 0x45, 0x45, 0x74, 0xbd, 0x71, 0x2c, 0xed, 0x17, 0x05, 0x74, 0x1d, 0xb0, 0xdc, 0xf9, 0xd5,
+	0x12, 0x39, 0xd7, 0x47, 0x53, 0x7d, 0x28, 0x5f, 0xed, 0xcd, 0x5e, 0x14, 0x4b, 0xf5, 0x97, 0xb1,
+	0xda, 0x59, 0x31, 0x48, 0xb8, 0xfd, 0x71, 0x8b, 0x8c, 0xa2, 0x5e, 0x35, 0xa0, 0x49, 0xa3, 0x54,
+	0xb4, 0x92, 0x87, 0x75, 0xeb, 0x79, 0xde, 0xba, 0xee, 0x83, 0x28, 0x00, 0x49, 0x17, 0xbb, 0x4b,
+	0xef, 0x36, 0xfd, 0x5e, 0xab, 0xcf, 0xd5, 0xe5, 0x12, 0x2f, 0x06, 0x09, 0x47, 0x54, 0x2f, 0xe0,
+	0xa8, 0x95, 0x34, 0xea, 0x7c, 0x20, 0x50, 0x05, 0xdc, 0x79, 0x6d, 0x94, 0x9c, 0xc9, 0xdd, 0x1c,
+	0x28, 0x50, 0x31, 0x91, 0xe5, 0xb2, 0xe7, 0x53, 0xe9, 0xe4, 0xc5, 0x04, 0xaa, 0x9b, 0xaa, 0x14,
+	0x0c, 0x0c, 0xfb, 0xe7, 0x08, 0xe9, 0xba, 0x91, 0xdb, 0xa1, 0x4a, 0x3d, 0x7d, 0x60, 0xb9, 0x05,
+	0xfb, 0xb1, 0x2c, 0xdb, 0xd4, 0x57, 0x74, 0x55, 0x14, 0x83, 0x41, 0x12, 0xdd, 0x96, 0x22, 0xea,
+	0x53, 0x37, 0x66, 0xce, 0xed, 0xd9, 0x48, 0x1d, 0xd0, 0x20, 0x30, 0xf1, 0xd0, 0x93, 0x44, 0xf8,
+	0xc3, 0x65, 0xfc, 0x82, 0xd2, 0x3e, 0x71, 0xf6, 0xe7, 0x2d, 0x32, 0x81, 0x11, 0x72, 0x9a, 0xba,
+	0x88, 0xab, 0x59, 0x3a, 0xf8, 0x47, 0x5e, 0x36, 0xdb, 0xd5, 0x1c, 0x32, 0x55, 0x1c, 0x43, 0x86,
+	0x3c, 0x4e, 0xf3, 0x16, 0x8d, 0x18, 0x6b, 0x1d, 0x49, 0x4f, 0xf3, 0x4d, 0x5e, 0x0c, 0x12, 0x6e,
+	0x4f, 0x93, 0xe3, 0x5d, 0x37, 0x8e, 0x67, 0x23, 0xda, 0xa2, 0x41, 0xe2, 0xb9, 0x3e, 0x8f, 0x7a,
+	0xa9, 0x69, 0x67, 0xf1, 0xe5, 0x34, 0x18, 0xb2, 0xf8, 0xf6, 0xfb, 0xc9, 0xe3, 0x5c, 0xff, 0xb3,
+	0xe8, 0xc5, 0xb1, 0x17, 0xb4, 0xf5, 0x32, 0x10, 0x6a, 0xb0, 0x49, 0xd1, 0xd4, 0xe3, 0xf3, 0xf9,
+	0x68, 0x30, 0xa8, 0x3e, 0x3a, 0x30, 0xc6, 0x9b, 0x5e, 0x77, 0x36, 0x6a, 0xc5, 0xcc, 0xf6, 0x53,
+	0xd3, 0x4a, 0xd7, 0x15, 0x51, 0x0e, 0x0a, 0xc3, 0x6e, 0x92, 0x71, 0x3e, 0x25, 0xdc, 0xa1, 0x4f,
+	0xf0, 0xc7, 0x67, 0x07, 0x1e, 0xd3, 0x22, 0x88, 0x73, 0x0a, 0xdc, 0x3b, 0x97, 0xa4, 0x25, 0x8a,
+	0x1b, 0x4e, 0x6e, 0x1a, 0xcd, 0x40, 0xaa, 0xd1, 0xf4, 0x8d, 0x6d, 0x6c, 0x88, 0x1b, 0xdb, 0x8f,
+	0x93, 0xb1, 0xcd, 0xde, 0x1a, 0x15, 0x23, 0xdf, 0x18, 0x4f, 0xaf, 0xbe, 0x6b, 0x1a, 0x04, 0x26,
+	0x1e, 0xf3, 0xa5, 0xec, 0x7a, 0xe2, 0x17, 0x06, 0x5a, 0x68, 0x5f, 0xca, 0xe5, 0x79, 0x59, 0x0c,
+	0x26, 0x0e, 0x76, 0x0d, 0xc7, 0x62, 0x95, 0xc6, 0x2c, 0x54, 0x02, 0x87, 0x4b, 0x75, 0x6d, 0x45,
+	0x02, 0x40, 0xe3, 0x38, 0xbf, 0x5c, 0x22, 0x8d, 0xbe, 0x3d, 0x2e, 0xf8, 0x8b, 0x1d, 0x23, 0x5b,
+	0x49, 0x6e, 0xba, 0x91, 0x14, 0x3e, 0x0e, 0x18, 0x68, 0x24, 0xda, 0xbd, 0xe9, 0x46, 0x26, 0x83,
+	0x62, 0x04, 0x40, 0x52, 0xb2, 0x6f, 0x93, 0x4a, 0xe2, 0xbb, 0x05, 0x45, 0x26, 0x1a, 0x14, 0xb5,
+	0x52, 0x69, 0x61, 0x3a, 0x06, 0x46, 0xc3, 0x7e, 0x02, 0x6f, 0x52, 0x6b, 0xd2, 0xea, 0x25, 0x2e,
+	0x3f, 0x6b, 0x31, 0xb0, 0x52, 0xe7, 0x4f, 0xc6, 0x72, 0xce, 0x08, 0x75, 0x28, 0xa3, 0x95, 0x04,
+	0xa7, 0x78, 0x39, 0xa2, 0xeb, 0xde, 0x5d, 0x21, 0x14, 0x29, 0x3e, 0x74, 0x5d, 0x41, 0xc0, 0xc0,
+	0x92, 0x75, 0x56, 0x7a, 0xeb, 0x58, 0xa7, 0xd4, 0x5f, 0x87, 0x43, 0xc0, 0xc0, 0xb2, 0xdf, 0x41,
+	0x46, 0xbc, 0x8e, 0xdb, 0x56, 0x4e, 0xb9, 0x4f, 0x20, 0x03, 0x9a, 0x67, 0x25, 0x0f, 0xee, 0x4d,
+	0x4e, 0xa8, 0x0e, 0xb1, 0x22, 0x10, 0xb8, 0xf6, 0xaf, 0x5b, 0x64, 0xbc, 0x19, 0x76, 0x3a, 0x61,
+	0xc0, 0xaf, 0xb2, 0xe2, 0x5e, 0x7e, 0xfb, 0xb0, 0x44, 0x96, 0xa9, 0x59, 0x83, 0x18, 0xbf, 0x98,
+	0xab, 0x10, 0x4a, 0x13, 0x04, 0xa9, 0x5e, 0x99, 0x7c, 0xaa, 0xba, 0x0b, 0x9f, 0xfa, 0x0d, 0x8b,
+	0x9c, 0xe4, 0x75, 0x8d, 0x1b, 0xb6, 0x88, 0x16, 0x0c, 0x0f, 0xf9, 0xb3, 0xfa, 0x94, 0x0e, 0x4a,
+	0xf1, 0xda, 0x07, 0x87, 0xfe, 0x4e, 0xda, 0x57, 0xc8, 0xc9, 0xf5, 0x30, 0x6a, 0x52, 0x73, 0x20,
+	0x04, 0x93, 0x55, 0x0d, 0x5d, 0xce, 0x22, 0x40, 0x7f, 0x1d, 0xfb, 0x26, 0x79, 0xcc, 0x28, 0x34,
+	0xc7, 0x81, 0xf3, 0xd9, 0xa7, 0x44, 0x6b, 0x8f, 0x5d, 0xce, 0xc5, 0x82, 0x01, 0xb5, 0xd3, 0x2c,
+	0xad, 0x3e, 0x04, 0x4b, 0x7b, 0x99, 0x9c, 0x6d, 0xf6, 0x8f, 0xcc, 0x56, 0xdc, 0x5b, 0x8b, 0x39,
+	0xd7, 0xad, 0xcd, 0xfc, 0x90, 0x68, 0xe0, 0xec, 0xec, 0x20, 0x44, 0x18, 0xdc, 0x86, 0xfd, 0x61,
+	0x52, 0x8b, 0x28, 0x9b, 0x95, 0x58, 0x84, 0xce, 0x1d, 0x50, 0xf3, 0xa0, 0xa5, 0x69, 0xde, 0xac,
+	0x3e, 0x47, 0x44, 0x41, 0x0c, 0x8a, 0xa2, 0x7d, 0x87, 0x8c, 0x76, 0xd1, 0x00, 0x21, 0x02, 0xe6,
+	0x0e, 0xac, 0x27, 0x57, 0xc4, 0x99, 0x59, 0xc3, 0x08, 0xb1, 0xe7, 0x44, 0x40, 0x52, 0x43, 0xc9,
+	0xaa, 0x19, 0x76, 0xba, 0x61, 0x40, 0x83, 0x44, 0xb2, 0xfc, 0x09, 0x6e, 0x7b, 0x90, 0xa5, 0x60,
+	0x60, 0xa0, 0xf5, 0x89, 0xe9, 0xe1, 0x6e, 0x79, 0xc9, 0x06, 0xea, 0xae, 0xe5, 0xfd, 0x74, 0x22,
+	0x6d, 0x7d, 0x5a, 0xc8, 0xc1, 0x81, 0xdc, 0x9a, 0xd9, 0xc3, 0xea, 0xf8, 0xfe, 0x0e, 0xab, 0x13,
+	0xbb, 0x1f, 0x56, 0xe7, 0x7e, 0x8a, 0x9c, 0xec, 0x63, 0x1a, 0x7b, 0x52, 0xb6, 0xcd, 0x91, 0xc7,
+	0xf2, 0xb7, 0xe7, 0x9e, 0x54, 0x6e, 0xff, 0x28, 0xe3, 0x73, 0x6d, 0x5c, 0x3f, 0x86, 0x50, 0xdf,
+	0xba, 0xa4, 0x4c, 0x83, 0x2d, 0x71, 0x5a, 0x5d, 0x3e, 0xd8, 0x2a, 0xb9, 0x14, 0x6c, 0x71, 0xee,
+	0xc2, 0x74, 0x54, 0x97, 0x82, 0x2d, 0xc0, 0xb6, 0xed, 0x2f, 0x5a, 0x29, 0xf1, 0x99, 0x2b, 0x7d,
+	0x5f, 0x3a, 0x94, 0xfb, 0xd6, 0xd0, 0x12, 0xb5, 0xf3, 0x6f, 0x4a, 0xe4, 0xfc, 0x6e, 0x8d, 0x0c,
+	0x31, 0x7c, 0x4f, 0xa3, 0xd3, 0x37, 0x7a, 0x51, 0x08, 0xf6, 0x3f, 0x86, 0xbb, 0x82, 0xfb, 0x55,
+	0xbc, 0x0c, 0x02, 0x64, 0xfb, 0xa4, 0xdc, 0x71, 0xbb, 0x42, 0x17, 0x38, 0x7f, 0xd0, 0xd8, 0x34,
+	0xfc, 0xed, 0xfa, 0x8b, 0x6e, 0x97, 0x2f, 0x4f, 0xa3, 0x00, 0x90, 0x8c, 0x9d, 0x90, 0xaa, 0x1b,
+	0x45, 0xae, 0x34, 0xd9, 0x5f, 0x2b, 0x86, 0xde, 0x34, 0x36, 0xc9, 0x2d, 0x9e, 0xa9, 0x22, 0xe0,
+	0xc4, 0x9c, 0xcf, 0x8c, 0xa6, 0x02, 0x99, 0x98, 0x1f, 0x46, 0x4c, 0x46, 0x84, 0x0a, 0xd0, 0x2a,
+	0x3a, 0x24, 0x90, 0x35, 0xcb, 0x6f, 0xd7, 0xfc, 0x7f, 0x10, 0xa4, 0xec, 0x4f, 0x5b, 0x2c, 0xab,
+	0x81, 0x8c, 0x0e, 0x6b, 0x94, 0x0a, 0x76, 0x19, 0x30, 0x93, 0x2c, 0x98, 0xb9, 0x12, 0x64, 0x21,
+	0x98, 0xd4, 0x45, 0x76, 0x12, 0x26, 0xcb, 0xf7, 0x67, 0x27, 0xc1, 0x62, 0x90, 0x70, 0xfb, 0x6e,
+	0x8e, 0xbf, 0x45, 0x01, 0x91, 0xf1, 0x43, 0x78, 0x58, 0x7c, 0xcd, 0x22, 0x27, 0xbd, 0xac, 0xe1,
+	0xbc, 0x51, 0x2d, 0xc2, 0xa3, 0x67, 0xb0, 0x5d, 0x5e, 0x09, 0x0e, 0x7d, 0x20, 0xe8, 0xef, 0x8c,
+	0xdd, 0x22, 0x15, 0x2f, 0x58, 0x0f, 0x85, 0xb8, 0x34, 0x73, 0xb0, 0x4e, 0xcd, 0x07, 0xeb, 0xa1,
+	0xde, 0xcd, 0xf8, 0x0b, 0x58, 0xeb, 0xf6, 0x02, 0x39, 0x2d, 0x63, 0x59, 0xae, 0x7a, 0x31, 0x6a,
+	0x52, 0x16, 0xbc, 0x8e, 0x97, 0x30, 0x51, 0xa7, 0x3c, 0xd3, 0xc0, 0x93, 0x08, 0x72, 0xe0, 0x90,
+	0x5b, 0xcb, 0x7e, 0x95, 0x8c, 0x4a, 0x63, 0x75, 0xad, 0x88, 0xdb, 0x74, 0xff, 0xfa, 0x57, 0x8b,
+	0x89, 0xff, 0x8e, 0x41, 0x12, 0x74, 0x3e, 0x3f, 0x46, 0x4e, 0x4e, 0xef, 0x6c, 0x40, 0xb7, 0x8e,
+	0xda, 0x80, 0x8e, 0x57, 0xa3, 0x58, 0xdb, 0xbe, 0x0b, 0x58, 0xdb, 0x82, 0xaa, 0xb6, 0x6b, 0xa2,
+	0x95, 0x9b, 0xd1, 0xb0, 0x23, 0x32, 0xb2, 0x41, 0x5d, 0x3f, 0xd9, 0x28, 0xc6, 0x04, 0x73, 0x95,
+	0xb5, 0x95, 0x0d, 0x40, 0xe3, 0xa5, 0x20, 0x28, 0xd9, 0x77, 0xc9, 0xe8, 0x06, 0x5f, 0x00, 0xe2,
+	0xb6, 0xb2, 0x78, 0xd0, 0xc1, 0x4d, 0xad, 0x2a, 0x3d, 0xdd, 0xa2, 0x00, 0x24, 0x39, 0xe6, 0xac,
+	0x65, 0xb8, 0x93, 0xf0, 0xad, 0x5b, 0x5c, 0xec, 0xdd, 0xf0, 0xbe, 0x24, 0x1f, 0x22, 0xe3, 0x11,
+	0x6d, 0x86, 0x41, 0xd3, 0xf3, 0x69, 0x6b, 0x5a, 0x9a, 0x57, 0xf6, 0x12, 0x72, 0xc5, 0xb4, 0x17,
+	0x60, 0xb4, 0x01, 0xa9, 0x16, 0xed, 0x4f, 0x59, 0x64, 0x42, 0x85, 0x61, 0xe3, 0x84, 0x50, 0xa1,
+	0x46, 0x5f, 0x28, 0x28, 0xe8, 0x9b, 0xb5, 0x39, 0x63, 0xa3, 0x92, 0x2a, 0x5d, 0x06, 0x19, 0xba,
+	0xf6, 0x07, 0x08, 0x09, 0xd7, 0xb8, 0x47, 0xd6, 0x74, 0xd2, 0xa8, 0xed, 0xf9, 0x53, 0x27, 0x78,
+	0xe8, 0xa6, 0x6c, 0x01, 0x8c, 0xd6, 0xec, 0x6b, 0x84, 0xf0, 0x6d, 0x83, 0x46, 0xaf, 0x46, 0x3d,
+	0x15, 0x33, 0x47, 0x56, 0x14, 0xe4, 0xc1, 0xbd, 0xc9, 0x7e, 0x1d, 0x27, 0x02, 0xc0, 0xa8, 0x6e,
+	0xff, 0x2c, 0x19, 0x8d, 0x7b, 0x9d, 0x8e, 0xab, 0x34, 0xee, 0x05, 0x06, 0x83, 0xf2, 0x76, 0x0d,
+	0x56, 0xc4, 0x0b, 0x40, 0x52, 0xb4, 0x6f, 0x23, 0x53, 0x8d, 0x85, 0xf2, 0x95, 0xed, 0x22, 0xf6,
+	0xbf, 0xd0, 0x3c, 0xbd, 0x53, 0x8a, 0xf8, 0x90, 0x83, 0x83, 0x0e, 0x1f, 0xe9, 0xf2, 0x85, 0x90,
+	0x93, 0x85, 0xdc, 0x36, 0xed, 0xe7, 0xc9, 0x98, 0xfe, 0x6c, 0x99, 0x2c, 0xe4, 0x2d, 0x3a, 0x2b,
+	0x13, 0x2b, 0x1e, 0x3c, 0x66, 0x66, 0x65, 0x7b, 0x91, 0x9c, 0x6a, 0x86, 0x41, 0x12, 0x85, 0xbe,
+	0xcf, 0xb3, 0x92, 0xf1, 0xdb, 0x25, 0xd7, 0xc8, 0xbf, 0x51, 0x74, 0xfb, 0xd4, 0x6c, 0x3f, 0x0a,
+	0xe4, 0xd5, 0x73, 0x82, 0xb4, 0x75, 0x4c, 0x0c, 0xce, 0x3b, 0xc8, 0x38, 0xba, 0x90, 0x47, 0x81,
+	0xeb, 0xdf, 0x80, 0x05, 0xa9, 0x8b, 0x66, 0x7b, 0xe0, 0x92, 0x51, 0x0e, 0x29, 0x2c, 0x0c, 0x39,
+	0x16, 0x2a, 0x15, 0x23, 0xe4, 0x98, 0xab, 0x54, 0xa4, 0x02, 0xc5, 0xf9, 0xc7, 0x95, 0x94, 0x40,
+	0xf6, 0x50, 0x6c, 0x71, 0x2c, 0xb7, 0x8d, 0x4c, 0x02, 0xc4, 0x00, 0x8d, 0x52, 0xe1, 0x94, 0x55,
+	0x6e, 0x9b, 0x25, 0x93, 0x10, 0xa4, 0xe9, 0xda, 0x9b, 0xa4, 0xba, 0x11, 0xc6, 0x89, 0xbc, 0x7e,
+	0x1c, 0xf0, 0xa6, 0x73, 0x35, 0x8c, 0x13, 0x26, 0x45, 0xa8, 0xcf, 0xc6, 0x92, 0x18, 0x38, 0x0d,
+	0xbc, 0x83, 0xc6, 0x1b, 0x6e, 0xd4, 0x8a, 0x67, 0x59, 0x82, 0x80, 0x0a, 0x13, 0x1f, 0x94, 0xb0,
+	0xb8, 0xa2, 0x41, 0x60, 0xe2, 0xd9, 0x9f, 0x60, 0x09, 0xc2, 0x7c, 0x9a, 0xc8, 0xc1, 0xaa, 0x16,
+	0x11, 0xa2, 0xaf, 0x6c, 0xe2, 0x74, 0xdd, 0xcc, 0x0a, 0xa6, 0xc9, 0x40, 0x8a, 0xa8, 0xf3, 0xa7,
+	0x56, 0xca, 0x6c, 0x72, 0x8b, 0xf9, 0x9c, 0x6f, 0xd1, 0x00, 0x79, 0x92, 0xe9, 0xe5, 0xf6, 0x13,
+	0x99, 0x08, 0xde, 0x37, 0x0f, 0xca, 0x18, 0x78, 0x07, 0x5b, 0x98, 0x62, 0x4d, 0x18, 0x0e, 0x71,
+	0x1f, 0xb3, 0xd2, 0xa1, 0xd8, 0xa5, 0x22, 0x6e, 0x47, 0x46, 0xbf, 0x77, 0x8f, 0xea, 0x76, 0xbe,
+	0x68, 0x91, 0xd1, 0x19, 0xb7, 0xb9, 0x19, 0xae, 0xaf, 0xa3, 0x9e, 0xbe, 0xd5, 0x8b, 0xcc, 0xa8,
+	0x70, 0xa5, 0x5f, 0x99, 0x13, 0xe5, 0xa0, 0x30, 0x70, 0x03, 0xae, 0xbb, 0x4d, 0x99, 0x94, 0xa0,
+	0xcc, 0x37, 0xe0, 0x65, 0x56, 0x02, 0x02, 0x82, 0x8b, 0xa0, 0xe3, 0xde, 0x95, 0x95, 0xb3, 0x36,
+	0x9b, 0x45, 0x0d, 0x02, 0x13, 0xcf, 0xf9, 0x17, 0x16, 0x69, 0xcc, 0xb8, 0xb1, 0xd7, 0xc4, 0x2c,
+	0x8a, 0x33, 0x5e, 0xb2, 0xd6, 0x6b, 0x6e, 0xd2, 0x84, 0x27, 0xaf, 0xc0, 0x5e, 0xf6, 0x62, 0x1a,
+	0x19, 0x97, 0x52, 0xd5, 0xcb, 0x1b, 0xa2, 0x1c, 0x14, 0x86, 0xfd, 0x2a, 0x19, 0x43, 0x4b, 0xc7,
+	0x9d, 0x30, 0x6a, 0x01, 0x5d, 0x2f, 0x26, 0xbd, 0xcd, 0x0a, 0x6d, 0x46, 0x34, 0xc1, 0xb5, 0xc4,
+	0xfd, 0x1b, 0x74, 0xfb, 0x60, 0x12, 0x73, 0x7e, 0xc9, 0x22, 0xa7, 0x67, 0xa8, 0x1b, 0xd1, 0x88,
+	0x65, 0xc3, 0x51, 0x1f, 0x62, 0xbf, 0x42, 0x6a, 0x09, 0x96, 0x60, 0x8f, 0xac, 0x62, 0x7b, 0xc4,
+	0x3c, 0x13, 0x56, 0x45, 0xe3, 0xa0, 0xc8, 0x38, 0x9f, 0xb3, 0xc8, 0xd9, 0xbc, 0xbe, 0xcc, 0xfa,
+	0x61, 0xaf, 0xf5, 0x30, 0x3a, 0xf4, 0xd7, 0x2d, 0x32, 0xce, 0xac, 0xbd, 0x73, 0x34, 0x71, 0x3d,
+	0xbf, 0x2f, 0x13, 0x9f, 0x35, 0x64, 0x26, 0xbe, 0xf3, 0xa4, 0xb2, 0x11, 0x76, 0x68, 0xd6, 0x53,
+	0xe1, 0x6a, 0x88, 0xfa, 0x09, 0x84, 0xa0, 0x5a, 0xab, 0xe3, 0x7a, 0x41, 0xe2, 0xe2, 0x76, 0x94,
+	0x1a, 0xf8, 0xe3, 0x7c, 0x01, 0xaa, 0x62, 0x30, 0x71, 0x9c, 0x7f, 0x5e, 0x27, 0xa3, 0xc2, 0xad,
+	0x66, 0xe8, 0x64, 0x2a, 0x52, 0x51, 0x52, 0x1a, 0xa8, 0x28, 0x89, 0xc9, 0x48, 0x93, 0xa5, 0x04,
+	0x6d, 0x94, 0x8b, 0x50, 0x4b, 0x88, 0x0e, 0xf2, 0x2c, 0xa3, 0xba, 0x5b, 0xfc, 0x37, 0x08, 0x52,
+	0xf6, 0x17, 0x2c, 0x72, 0xbc, 0x19, 0x06, 0x01, 0x6d, 0x6a, 0x61, 0xb1, 0x52, 0x84, 0xbb, 0xcd,
+	0x6c, 0xba, 0x51, 0x6d, 0x6a, 0xcc, 0x00, 0x20, 0x4b, 0xde, 0x7e, 0x37, 0x39, 0xc6, 0xc7, 0xec,
+	0x66, 0xca, 0x6c, 0xa0, 0x13, 0xb4, 0x99, 0x40, 0x48, 0xe3, 0xa2, 0x76, 0x35, 0xd0, 0xa9, 0xd0,
+	0x46, 0xb4, 0x76, 0xd5, 0x48, 0x82, 0x66, 0x60, 0x60, 0x1a, 0x84, 0x88, 0xae, 0x47, 0x34, 0xde,
+	0x10, 0x6e, 0x47, 0x4c, 0x50, 0x1d, 0xdd, 0x5f, 0x1a, 0x04, 0xe8, 0x6b, 0x09, 0x72, 0x5a, 0xb7,
+	0x37, 0xc5, 0x4d, 0xbd, 0x56, 0x04, 0x3f, 0x17, 0xd3, 0x3c, 0xf0, 0xc2, 0x3e, 0x49, 0xaa, 0xec,
+	0x00, 0x65, 0x02, 0x72, 0x99, 0x87, 0xde, 0xb1, 0xe3, 0x15, 0x78, 0xb9, 0x3d, 0x47, 0x4e, 0x64,
+	0xd2, 0xcb, 0xc5, 0x42, 0xbd, 0xaf, 0xc2, 0xac, 0x32, 0x89, 0xe9, 0x62, 0xe8, 0xab, 0x61, 0x6a,
+	0x71, 0xc6, 0x76, 0xd1, 0xe2, 0x6c, 0x2b, 0xe7, 0x56, 0xae, 0x78, 0x7f, 0xa1, 0x90, 0x01, 0x18,
+	0xca, 0x93, 0xf5, 0xb3, 0x19, 0x4f, 0xd6, 0x63, 0xe7, 0xcb, 0x07, 0xf7, 0xe6, 0x90, 0x1d, 0xd8,
+	0xbb, 0xdb, 0xea, 0xc3, 0x74, 0x43, 0xfd, 0x9f, 0x16, 0x91, 0xf3, 0x3a, 0xeb, 0x36, 0x37, 0x28,
+	0x2e, 0x19, 0xf4, 0xda, 0x52, 0xba, 0x08, 0x2e, 0x98, 0x59, 0x6c, 0xd5, 0x28, 0x9f, 0x04, 0x48,
+	0x41, 0x21, 0x83, 0x8d, 0x46, 0x26, 0x1c, 0x27, 0x5e, 0x95, 0x9f, 0xfb, 0x4a, 0xdf, 0x31, 0xbd,
+	0x3c, 0x2f, 0x6a, 0x69, 0x1c, 0x3b, 0x24, 0x27, 0x7d, 0x37, 0x4e, 0x58, 0x0f, 0x50, 0x35, 0xb1,
+	0xcf, 0x24, 0x24, 0x2c, 0x96, 0x67, 0x21, 0xdb, 0x10, 0xf4, 0xb7, 0xed, 0xfc, 0xdb, 0x2a, 0x39,
+	0x96, 0xe2, 0x8c, 0x7b, 0x14, 0x18, 0xde, 0x4a, 0x6a, 0xf2, 0x0c, 0xcf, 0x66, 0x5b, 0x52, 0x07,
+	0xbd, 0xc2, 0xc0, 0x43, 0x6b, 0x4d, 0x9f, 0xaa, 0x59, 0x01, 0xc7, 0x38, 0x70, 0xc1, 0xc4, 0x63,
+	0x4c, 0x39, 0xf1, 0xe3, 0x59, 0xdf, 0xa3, 0x41, 0xc2, 0xbb, 0x59, 0x0c, 0x53, 0x5e, 0x5d, 0x58,
+	0x31, 0x1b, 0xd5, 0x4c, 0x39, 0x03, 0x80, 0x2c, 0x79, 0xfb, 0x2f, 0x59, 0xe4, 0x98, 0x7b, 0x27,
+	0xd6, 0x79, 0xab, 0x1b, 0xd5, 0x22, 0x0e, 0xa9, 0x54, 0x2a, 0x6c, 0xae, 0x3b, 0x4f, 0x15, 0x41,
+	0x9a, 0x28, 0xc6, 0x25, 0xd8, 0xf4, 0x2e, 0x6d, 0x4a, 0xaf, 0x5a, 0xd1, 0x97, 0x91, 0x22, 0xae,
+	0xec, 0x97, 0xfa, 0xda, 0xe5, 0x5c, 0xbd, 0xbf, 0x1c, 0x72, 0xfa, 0x60, 0x3f, 0x4f, 0xec, 0x96,
+	0x17, 0xbb, 0x6b, 0x3e, 0x1a, 0x5f, 0x65, 0xfc, 0xa9, 0x30, 0x01, 0x9f, 0x13, 0xe3, 0x6c, 0xcf,
+	0xf5, 0x61, 0x40, 0x4e, 0x2d, 0xb6, 0xca, 0xa2, 0xf0, 0xee, 0xf6, 0x8d, 0xc8, 0x6f, 0xd4, 0x32,
+	0xab, 0x4c, 0x94, 0x83, 0xc2, 0x70, 0xfe, 0xac, 0xac, 0xb6, 0xb2, 0x76, 0x21, 0x77, 0x0d, 0x57,
+	0x56, 0x6b, 0xff, 0xae, 0xac, 0x8a, 0x6e, 0x4e, 0x54, 0x75, 0x2a, 0x08, 0xb3, 0xf4, 0x90, 0x82,
+	0x30, 0x7f, 0xde, 0x4a, 0x65, 0x34, 0x1b, 0xbb, 0xf8, 0x81, 0x62, 0xdd, 0xd7, 0xa7, 0xb8, 0x9b,
+	0x50, 0xe6, 0x5c, 0xc9, 0x78, 0x87, 0xbd, 0x95, 0xd4, 0xd6, 0x7d, 0x97, 0xe5, 0xe1, 0x68, 0x54,
+	0xd2, 0x2e, 0x4c, 0x97, 0x45, 0x39, 0x28, 0x0c, 0xe4, 0xfa, 0x46, 0xa3, 0x7b, 0xe2, 0xda, 0xff,
+	0xa1, 0x4c, 0xc6, 0x8c, 0x13, 0x3f, 0x57, 0x7c, 0xb3, 0x1e, 0x31, 0xf1, 0xad, 0xb4, 0x07, 0xf1,
+	0xed, 0xe7, 0x48, 0xbd, 0x29, 0x4f, 0xa3, 0x62, 0x32, 0xb4, 0x67, 0xcf, 0x38, 0x7d, 0x20, 0xa9,
+	0x22, 0xd0, 0x34, 0xd1, 0x8f, 0xc3, 0x68, 0x26, 0xa5, 0x9d, 0xc8, 0x8b, 0xc4, 0x13, 0x27, 0x5a,
+	0x7f, 0x9d, 0xac, 0xb5, 0xbc, 0xba, 0xbb, 0xb5, 0x1c, 0x13, 0x66, 0xca, 0xc9, 0x3d, 0x82, 0x8c,
+	0x2e, 0xb7, 0xd3, 0x19, 0x5d, 0x2e, 0x15, 0x32, 0xcc, 0x03, 0x52, 0xb9, 0x5c, 0x27, 0xa3, 0x68,
+	0xc6, 0x77, 0x83, 0x96, 0xfd, 0xc3, 0x64, 0xb4, 0xc9, 0xff, 0x15, 0x9a, 0x3c, 0x66, 0x0f, 0x16,
+	0x50, 0x90, 0x30, 0xf4, 0xdb, 0x72, 0xa3, 0xb6, 0xd4, 0xde, 0x31, 0xbf, 0xad, 0xe9, 0xa8, 0x1d,
+	0x03, 0x2b, 0x75, 0xfe, 0x61, 0x85, 0x30, 0x77, 0x09, 0x37, 0xa2, 0xad, 0xd5, 0x90, 0x25, 0x56,
+	0x3d, 0x54, 0x2b, 0xaa, 0xbe, 0xd4, 0x3d, 0xca, 0x96, 0x54, 0xc3, 0x9a, 0x56, 0x3e, 0x62, 0x6b,
+	0xda, 0x00, 0x03, 0x69, 0xe5, 0x11, 0x32, 0x90, 0x3a, 0x9f, 0xb1, 0x88, 0xad, 0x7c, 0x6c, 0xb4,
+	0x07, 0xc3, 0x05, 0x52, 0x57, 0xde, 0x36, 0x42, 0x00, 0xd4, 0x2c, 0x42, 0x02, 0x40, 0xe3, 0x0c,
+	0x71, 0x93, 0x7f, 0x5a, 0xf2, 0xef, 0x72, 0xda, 0x7d, 0x9d, 0x71, 0x7d, 0xc1, 0xce, 0x9d, 0xdf,
+	0x29, 0x91, 0xc7, 0xb8, 0xe8, 0xb0, 0xe8, 0x06, 0x6e, 0x9b, 0x76, 0xb0, 0x57, 0xc3, 0xfa, 0xa4,
+	0x34, 0xf1, 0x0a, 0xe9, 0x49, 0x77, 0xf4, 0x83, 0xee, 0x5d, 0xbe, 0xe7, 0xf8, 0x2e, 0x9b, 0x0f,
+	0xbc, 0x04, 0x58, 0xe3, 0x76, 0x4c, 0x6a, 0xf2, 0xf9, 0x92, 0x46, 0xb9, 0x48, 0x42, 0x8a, 0x2d,
+	0x89, 0x53, 0x96, 0x82, 0x22, 0x84, 0x47, 0xa9, 0x1f, 0x36, 0x37, 0x81, 0x76, 0xc3, 0xec, 0x51,
+	0xba, 0x20, 0xca, 0x41, 0x61, 0x38, 0x1d, 0x72, 0x5c, 0x8e, 0x61, 0x17, 0x33, 0xa2, 0xd2, 0x75,
+	0x3c, 0x7f, 0x9a, 0xb2, 0xc8, 0x78, 0x51, 0x45, 0x9d, 0x3f, 0xb3, 0x26, 0x10, 0xd2, 0xb8, 0x32,
+	0xd7, 0x6a, 0x29, 0x3f, 0xd7, 0xaa, 0xf3, 0x3b, 0x16, 0xc9, 0x1e, 0x80, 0x46, 0x66, 0x49, 0x6b,
+	0xc7, 0xcc, 0x92, 0x7b, 0xc8, 0xcd, 0xf8, 0x33, 0x64, 0xcc, 0x4d, 0x50, 0xc2, 0xe1, 0xda, 0x88,
+	0xf2, 0xfe, 0xcc, 0x66, 0x8b, 0x61, 0xcb, 0x5b, 0xf7, 0xb0, 0x05, 0x30, 0x9b, 0x73, 0xfe, 0xa2,
+	0x42, 0x4e, 0xf6, 0xc5, 0x8a, 0xd9, 0xcf, 0x91, 0x71, 0x35, 0x14, 0x52, 0xcf, 0x57, 0x37, 0x1d,
+	0x3c, 0x35, 0x0c, 0x52, 0x98, 0x43, 0xec, 0x87, 0x79, 0x72, 0x2a, 0x42, 0xfd, 0x47, 0x8f, 0x4e,
+	0xaf, 0x27, 0x34, 0x5a, 0xa1, 0x68, 0x0e, 0xe5, 0xf9, 0x4f, 0xcb, 0x33, 0x8f, 0xa3, 0x8d, 0x08,
+	0xfa, 0xc1, 0x90, 0x57, 0xc7, 0xee, 0x92, 0x63, 0xbe, 0x29, 0xa0, 0x36, 0x2a, 0xfb, 0x97, 0x6d,
+	0xd5, 0x92, 0x48, 0x15, 0x43, 0x9a, 0x40, 0x5a, 0xca, 0xad, 0x3e, 0x24, 0x29, 0xf7, 0x13, 0x5a,
+	0xca, 0xe5, 0xfe, 0x1d, 0x1f, 0x2c, 0x38, 0x56, 0x70, 0x18, 0x31, 0xf7, 0x20, 0x82, 0xeb, 0x0b,
+	0xa4, 0x26, 0x7d, 0xdf, 0x86, 0xf2, 0x19, 0x33, 0xdb, 0x19, 0xc0, 0x40, 0x9f, 0x21, 0x6f, 0xba,
+	0x14, 0x45, 0xc6, 0x60, 0x5e, 0x0f, 0x93, 0x69, 0xdf, 0x0f, 0xef, 0xa0, 0x4c, 0x70, 0x23, 0xa6,
+	0x42, 0xf1, 0xe4, 0x3c, 0x28, 0x91, 0x9c, 0x3b, 0x1c, 0xee, 0x47, 0x2d, 0x88, 0xa4, 0xf6, 0xe3,
+	0xde, 0x84, 0x11, 0xfb, 0x2e, 0xf7, 0x0f, 0xe4, 0x47, 0xee, 0xfb, 0x8b, 0xbe, 0x83, 0x6a, 0x97,
+	0x41, 0xc5, 0x8e, 0x94, 0xdb, 0xe0, 0x45, 0x42, 0xb4, 0xfc, 0x28, 0x02, 0x58, 0x94, 0xfb, 0x81,
+	0x16, 0x33, 0xc1, 0xc0, 0x42, 0x95, 0x84, 0x17, 0xc4, 0x89, 0xeb, 0xfb, 0x57, 0xbd, 0x20, 0x11,
+	0xba, 0x55, 0x25, 0x5b, 0xcc, 0x6b, 0x10, 0x98, 0x78, 0xe7, 0xde, 0x69, 0xcc, 0xdf, 0x5e, 0xe6,
+	0x7d, 0x83, 0x9c, 0xbd, 0xe2, 0x25, 0x2a, 0xec, 0x4a, 0xad, 0x37, 0x14, 0x0f, 0x55, 0x18, 0xa1,
+	0x35, 0x30, 0x8c, 0xd0, 0x08, 0x7b, 0x2a, 0xa5, 0xa3, 0xb4, 0xb2, 0x61, 0x4f, 0xce, 0x73, 0xe4,
+	0xf4, 0x15, 0x2f, 0xc1, 0x90, 0x92, 0x3d, 0x12, 0x71, 0x7e, 0x7b, 0x84, 0x8c, 0x9b, 0x01, 0xc4,
+	0x7b, 0x89, 0x84, 0xc4, 0xa4, 0x15, 0x32, 0x64, 0xce, 0x53, 0xc6, 0xdb, 0x5b, 0x07, 0x8e, 0x66,
+	0xce, 0x1f, 0x31, 0x43, 0x08, 0xd4, 0x34, 0xc1, 0xec, 0x80, 0x7d, 0x87, 0x54, 0xd7, 0x59, 0x58,
+	0x4e, 0xb9, 0x08, 0x0f, 0x97, 0xbc, 0x11, 0xd5, 0xdb, 0x91, 0x07, 0xf6, 0x70, 0x7a, 0x78, 0x70,
+	0x47, 0xe9, 0x58, 0x4f, 0xc3, 0xfd, 0x9a, 0x97, 0x83, 0xc2, 0x18, 0x74, 0x24, 0x54, 0xf7, 0x71,
+	0x24, 0xa4, 0x18, 0xf4, 0xc8, 0x43, 0x62, 0xd0, 0x2c, 0xc4, 0x2a, 0xd9, 0x60, 0x62, 0xa5, 0x88,
+	0x17, 0x19, 0x65, 0x83, 0x60, 0x84, 0x58, 0xa5, 0xc0, 0x90, 0xc5, 0xb7, 0x3f, 0xaa, 0x58, 0x7c,
+	0xad, 0x08, 0xb5, 0xb4, 0xb9, 0xa2, 0x0f, 0x9b, 0xbb, 0x7f, 0xa6, 0x44, 0x26, 0xae, 0x04, 0xbd,
+	0xe5, 0x2b, 0xcb, 0xbd, 0x35, 0xdf, 0x6b, 0x5e, 0xa3, 0xdb, 0xc8, 0xc2, 0x37, 0xe9, 0xf6, 0xfc,
+	0x9c, 0xd8, 0x41, 0x6a, 0xcd, 0x5c, 0xc3, 0x42, 0xe0, 0x30, 0x64, 0x46, 0xeb, 0x5e, 0xd0, 0xa6,
+	0x51, 0x37, 0xf2, 0x84, 0xc6, 0xd8, 0x60, 0x46, 0x97, 0x35, 0x08, 0x4c, 0x3c, 0x6c, 0x3b, 0xbc,
+	0x13, 0xd0, 0x28, 0x2b, 0x5f, 0x2f, 0x61, 0x21, 0x70, 0x18, 0x22, 0x25, 0x51, 0x4f, 0x28, 0x64,
+	0x0c, 0xa4, 0x55, 0x2c, 0x04, 0x0e, 0xc3, 0x9d, 0x1e, 0xf7, 0xd6, 0x98, 0x03, 0x51, 0x26, 0x38,
+	0x65, 0x85, 0x17, 0x83, 0x84, 0x23, 0xea, 0x26, 0xdd, 0x9e, 0xc3, 0xcb, 0x78, 0x26, 0xde, 0xee,
+	0x1a, 0x2f, 0x06, 0x09, 0x67, 0x19, 0x5a, 0xd3, 0xc3, 0xf1, 0x7d, 0x97, 0xa1, 0x35, 0xdd, 0xfd,
+	0x01, 0xd7, 0xfa, 0x5f, 0xb3, 0xc8, 0xb8, 0xe9, 0xf6, 0x67, 0xb7, 0x33, 0xb2, 0xf0, 0x52, 0x5f,
+	0x82, 0xef, 0xf7, 0xe4, 0x3d, 0x7e, 0xd9, 0xf6, 0x92, 0xb0, 0x1b, 0x3f, 0x4b, 0x83, 0xb6, 0x17,
+	0x50, 0xe6, 0x10, 0xc1, 0xdd, 0x05, 0x53, 0x3e, 0x85, 0xb3, 0x61, 0x8b, 0xee, 0x43, 0x98, 0x76,
+	0x6e, 0x91, 0x93, 0x7d, 0x41, 0x96, 0x43, 0x88, 0x20, 0xbb, 0x86, 0xb8, 0x3b, 0x40, 0xc6, 0xb0,
+	0x61, 0x99, 0x25, 0x6c, 0x96, 0x9c, 0xe4, 0x1b, 0x09, 0x29, 0xad, 0xe0, 0x93, 0x91, 0x2a, 0x70,
+	0x96, 0x99, 0x27, 0x6e, 0x66, 0x81, 0xd0, 0x8f, 0x8f, 0x4f, 0x41, 0x1c, 0x4b, 0xc5, 0xbd, 0x16,
+	0x24, 0x2c, 0xb1, 0x9d, 0x16, 0x32, 0x2f, 0x54, 0xe6, 0x8a, 0x5f, 0x66, 0x87, 0xa9, 0xde, 0x69,
+	0x1a, 0x04, 0x26, 0x9e, 0xf3, 0xc5, 0x12, 0xa9, 0x49, 0x4f, 0x9e, 0x21, 0xba, 0xf2, 0x69, 0x8b,
+	0x1c, 0x53, 0x26, 0x21, 0xac, 0x23, 0x16, 0xe3, 0xf5, 0x83, 0xfb, 0x12, 0x29, 0x2d, 0x00, 0xea,
+	0xf0, 0x94, 0xe4, 0x0e, 0x26, 0x31, 0x48, 0xd3, 0xb6, 0x6f, 0xa2, 0xbb, 0x78, 0x9c, 0xd0, 0x8e,
+	0xa1, 0x4d, 0x74, 0x8c, 0x1d, 0x37, 0xd5, 0x0c, 0x23, 0x8a, 0xfb, 0x0b, 0xbd, 0x7a, 0x56, 0x14,
+	0xa6, 0x16, 0xa1, 0x74, 0x19, 0x18, 0x2d, 0x39, 0x7f, 0xbf, 0x44, 0x4e, 0x64, 0xbb, 0x64, 0x7f,
+	0x10, 0xdd, 0x3a, 0xf5, 0xeb, 0x5a, 0x19, 0x0f, 0xa0, 0x71, 0x30, 0x60, 0x0f, 0xee, 0x4d, 0x4e,
+	0xf6, 0x3f, 0xa4, 0x3a, 0x65, 0xa2, 0x40, 0xaa, 0x31, 0x6e, 0x97, 0x13, 0x06, 0xe4, 0x99, 0xed,
+	0xe9, 0x6e, 0xb7, 0x51, 0xca, 0xda, 0xe5, 0x4c, 0x28, 0x64, 0xb0, 0x31, 0x86, 0xc8, 0x28, 0xb9,
+	0x4e, 0xbd, 0xf6, 0xc6, 0x5a, 0x18, 0xc9, 0x1b, 0xd8, 0x13, 0xda, 0xc1, 0xb0, 0x1f, 0x07, 0x72,
+	0x6b, 0xe2, 0x69, 0xdf, 0x74, 0xbb, 0x6e, 0xd3, 0x4b, 0xb6, 0x85, 0x7a, 0x54, 0xf1, 0xa6, 0x59,
+	0x51, 0x0e, 0x0a, 0xc3, 0x59, 0x24, 0x95, 0x21, 0x57, 0xd0, 0x50, 0x92, 0xff, 0x0b, 0xa4, 0x86,
+	0xcd, 0x49, 0xf1, 0xae, 0x88, 0x26, 0x43, 0x52, 0x93, 0xcf, 0x52, 0xd9, 0x0e, 0x29, 0x7b, 0xae,
+	0x34, 0x7d, 0xaa, 0xcf, 0x9a, 0x8f, 0xe3, 0x1e, 0xbb, 0x4c, 0x23, 0xd0, 0x7e, 0x9a, 0x94, 0xe9,
+	0xdd, 0x6e, 0xd6, 0xc6, 0x79, 0xe9, 0x6e, 0xd7, 0x8b, 0x68, 0x8c, 0x48, 0xf4, 0x6e, 0xd7, 0x3e,
+	0x47, 0x4a, 0x5e, 0x4b, 0x1c, 0x52, 0x44, 0xe0, 0x94, 0xe6, 0xe7, 0xa0, 0xe4, 0xb5, 0x9c, 0xbb,
+	0xa4, 0x2e, 0x09, 0x32, 0xd7, 0x3b, 0xce, 0xbb, 0xad, 0x22, 0x5c, 0xef, 0x64, 0xbb, 0x03, 0xb8,
+	0x76, 0x8f, 0x10, 0x1d, 0x34, 0x5b, 0x14, 0x7f, 0x39, 0x4f, 0x2a, 0xcd, 0x50, 0x24, 0x27, 0xa8,
+	0xe9, 0x66, 0x18, 0xd3, 0x66, 0x10, 0xe7, 0x16, 0x99, 0xb8, 0x16, 0x84, 0x77, 0xd8, 0x73, 0x15,
+	0x2c, 0x3b, 0x23, 0x36, 0xbc, 0x8e, 0xff, 0x64, 0x45, 0x04, 0x06, 0x05, 0x0e, 0x53, 0x79, 0xe3,
+	0x4a, 0x83, 0xf2, 0xc6, 0x39, 0x1f, 0xb3, 0xc8, 0xb8, 0x8a, 0xbe, 0xbb, 0xb2, 0xb5, 0x89, 0xed,
+	0xb6, 0xa3, 0xb0, 0xd7, 0xcd, 0xb6, 0xcb, 0x9e, 0xdc, 0x03, 0x0e, 0x33, 0xc3, 0x52, 0x4b, 0xbb,
+	0x84, 0xa5, 0x9e, 0x27, 0x95, 0x4d, 0x2f, 0x68, 0x65, 0x9f, 0x5e, 0xc2, 0xc7, 0xfb, 0x80, 0x41,
+	0xb0, 0x0b, 0x27, 0x54, 0x17, 0xe4, 0x81, 0xf0, 0x1c, 0x19, 0x5f, 0xeb, 0x79, 0x7e, 0x4b, 0xfc,
+	0xce, 0x6a, 0x54, 0x66, 0x0c, 0x18, 0xa4, 0x30, 0xf1, 0x5e, 0xb7, 0xe6, 0x05, 0x6e, 0xb4, 0xbd,
+	0xac, 0x4f, 0x20, 0xc5, 0x94, 0x66, 0x14, 0x04, 0x0c, 0x2c, 0xe7, 0xf3, 0x65, 0x32, 0x91, 0x8e,
+	0x41, 0x1c, 0xe2, 0x7a, 0xf5, 0x34, 0xa9, 0xb2, 0xb0, 0xc4, 0xec, 0xd4, 0xb2, 0xfa, 0xc0, 0x61,
+	0xe8, 0x97, 0xc4, 0x93, 0xb3, 0x14, 0xf3, 0x6c, 0x99, 0xea, 0xa4, 0xd2, 0xc3, 0x30, 0xd7, 0x40,
+	0x91, 0x0f, 0x46, 0x90, 0x42, 0x7b, 0xf3, 0x68, 0xd8, 0x35, 0xf3, 0x8d, 0xbd, 0xbf, 0xc8, 0xf8,
+	0x4c, 0x11, 0xb4, 0x25, 0x24, 0x62, 0x35, 0xf5, 0x72, 0x3a, 0x24, 0xe9, 0x73, 0xef, 0x22, 0xe3,
+	0x26, 0xe6, 0x6e, 0x42, 0x71, 0xcd, 0x14, 0x8a, 0x3f, 0x6d, 0x2e, 0x0a, 0x11, 0x81, 0x3a, 0xc4,
+	0x76, 0xbb, 0x41, 0xaa, 0x4d, 0xe5, 0x3f, 0xb1, 0xaf, 0x64, 0xc5, 0x2a, 0x5b, 0x0a, 0x36, 0x03,
+	0xbc, 0x35, 0x34, 0x2e, 0x4d, 0x18, 0xbd, 0x89, 0xe7, 0x5b, 0x76, 0x44, 0xca, 0xed, 0xad, 0x4d,
+	0x21, 0x8a, 0x3e, 0x5f, 0xd0, 0xf0, 0x5e, 0xd9, 0xda, 0xd4, 0x6b, 0xdc, 0x2c, 0x05, 0x24, 0x36,
+	0x84, 0xb2, 0x30, 0x15, 0xa8, 0x5c, 0xde, 0x3d, 0x50, 0xd9, 0xf9, 0x72, 0x89, 0x9c, 0xec, 0x5b,
+	0x54, 0xf6, 0xab, 0xa4, 0x1a, 0xe1, 0x57, 0x8a, 0xcf, 0x5b, 0x28, 0x2c, 0xb4, 0x38, 0x9e, 0x6f,
+	0xe9, 0x73, 0x37, 0x5d, 0x0e, 0x9c, 0x24, 0xba, 0x02, 0x68, 0x2f, 0x1f, 0xa5, 0xa9, 0xe4, 0x9f,
+	0xac, 0x5c, 0x01, 0xa6, 0xfb, 0x30, 0x20, 0xa7, 0x16, 0xaa, 0xb3, 0xd3, 0x0a, 0xcf, 0x72, 0x5a,
+	0x9d, 0xbd, 0x93, 0xee, 0xd2, 0xf9, 0x67, 0x25, 0x72, 0x2c, 0x95, 0xfe, 0xcd, 0xf6, 0x49, 0x8d,
+	0xfa, 0xcc, 0xd6, 0x20, 0x0f, 0x9b, 0x83, 0x26, 0x73, 0x57, 0x07, 0xe4, 0x25, 0xd1, 0x2e, 0x28,
+	0x0a, 0x8f, 0x86, 0x87, 0xc0, 0x73, 0x64, 0x5c, 0x76, 0xe8, 0xfd, 0x6e, 0xc7, 0x17, 0x03, 0xa8,
+	0xd6, 0xe8, 0x25, 0x03, 0x06, 0x29, 0x4c, 0xe7, 0x77, 0xcb, 0xa4, 0xc1, 0x8d, 0x33, 0x2d, 0xb5,
+	0xf2, 0x16, 0xe5, 0x7d, 0xeb, 0x2f, 0xeb, 0x24, 0x8d, 0x56, 0x11, 0x2f, 0x96, 0x0e, 0x22, 0x34,
+	0x94, 0x63, 0xdb, 0x57, 0x33, 0x8e, 0x6d, 0x5c, 0xec, 0x6e, 0x1f, 0x52, 0x8f, 0xbe, 0xbf, 0x3c,
+	0xdd, 0xfe, 0x4e, 0x89, 0x1c, 0xcf, 0x3c, 0x4c, 0x83, 0xe9, 0x7c, 0xcc, 0x5c, 0xe6, 0x56, 0x11,
+	0x3a, 0xf5, 0x1d, 0xdf, 0x2a, 0xd9, 0x5b, 0x46, 0xf3, 0x87, 0xb4, 0x55, 0x9c, 0xef, 0x94, 0xc8,
+	0x44, 0xfa, 0x45, 0x9d, 0x47, 0x70, 0xa4, 0x7e, 0x94, 0xd4, 0xd9, 0xa3, 0x11, 0xec, 0x21, 0x68,
+	0xae, 0x92, 0xe7, 0xf9, 0xf9, 0x65, 0x21, 0x68, 0xf8, 0x23, 0x91, 0x28, 0xde, 0xf9, 0x7b, 0x16,
+	0x39, 0xc3, 0xbf, 0x32, 0xbb, 0x0e, 0xff, 0x4a, 0xde, 0xe8, 0xbe, 0x58, 0x6c, 0x07, 0x33, 0xc9,
+	0x45, 0x77, 0x1b, 0x5f, 0xf6, 0x6e, 0xab, 0xe8, 0x6d, 0x7a, 0x29, 0x3c, 0x82, 0x9d, 0xdd, 0xd3,
+	0x62, 0x70, 0xbe, 0x53, 0x26, 0xfa, 0xa9, 0x5a, 0x4c, 0xb2, 0xca, 0x62, 0x6d, 0x0b, 0x49, 0xb2,
+	0x8a, 0x0e, 0xa6, 0xaa, 0x69, 0x6e, 0x22, 0x32, 0x42, 0x6d, 0x7f, 0xd1, 0x42, 0xab, 0x8b, 0x97,
+	0x78, 0x2e, 0xbb, 0x46, 0x17, 0xf3, 0xde, 0xa4, 0x22, 0x37, 0xcf, 0x5b, 0x0e, 0x23, 0xd3, 0x8e,
+	0xa3, 0x88, 0x81, 0x49, 0xd9, 0xfe, 0x90, 0xf0, 0x3d, 0x2f, 0x17, 0x16, 0x25, 0x5e, 0xcb, 0x38,
+	0x9c, 0x77, 0x51, 0xf0, 0x4a, 0xa2, 0x82, 0x92, 0x2b, 0x00, 0x36, 0xa5, 0xf2, 0x75, 0x2b, 0xd1,
+	0x96, 0x15, 0x03, 0x27, 0xe4, 0xc4, 0xc4, 0xee, 0x1f, 0x8b, 0x3d, 0xfa, 0xf5, 0xa2, 0xe7, 0x72,
+	0x2f, 0x09, 0x3b, 0x38, 0x4c, 0xc2, 0xd4, 0xa4, 0x3d, 0x97, 0x25, 0x00, 0x34, 0x8e, 0xf3, 0xf9,
+	0x2a, 0xc9, 0x04, 0xbf, 0xda, 0x77, 0xcd, 0x67, 0x96, 0xad, 0x62, 0x9f, 0x59, 0x56, 0x9d, 0xc9,
+	0x7b, 0x6a, 0xd9, 0x6e, 0x93, 0x6a, 0x77, 0xc3, 0x8d, 0xa5, 0x58, 0xfd, 0x82, 0xba, 0xc7, 0x61,
+	0xe1, 0x83, 0x7b, 0x93, 0x3f, 0x3d, 0x9c, 0xd6, 0x15, 0xd7, 0xea, 0x05, 0x9e, 0xb0, 0x47, 0x93,
+	0x66, 0x6d, 0x00, 0x6f, 0x7f, 0x2f, 0x2f, 0x6e, 0x7e, 0x5c, 0xbc, 0x8e, 0x01, 0x34, 0xee, 0xf9,
+	0x89, 0x58, 0x0d, 0x2f, 0x14, 0xb8, 0xcb, 0x78, 0xc3, 0x3a, 0x6d, 0x03, 0xff, 0x0d, 0x06, 0x51,
+	0xfb, 0x83, 0xa4, 0x1e, 0x27, 0x6e, 0x94, 0xec, 0x33, 0xd0, 0x5a, 0x27, 0x56, 0x93, 0x8d, 0x80,
+	0x6e, 0x0f, 0x63, 0x9b, 0xd7, 0xbd, 0xc0, 0x8b, 0x37, 0xf6, 0x19, 0x32, 0x22, 0xf3, 0x53, 0x8b,
+	0x16, 0xc0, 0x68, 0x0d, 0x35, 0x00, 0x6c, 0x6d, 0x73, 0xff, 0xc3, 0x1a, 0xd3, 0x32, 0x29, 0x56,
+	0x08, 0x0a, 0x02, 0x06, 0x96, 0xf3, 0x63, 0x24, 0x9d, 0x77, 0x04, 0x43, 0x3f, 0x78, 0x9a, 0x13,
+	0xae, 0x85, 0x66, 0xa1, 0x1f, 0xa9, 0x8c, 0x24, 0xbf, 0x61, 0x11, 0x33, 0x39, 0x8a, 0xfd, 0x0a,
+	0xcf, 0xc2, 0x62, 0x15, 0x61, 0x39, 0x34, 0xda, 0x9d, 0x5a, 0x74, 0xbb, 0x19, 0x13, 0xb6, 0x4c,
+	0xc5, 0x82, 0x76, 0x65, 0x09, 0xdd, 0x93, 0x50, 0xf7, 0x51, 0x72, 0x4a, 0x06, 0xb3, 0x4a, 0xbd,
+	0xa9, 0xb0, 0x3a, 0xed, 0xae, 0xfa, 0x91, 0xfa, 0x9c, 0xd2, 0x20, 0x7d, 0xce, 0x10, 0x8f, 0x6d,
+	0xff, 0xa6, 0x45, 0xce, 0x67, 0x3b, 0x10, 0x2f, 0x86, 0x81, 0x97, 0x84, 0xd1, 0x0a, 0x4d, 0x12,
+	0x2f, 0x68, 0xb3, 0xe4, 0x73, 0x77, 0xdc, 0x48, 0x3e, 0x06, 0xc0, 0x18, 0xe5, 0x2d, 0x37, 0x0a,
+	0x80, 0x95, 0x62, 0x1c, 0x0c, 0x77, 0x52, 0x13, 0xd2, 0xfa, 0x01, 0xf7, 0x46, 0xce, 0x70, 0xe8,
+	0xeb, 0x02, 0x77, 0x90, 0x03, 0x41, 0xd0, 0xf9, 0x9e, 0x45, 0xec, 0xa5, 0x2d, 0x1a, 0x45, 0x5e,
+	0xcb, 0x70, 0xab, 0x63, 0xaf, 0x4c, 0x19, 0xaf, 0x49, 0x99, 0xa1, 0xd6, 0x99, 0x57, 0xa6, 0x8c,
+	0x5f, 0xf9, 0xaf, 0x4c, 0x95, 0xf6, 0xf6, 0xca, 0x94, 0xbd, 0x44, 0xce, 0x74, 0xf8, 0x75, 0x83,
+	0xbf, 0xdc, 0xc2, 0xef, 0x1e, 0x2a, 0x1e, 0xef, 0x2c, 0x3e, 0x1b, 0xbe, 0x98, 0x87, 0x00, 0xf9,
+	0xf5, 0x9c, 0x77, 0x12, 0x9b, 0x7b, 0xd3, 0xcd, 0xe6, 0xf9, 0x2a, 0x0d, 0x54, 0xbf, 0x38, 0x5f,
+	0xa9, 0x92, 0xe3, 0x99, 0x54, 0xd1, 0x78, 0xd5, 0xeb, 0x77, 0x8e, 0x3a, 0xf0, 0xf9, 0xdd, 0xdf,
+	0xbd, 0xa1, 0xdc, 0xad, 0xf0, 0x75, 0xf2, 0xa0, 0xdb, 0x4b, 0x8a, 0x09, 0x07, 0xe6, 0x9d, 0x98,
+	0xc7, 0x06, 0x0d, 0x75, 0x31, 0xfe, 0x04, 0x4e, 0xa6, 0x48, 0xe7, 0xad, 0x94, 0x30, 0x5e, 0x79,
+	0x48, 0xea, 0x80, 0x8f, 0x6b, 0x57, 0xaa, 0x6a, 0x11, 0x8a, 0xc5, 0xcc, 0x62, 0x39, 0x6c, 0x53,
+	0xfb, 0x37, 0x4b, 0x64, 0xcc, 0x98, 0x34, 0xfb, 0x57, 0xd3, 0xa9, 0xc3, 0xac, 0xe2, 0x3e, 0x89,
+	0xb5, 0x3f, 0xa5, 0x93, 0x83, 0xf1, 0x4f, 0x7a, 0xa6, 0x3f, 0x6b, 0xd8, 0x83, 0x7b, 0x93, 0x27,
+	0x32, 0x79, 0xc1, 0x52, 0x99, 0xc4, 0xce, 0x7d, 0x84, 0x1c, 0xcf, 0x34, 0x93, 0xf3, 0xc9, 0xab,
+	0xe6, 0x27, 0x1f, 0x58, 0x2d, 0x65, 0x0e, 0xd9, 0x37, 0x70, 0xc8, 0x44, 0x14, 0x62, 0xe8, 0xd3,
+	0x21, 0x74, 0xb0, 0x99, 0x60, 0xe3, 0xd2, 0x90, 0xc1, 0xc6, 0x6f, 0x21, 0xb5, 0x6e, 0xe8, 0x7b,
+	0x4d, 0x4f, 0x65, 0xf2, 0x64, 0xe1, 0xcd, 0xcb, 0xa2, 0x0c, 0x14, 0xd4, 0xbe, 0x43, 0xea, 0xb7,
+	0xef, 0x24, 0xdc, 0xfa, 0xd3, 0xa8, 0x14, 0x6a, 0xf4, 0x51, 0x42, 0x8b, 0x2c, 0x89, 0x41, 0xd3,
+	0xc2, 0xb0, 0x7c, 0x76, 0x08, 0xca, 0x88, 0x04, 0xa6, 0x7b, 0x67, 0xa7, 0x63, 0x0c, 0x02, 0xe2,
+	0x7c, 0xbd, 0x4e, 0x4e, 0xe7, 0xe5, 0xeb, 0xb7, 0x3f, 0x4c, 0x46, 0x78, 0x1f, 0x8b, 0x79, 0x12,
+	0x26, 0x8f, 0xc6, 0x15, 0xd6, 0xa0, 0xe8, 0x16, 0xfb, 0x1f, 0x04, 0x4d, 0x41, 0xdd, 0x77, 0xd7,
+	0x1a, 0xa5, 0x43, 0xa4, 0xbe, 0xe0, 0x6a, 0xea, 0x0b, 0x2e, 0xa7, 0xee, 0xbb, 0x6b, 0xf6, 0x5d,
+	0x52, 0x6d, 0x7b, 0x09, 0x75, 0x85, 0x12, 0xe1, 0xd6, 0xa1, 0x10, 0xa7, 0x2e, 0x97, 0xd2, 0xd8,
+	0xbf, 0xc0, 0x09, 0xa2, 0x6b, 0xfd, 0xf1, 0xb5, 0x74, 0x96, 0x03, 0xc1, 0x3c, 0xdd, 0xe2, 0x3b,
+	0x91, 0x49, 0xa7, 0xc0, 0x9f, 0x59, 0xcb, 0x14, 0x42, 0xb6, 0x3b, 0xe8, 0x9e, 0x3a, 0xba, 0xee,
+	0xf9, 0x46, 0x5a, 0xec, 0x43, 0x98, 0x9c, 0xcb, 0x8c, 0x80, 0xbe, 0x71, 0xf0, 0xdf, 0x31, 0x48,
+	0xca, 0x83, 0x4e, 0xaa, 0x91, 0x83, 0x9e, 0x54, 0xa3, 0x0f, 0xe9, 0xa4, 0xfa, 0x94, 0x45, 0xea,
+	0x6a, 0xa4, 0x45, 0xb4, 0xf8, 0x07, 0x0f, 0x71, 0xca, 0xb9, 0xe6, 0x44, 0xfd, 0x04, 0x4d, 0x1c,
+	0xe3, 0xcc, 0xc6, 0xdc, 0x57, 0x7b, 0x11, 0x6d, 0xd1, 0xad, 0xb0, 0x1b, 0x8b, 0x37, 0x5a, 0x5f,
+	0x2c, 0xbe, 0x33, 0xd3, 0x48, 0x64, 0x8e, 0x6e, 0x2d, 0x75, 0x63, 0x11, 0x2d, 0xa5, 0x0b, 0xc0,
+	0xec, 0x82, 0x73, 0xaf, 0x44, 0x26, 0x77, 0x69, 0x01, 0x55, 0xff, 0x61, 0xd4, 0x76, 0x03, 0xef,
+	0x55, 0x33, 0x6d, 0x89, 0x92, 0xb2, 0x96, 0x0c, 0x18, 0xa4, 0x30, 0xcd, 0x78, 0xf6, 0xd2, 0x2e,
+	0xf1, 0xec, 0xe7, 0x49, 0x25, 0xa2, 0xdd, 0x30, 0x7b, 0x59, 0x60, 0x91, 0x0a, 0x0c, 0x82, 0x51,
+	0x05, 0x6e, 0xd7, 0x13, 0x8e, 0x68, 0xea, 0x0e, 0x34, 0xbd, 0x3c, 0x0f, 0x58, 0x9e, 0x4a, 0xaf,
+	0x51, 0x3d, 0x92, 0xf4, 0x1a, 0x78, 0x0c, 0x08, 0xdb, 0xc5, 0x88, 0x3e, 0x06, 0xd2, 0x36, 0x05,
+	0xe7, 0xcb, 0x65, 0xf2, 0xe4, 0x8e, 0xeb, 0x45, 0xfb, 0xe1, 0x59, 0x3b, 0xf8, 0xe1, 0xc9, 0xe1,
+	0x29, 0xed, 0x36, 0x3c, 0xe5, 0x01, 0xc3, 0xf3, 0x09, 0xdc, 0x06, 0x32, 0xdd, 0x4b, 0x31, 0xaf,
+	0x6c, 0x0e, 0xca, 0x1e, 0x23, 0x76, 0x80, 0x84, 0x82, 0xa6, 0x8b, 0x77, 0x80, 0x54, 0x2c, 0x77,
+	0xb5, 0x88, 0x63, 0x60, 0x60, 0xca, 0x15, 0xbe, 0xf6, 0x07, 0x05, 0x88, 0x3b, 0xbf, 0x55, 0x21,
+	0x4f, 0x0f, 0xc1, 0xbd, 0xcd, 0x55, 0x6c, 0x0d, 0xb9, 0x8a, 0xbf, 0xcf, 0xa7, 0xe9, 0x93, 0xb9,
+	0xd3, 0x04, 0xc5, 0x4f, 0xd3, 0xce, 0x33, 0x84, 0xda, 0x47, 0x2f, 0x88, 0x69, 0xb3, 0x17, 0x71,
+	0x9f, 0x64, 0x23, 0x8c, 0x69, 0x5e, 0x94, 0x83, 0xc2, 0xc0, 0x3b, 0x5d, 0xd3, 0xc5, 0xed, 0x3f,
+	0x5a, 0x50, 0xec, 0xae, 0x19, 0x11, 0xc5, 0x45, 0x8a, 0xd9, 0x69, 0xe4, 0x00, 0x9c, 0x8c, 0xf3,
+	0x57, 0x2d, 0x72, 0x6e, 0xf0, 0x11, 0x8b, 0xb1, 0xab, 0x6b, 0x91, 0x1b, 0x34, 0x37, 0xd8, 0xfb,
+	0xca, 0x72, 0xe9, 0xb0, 0xef, 0xd5, 0xc5, 0x60, 0xe2, 0xa0, 0x12, 0x80, 0x7b, 0x6e, 0x18, 0x18,
+	0x32, 0xf2, 0x17, 0x95, 0x00, 0xab, 0x59, 0x20, 0xf4, 0xe3, 0x3b, 0xaf, 0x95, 0xf3, 0xbb, 0xc5,
+	0x45, 0xb1, 0xbd, 0xac, 0x66, 0xb1, 0x56, 0x4b, 0x43, 0x70, 0xdc, 0xf2, 0x51, 0x73, 0xdc, 0xca,
+	0x20, 0x8e, 0x8b, 0xa9, 0x58, 0x8c, 0x07, 0xb0, 0x78, 0x34, 0x37, 0x77, 0x4b, 0x56, 0xa9, 0x58,
+	0x96, 0x33, 0x70, 0xe8, 0xab, 0xf1, 0x88, 0x2f, 0xbd, 0x5f, 0x2b, 0x91, 0xb3, 0x03, 0xa5, 0xdf,
+	0x23, 0x3a, 0x51, 0xcc, 0xe9, 0xaf, 0x1c, 0xcd, 0xf4, 0x9b, 0x93, 0x52, 0xdd, 0x6d, 0x52, 0x9c,
+	0x3f, 0x2a, 0x0d, 0xdc, 0x08, 0x78, 0x13, 0xfa, 0x81, 0x1d, 0xa5, 0x77, 0x93, 0x63, 0x6e, 0xb7,
+	0xcb, 0xf1, 0x98, 0x17, 0x6d, 0x26, 0xf5, 0xd3, 0xb4, 0x09, 0x84, 0x34, 0xee, 0x50, 0x32, 0xcd,
+	0x1f, 0x5b, 0xa4, 0x0e, 0x74, 0x9d, 0x73, 0x23, 0xcc, 0xb6, 0xcb, 0x86, 0xc8, 0x2a, 0x22, 0xdb,
+	0x2e, 0x0e, 0x6c, 0xec, 0xb1, 0x2c, 0xb4, 0x79, 0x83, 0xdd, 0xff, 0x20, 0x5a, 0x69, 0x4f, 0x0f,
+	0xa2, 0xa9, 0x27, 0xb1, 0xca, 0x83, 0x9f, 0xc4, 0x72, 0xbe, 0x3b, 0x8a, 0x9f, 0xd7, 0x0d, 0xf1,
+	0xe5, 0x9e, 0x18, 0xe7, 0xb7, 0x17, 0xf9, 0x0d, 0x2b, 0x3d, 0xbf, 0x18, 0xbe, 0x84, 0xe5, 0x29,
+	0x03, 0x59, 0x69, 0x4f, 0x89, 0x6f, 0xca, 0xbb, 0x26, 0xbe, 0xc1, 0x24, 0x10, 0xf1, 0xc6, 0x72,
+	0xe4, 0x6d, 0xb9, 0x09, 0x6a, 0xa2, 0x1b, 0x95, 0xf4, 0x44, 0xae, 0xac, 0x5c, 0xd5, 0x40, 0x48,
+	0xe3, 0x62, 0x0e, 0x06, 0x9d, 0x7e, 0x86, 0x46, 0x09, 0x8b, 0xb9, 0xe0, 0x2b, 0x41, 0x45, 0x7c,
+	0xeb, 0x84, 0x35, 0x02, 0x01, 0xfa, 0xeb, 0x20, 0x3f, 0x4d, 0x15, 0x62, 0x47, 0x46, 0xd2, 0xfc,
+	0x34, 0xd5, 0x0e, 0xf6, 0xa5, 0xaf, 0x06, 0x66, 0x39, 0xe5, 0x0b, 0x63, 0xba, 0xdb, 0x35, 0xbe,
+	0x68, 0x34, 0x9d, 0xe5, 0xf4, 0x4a, 0x3f, 0x0a, 0xe4, 0xd5, 0x43, 0xdd, 0x92, 0x2a, 0x9e, 0x9f,
+	0x13, 0xb6, 0x1d, 0xa5, 0x5b, 0x52, 0xcd, 0xcc, 0xb7, 0xc0, 0xc4, 0xc3, 0x07, 0x98, 0xf4, 0x4f,
+	0x1e, 0x98, 0xc7, 0x0d, 0x9e, 0x73, 0x22, 0xb3, 0x97, 0x7a, 0x80, 0xe9, 0x4a, 0x2e, 0x5a, 0x0b,
+	0x06, 0xd5, 0xb7, 0xd7, 0xc8, 0x39, 0x05, 0xba, 0x14, 0x24, 0x2c, 0xca, 0x26, 0xa6, 0x33, 0x6e,
+	0x4c, 0x31, 0xff, 0x0c, 0x7f, 0xc8, 0x5b, 0xbd, 0xd1, 0x7b, 0xc5, 0x4b, 0xae, 0xe6, 0x61, 0xc2,
+	0x02, 0xec, 0xd0, 0x0a, 0xda, 0x57, 0x69, 0xe0, 0xae, 0xf9, 0x74, 0x69, 0x76, 0xbe, 0x31, 0x96,
+	0xb6, 0xaf, 0x5e, 0x92, 0x00, 0xd0, 0x38, 0xca, 0xef, 0x77, 0x7c, 0xe0, 0x7b, 0xd1, 0xcb, 0xe4,
+	0x74, 0xbb, 0xd9, 0x45, 0x89, 0xd0, 0x6b, 0xd2, 0xe9, 0x26, 0x73, 0x73, 0xc4, 0x89, 0xe1, 0xe9,
+	0x67, 0x95, 0x53, 0xfb, 0x95, 0xd9, 0xe5, 0x3e, 0x1c, 0xc8, 0xad, 0xc9, 0xdc, 0x61, 0x31, 0xa9,
+	0x4e, 0xe3, 0x54, 0xc6, 0x1d, 0x16, 0x0b, 0x81, 0xc3, 0xd0, 0xb9, 0x8f, 0x45, 0x48, 0x5c, 0x4d,
+	0x92, 0xae, 0x12, 0x41, 0x1b, 0xa7, 0xd3, 0x79, 0x7e, 0x2e, 0xf7, 0x61, 0x40, 0x4e, 0x2d, 0x94,
+	0x68, 0x82, 0x90, 0xb5, 0xde, 0x78, 0x3c, 0x2d, 0xd1, 0x5c, 0xe7, 0xc5, 0x20, 0xe1, 0xce, 0x7f,
+	0xb4, 0xc8, 0x31, 0xb5, 0xb5, 0x8f, 0x20, 0x9c, 0xc8, 0x4f, 0x87, 0x13, 0x5d, 0x39, 0x38, 0x73,
+	0x64, 0x3d, 0x1f, 0xe0, 0x93, 0xfe, 0xcd, 0x31, 0x42, 0x34, 0x03, 0x55, 0x67, 0x97, 0x35, 0xf0,
+	0xec, 0x7a, 0x64, 0x99, 0x57, 0x5e, 0x46, 0x9e, 0xea, 0xc3, 0xcd, 0xc8, 0xb3, 0x42, 0xce, 0x48,
+	0xc9, 0x82, 0x1b, 0xfb, 0x30, 0x78, 0x45, 0xf2, 0xc2, 0xda, 0xcc, 0x93, 0xa2, 0xa1, 0x33, 0xf3,
+	0x79, 0x48, 0x90, 0x5f, 0x37, 0x25, 0xd0, 0x8c, 0xee, 0x2a, 0x65, 0xaa, 0xed, 0xbf, 0xb0, 0x2e,
+	0x1f, 0x32, 0xca, 0x6c, 0xff, 0x85, 0xcb, 0x2b, 0xa0, 0x71, 0xf2, 0xcf, 0x80, 0x7a, 0x41, 0x67,
+	0x00, 0xd9, 0xf3, 0x19, 0x20, 0xb9, 0xd1, 0xd8, 0x40, 0x6e, 0x24, 0x8d, 0x0a, 0xe3, 0x03, 0x8d,
+	0x0a, 0xef, 0x25, 0x13, 0x5e, 0xb0, 0x41, 0x23, 0x2f, 0xa1, 0x2d, 0xb6, 0x17, 0x18, 0xa7, 0xaa,
+	0x69, 0x09, 0x60, 0x3e, 0x05, 0x85, 0x0c, 0x76, 0x9a, 0x85, 0x4e, 0x0c, 0xc1, 0x42, 0x07, 0x1c,
+	0x5c, 0xc7, 0x8b, 0x39, 0xb8, 0x4e, 0x1c, 0xfc, 0xe0, 0x3a, 0x79, 0xa8, 0x07, 0x97, 0x5d, 0xc8,
+	0xc1, 0x35, 0xd4, 0x99, 0x60, 0xdc, 0x4c, 0x4f, 0xef, 0x72, 0x33, 0x1d, 0x74, 0x6a, 0x9d, 0xd9,
+	0xf7, 0xa9, 0x95, 0x7f, 0x20, 0x3d, 0x76, 0xd8, 0x07, 0xd2, 0xa7, 0x4a, 0xe4, 0x8c, 0x66, 0xd9,
+	0xb8, 0x51, 0xbc, 0x75, 0x64, 0x5a, 0xec, 0xd9, 0x3c, 0x6e, 0xa3, 0x33, 0x02, 0xe1, 0x74, 0x4c,
+	0x9d, 0x82, 0x80, 0x81, 0xc5, 0xe2, 0xc9, 0x68, 0xc4, 0xb2, 0x5f, 0x67, 0xf9, 0xf9, 0xac, 0x28,
+	0x07, 0x85, 0x81, 0x4b, 0x11, 0xff, 0x17, 0x31, 0xba, 0xd9, 0xbc, 0x8a, 0xb3, 0x1a, 0x04, 0x26,
+	0x1e, 0xda, 0xe7, 0x9a, 0x92, 0x97, 0x20, 0x4f, 0x1f, 0x17, 0x6f, 0x93, 0x8b, 0x32, 0x50, 0x50,
+	0xd9, 0x1d, 0x16, 0x38, 0x58, 0xed, 0xef, 0x0e, 0x96, 0x83, 0xc2, 0x70, 0xfe, 0x87, 0x45, 0xce,
+	0xe6, 0x0e, 0xc5, 0x11, 0x9c, 0xd3, 0x77, 0xd3, 0xe7, 0xf4, 0x4a, 0x51, 0x97, 0x18, 0xe3, 0x2b,
+	0x06, 0x9c, 0xd9, 0xff, 0xde, 0x22, 0x13, 0x1a, 0xff, 0x08, 0x3e, 0xd5, 0x4b, 0x7f, 0x6a, 0x71,
+	0xf7, 0xb5, 0x7a, 0xdf, 0xb7, 0xfd, 0x6e, 0x89, 0xa8, 0x5c, 0xa7, 0xd3, 0x4d, 0x99, 0x49, 0x7a,
+	0x17, 0xab, 0x31, 0x3e, 0x98, 0x8c, 0x66, 0xee, 0xb8, 0x18, 0x87, 0x9e, 0x34, 0x7d, 0x66, 0x40,
+	0xd7, 0x0e, 0x05, 0xec, 0x67, 0x0c, 0x82, 0x20, 0xcb, 0xcd, 0xce, 0xd3, 0x48, 0xb6, 0x44, 0x08,
+	0x9e, 0xce, 0xcd, 0x2e, 0xca, 0x41, 0x61, 0xe0, 0x49, 0xe2, 0x35, 0xc3, 0x60, 0xd6, 0x77, 0x63,
+	0xf9, 0xee, 0xad, 0x3a, 0x49, 0xe6, 0x25, 0x00, 0x34, 0x0e, 0xb3, 0x87, 0x7b, 0x71, 0xd7, 0x77,
+	0xb7, 0x8d, 0x5b, 0xb9, 0x91, 0x8b, 0x42, 0x81, 0xc0, 0xc4, 0x73, 0x3a, 0xa4, 0x91, 0xfe, 0x88,
+	0x39, 0xba, 0xce, 0x9c, 0x51, 0x87, 0x1a, 0x4e, 0x74, 0xc9, 0x64, 0xb5, 0x16, 0x7a, 0x6e, 0xa3,
+	0x94, 0xee, 0xe5, 0xb4, 0x04, 0x80, 0xc6, 0x71, 0xfe, 0xae, 0x45, 0x4e, 0xe5, 0x0c, 0x5a, 0x81,
+	0x21, 0x8e, 0x89, 0xe6, 0x36, 0x79, 0x32, 0xc0, 0x8f, 0x90, 0xd1, 0x16, 0x5d, 0x77, 0xa5, 0xbb,
+	0xa3, 0xc1, 0x3d, 0xe7, 0x78, 0x31, 0x48, 0x38, 0x46, 0xe6, 0x1c, 0x4f, 0xf7, 0x35, 0x66, 0x61,
+	0x43, 0x7c, 0x98, 0xbc, 0xb8, 0x19, 0x6e, 0xd1, 0x68, 0x1b, 0xbf, 0xdc, 0xca, 0x84, 0x0d, 0xf5,
+	0x61, 0x40, 0x4e, 0x2d, 0x96, 0xe9, 0xb8, 0xa5, 0x46, 0x5b, 0xae, 0xc8, 0x9b, 0x45, 0xae, 0x48,
+	0x3d, 0x99, 0xc6, 0x52, 0xd0, 0x24, 0xc1, 0xa4, 0x8f, 0xb2, 0x08, 0xf3, 0xc3, 0xc6, 0xa8, 0xc7,
+	0xc4, 0x0b, 0xc4, 0x27, 0x8b, 0xb5, 0xaa, 0x64, 0x91, 0xc5, 0x7e, 0x14, 0xc8, 0xab, 0xe7, 0x7c,
+	0xaf, 0x42, 0x54, 0x48, 0x35, 0x73, 0x5d, 0x2b, 0xc8, 0xf1, 0x6f, 0xaf, 0xc1, 0x67, 0x6a, 0x6d,
+	0x55, 0x76, 0xf2, 0x25, 0xe1, 0xaa, 0x1c, 0x53, 0x9f, 0xab, 0x06, 0x6c, 0x55, 0x83, 0xc0, 0xc4,
+	0xc3, 0x9e, 0xf8, 0xde, 0x16, 0xe5, 0x95, 0x46, 0xd2, 0x3d, 0x59, 0x90, 0x00, 0xd0, 0x38, 0xd8,
+	0x93, 0x96, 0xb7, 0xbe, 0xde, 0x18, 0x4d, 0xf7, 0x04, 0x47, 0x07, 0x18, 0x84, 0xe7, 0xc2, 0x0f,
+	0x37, 0x85, 0xfc, 0x6d, 0xe4, 0xc2, 0x0f, 0x37, 0x81, 0x41, 0x70, 0x96, 0x82, 0x30, 0xea, 0xb8,
+	0xbe, 0xf7, 0x2a, 0x6d, 0x29, 0x2a, 0x42, 0xee, 0x56, 0xb3, 0x74, 0xbd, 0x1f, 0x05, 0xf2, 0xea,
+	0xe1, 0x82, 0xee, 0x46, 0xb4, 0xe5, 0x35, 0x13, 0xb3, 0x35, 0x92, 0x5e, 0xd0, 0xcb, 0x7d, 0x18,
+	0x90, 0x53, 0x0b, 0x13, 0xac, 0xc8, 0x90, 0x78, 0x99, 0xf0, 0x68, 0x2c, 0x9d, 0x60, 0x05, 0xd2,
+	0x60, 0xc8, 0xe2, 0x23, 0x93, 0xec, 0x88, 0x9c, 0x68, 0x8d, 0xf1, 0x34, 0x93, 0x94, 0xb9, 0xd2,
+	0x40, 0x61, 0x38, 0x1f, 0x2f, 0xe3, 0xa1, 0x3e, 0x20, 0xf5, 0xe0, 0x91, 0x39, 0x9a, 0xa6, 0x57,
+	0x64, 0x65, 0x88, 0x15, 0x89, 0x4e, 0x9c, 0x71, 0x18, 0x28, 0x27, 0xce, 0xea, 0x40, 0x27, 0x4e,
+	0x03, 0x2b, 0xdf, 0x89, 0x73, 0xa4, 0x28, 0x27, 0xce, 0xd1, 0x7d, 0x3a, 0x71, 0xfe, 0x7e, 0x95,
+	0xa8, 0xd7, 0x8d, 0xae, 0xd3, 0xe4, 0x4e, 0x18, 0x6d, 0x7a, 0x41, 0x9b, 0xa5, 0x12, 0xf8, 0x9a,
+	0x45, 0xc6, 0xf9, 0x7e, 0x59, 0x30, 0x83, 0xf0, 0xd6, 0x0b, 0x7a, 0x36, 0x27, 0x45, 0x6c, 0x6a,
+	0xd5, 0x20, 0x94, 0x79, 0xf9, 0xd8, 0x04, 0x41, 0xaa, 0x47, 0xf6, 0x47, 0x08, 0x91, 0x4a, 0xdc,
+	0x75, 0xc9, 0x81, 0x0b, 0x7c, 0xa9, 0x46, 0x89, 0xd4, 0xab, 0x8a, 0x08, 0x18, 0x04, 0xd1, 0x7d,
+	0x44, 0x2a, 0xc4, 0x79, 0xb4, 0xc7, 0x87, 0x0e, 0x65, 0x6c, 0x86, 0x09, 0x4f, 0x04, 0x32, 0xea,
+	0x05, 0x6d, 0x5c, 0x27, 0xc2, 0xd9, 0xed, 0xcd, 0x79, 0x69, 0x38, 0x16, 0x42, 0xb7, 0x35, 0xe3,
+	0xfa, 0x6e, 0xd0, 0xc4, 0xec, 0xc6, 0x0c, 0x5d, 0x9f, 0xa0, 0xa2, 0x00, 0x64, 0x43, 0x7d, 0xef,
+	0x42, 0x55, 0x87, 0x79, 0x17, 0x0a, 0x5f, 0xa4, 0xed, 0x9b, 0xcc, 0x3d, 0x45, 0x23, 0xee, 0x3f,
+	0x90, 0xd1, 0xf9, 0xad, 0x11, 0x7d, 0x68, 0x61, 0xca, 0x11, 0xf6, 0xc0, 0x4f, 0xa4, 0x67, 0x54,
+	0x88, 0xcc, 0x05, 0x2e, 0x11, 0x75, 0xcc, 0x18, 0x85, 0x60, 0x92, 0xc4, 0x35, 0xda, 0x75, 0x23,
+	0x1a, 0x1c, 0xf6, 0x1a, 0x5d, 0x56, 0x44, 0xc0, 0x20, 0x68, 0x6f, 0xa4, 0xc2, 0x91, 0x2e, 0x1f,
+	0x3c, 0x1c, 0x89, 0x25, 0x28, 0xcb, 0x7b, 0x07, 0xe3, 0x0b, 0x16, 0x99, 0x08, 0x52, 0x2b, 0xb7,
+	0x18, 0x0f, 0xe4, 0xfc, 0x5d, 0xc1, 0x1f, 0xc7, 0x4b, 0x97, 0x41, 0x86, 0x7e, 0xde, 0x91, 0x56,
+	0xdd, 0xe3, 0x91, 0xa6, 0x9f, 0x39, 0x1b, 0x19, 0xf4, 0xcc, 0x99, 0x1d, 0xa8, 0x77, 0x1e, 0x47,
+	0x0b, 0x7f, 0xe7, 0x91, 0xe4, 0xbc, 0xf1, 0x78, 0x8b, 0xd4, 0x9b, 0x11, 0x75, 0x93, 0x7d, 0x3e,
+	0xf9, 0xc7, 0x7c, 0x3b, 0x66, 0x65, 0x03, 0xa0, 0xdb, 0x72, 0xfe, 0x77, 0x85, 0x9c, 0x90, 0x23,
+	0x22, 0xa3, 0x17, 0xf0, 0x7c, 0xe4, 0x74, 0xb5, 0xac, 0xac, 0xce, 0xc7, 0xab, 0x12, 0x00, 0x1a,
+	0x07, 0xe5, 0xb1, 0x5e, 0x4c, 0x97, 0xba, 0x34, 0x58, 0xf0, 0xd6, 0x62, 0x61, 0x8c, 0x55, 0x1b,
+	0xe5, 0x86, 0x06, 0x81, 0x89, 0x87, 0xb2, 0xbd, 0x6b, 0x08, 0xad, 0x86, 0x6c, 0x2f, 0x05, 0x55,
+	0x09, 0xb7, 0x7f, 0x39, 0x37, 0x17, 0x72, 0x31, 0x31, 0x7f, 0x7d, 0x41, 0x1b, 0x7b, 0x7c, 0x25,
+	0xf6, 0x6f, 0x59, 0xe4, 0x0c, 0x2f, 0x95, 0x23, 0x79, 0xa3, 0xdb, 0x72, 0x13, 0x1a, 0x37, 0x46,
+	0x0e, 0xa9, 0x7f, 0x5a, 0xbd, 0x9c, 0x47, 0x16, 0xf2, 0x7b, 0x83, 0x61, 0xc7, 0xc7, 0x37, 0x53,
+	0xe9, 0x62, 0xe4, 0xd1, 0x71, 0xd0, 0x4c, 0x0e, 0xa9, 0x46, 0xf5, 0x56, 0x4b, 0x97, 0xc7, 0x90,
+	0xa5, 0xee, 0xfc, 0x77, 0x8b, 0x98, 0x6c, 0xf4, 0xe8, 0xb3, 0xcc, 0xec, 0x5d, 0x14, 0x94, 0xd2,
+	0x65, 0x75, 0xa0, 0x74, 0x89, 0x26, 0x62, 0xaf, 0xd5, 0x18, 0xc9, 0x98, 0x88, 0xe7, 0xe7, 0x00,
+	0xcb, 0x9d, 0x7f, 0x5a, 0xd5, 0x6a, 0x10, 0x11, 0x52, 0xf7, 0x03, 0xf1, 0xd9, 0xeb, 0x2a, 0x4f,
+	0x1d, 0xff, 0xf2, 0xeb, 0x7d, 0x79, 0xea, 0x7e, 0x72, 0xef, 0x11, 0x93, 0x7c, 0x80, 0x06, 0xa5,
+	0xa9, 0x1b, 0xdd, 0x25, 0x5c, 0xf2, 0x36, 0xa9, 0xe1, 0x15, 0x8c, 0xe9, 0x33, 0x6b, 0xa9, 0x4e,
+	0xd5, 0xae, 0x8a, 0xf2, 0x07, 0xf7, 0x26, 0xdf, 0xb5, 0xf7, 0x6e, 0xc9, 0xda, 0xa0, 0xda, 0xb7,
+	0x63, 0x52, 0xc7, 0xff, 0x59, 0x64, 0xa7, 0xb8, 0xdc, 0xdd, 0x50, 0x3c, 0x53, 0x02, 0x0a, 0x09,
+	0x1b, 0xd5, 0x74, 0xec, 0x80, 0xd4, 0x11, 0x91, 0x13, 0xe5, 0x77, 0xc0, 0x65, 0x49, 0x74, 0x45,
+	0x02, 0x1e, 0xdc, 0x9b, 0x7c, 0xf7, 0xde, 0x89, 0xaa, 0xea, 0xa0, 0x49, 0x38, 0xaf, 0x55, 0xf4,
+	0xda, 0xe5, 0xd3, 0xfa, 0x83, 0xb1, 0x76, 0x9f, 0xcb, 0xac, 0xdd, 0xf3, 0x7d, 0x6b, 0x77, 0x42,
+	0x3f, 0xfc, 0x9c, 0x5a, 0x8d, 0x47, 0x2d, 0x08, 0xec, 0xae, 0x6f, 0x60, 0x12, 0xd0, 0x2b, 0x3d,
+	0x2f, 0xa2, 0xf1, 0x72, 0xd4, 0x0b, 0x30, 0x33, 0x61, 0x9d, 0x21, 0x1b, 0x12, 0x50, 0x0a, 0x0c,
+	0x59, 0x7c, 0xbc, 0xd4, 0xe3, 0x9c, 0xdf, 0x72, 0xb7, 0xf8, 0xaa, 0x32, 0x32, 0xb6, 0xad, 0x88,
+	0x72, 0x50, 0x18, 0xa8, 0xe0, 0xf0, 0xdd, 0x38, 0x61, 0xce, 0xf8, 0xb4, 0x25, 0x9d, 0x6b, 0x1a,
+	0x63, 0x69, 0x05, 0xc7, 0x42, 0x3f, 0x0a, 0xe4, 0xd5, 0x73, 0xbe, 0xc1, 0x8c, 0xf2, 0x46, 0x84,
+	0x3a, 0x2e, 0x31, 0x9f, 0x3d, 0x88, 0xce, 0xb3, 0xc7, 0xa9, 0x25, 0xc6, 0x5f, 0x41, 0xe7, 0x30,
+	0xfb, 0x0e, 0x19, 0x5d, 0xe3, 0x8f, 0x6a, 0x16, 0x93, 0xc0, 0x5f, 0xbc, 0xd0, 0xc9, 0x9e, 0x2b,
+	0x92, 0xcf, 0x75, 0x3e, 0xd0, 0xff, 0x82, 0xa4, 0xe6, 0x7c, 0xbb, 0x4a, 0x8e, 0xcb, 0xce, 0x8b,
+	0x17, 0xb2, 0x53, 0x79, 0x7b, 0x4b, 0xbb, 0xe6, 0xed, 0x7d, 0x89, 0x90, 0x16, 0xed, 0xfa, 0xe1,
+	0x36, 0x93, 0xee, 0x2a, 0x7b, 0x96, 0xee, 0xd4, 0x85, 0x60, 0x4e, 0xb5, 0x02, 0x46, 0x8b, 0x22,
+	0x65, 0x1e, 0x4f, 0x03, 0x9c, 0x49, 0x99, 0x67, 0x3c, 0xf3, 0x31, 0x72, 0xb4, 0xcf, 0x7c, 0x78,
+	0xe4, 0x38, 0xef, 0xa2, 0x8a, 0x03, 0xdf, 0x47, 0xb8, 0x37, 0x8b, 0xa4, 0x99, 0x4b, 0x37, 0x03,
+	0xd9, 0x76, 0x1f, 0xe6, 0x8b, 0xf8, 0x98, 0x4b, 0x43, 0xce, 0x33, 0x46, 0x78, 0xa8, 0x5c, 0x1a,
+	0x72, 0x19, 0xb0, 0x97, 0xea, 0xc5, 0xbf, 0x7d, 0x29, 0x2d, 0xc8, 0xc3, 0x4a, 0x69, 0xe1, 0x7c,
+	0xae, 0x84, 0xd7, 0x02, 0xde, 0x2f, 0x95, 0x9d, 0xe9, 0x19, 0x32, 0xe2, 0xf6, 0x92, 0x8d, 0xb0,
+	0xef, 0x59, 0xce, 0x69, 0x56, 0x0a, 0x02, 0x6a, 0x2f, 0x90, 0x4a, 0x4b, 0x67, 0xdc, 0xd9, 0xcb,
+	0x7c, 0x6a, 0x0d, 0xab, 0x9b, 0x50, 0x60, 0xad, 0x60, 0xc0, 0x77, 0xe2, 0xb6, 0x65, 0xf0, 0x1f,
+	0x0b, 0xf8, 0x5e, 0x75, 0x31, 0x51, 0x3c, 0x96, 0x9a, 0xd2, 0x40, 0x65, 0x17, 0x69, 0x00, 0x5d,
+	0x50, 0xbc, 0x76, 0xe0, 0x26, 0xe8, 0x77, 0xa1, 0x8d, 0x90, 0xda, 0x05, 0xc5, 0x04, 0x42, 0x1a,
+	0xd7, 0xf9, 0xed, 0x71, 0x72, 0x7a, 0x65, 0x76, 0x51, 0xe6, 0x91, 0x3f, 0xb4, 0xf8, 0xbd, 0x3c,
+	0x1a, 0x47, 0x17, 0xbf, 0x37, 0x80, 0xba, 0x6f, 0xc4, 0xef, 0xf9, 0x46, 0xfc, 0x5e, 0x3a, 0x98,
+	0xaa, 0x5c, 0x44, 0x30, 0x55, 0x5e, 0x0f, 0x86, 0x09, 0xa6, 0x3a, 0xb4, 0x80, 0xbe, 0x1d, 0x3b,
+	0xb4, 0xa7, 0x80, 0x3e, 0x15, 0xed, 0x58, 0x48, 0x98, 0xcb, 0x80, 0xa9, 0xca, 0x8d, 0x76, 0x54,
+	0x91, 0x66, 0x3c, 0x84, 0xab, 0x31, 0x52, 0x44, 0xa4, 0x59, 0x5e, 0x07, 0x86, 0x88, 0x34, 0xe3,
+	0x3f, 0x52, 0xd1, 0x8d, 0xa3, 0x45, 0x44, 0x37, 0xe6, 0x75, 0x67, 0xd7, 0xe8, 0x46, 0x7c, 0xd7,
+	0xc6, 0x0f, 0x03, 0x7c, 0xd6, 0x22, 0x09, 0x9b, 0xa1, 0x7c, 0x18, 0x50, 0xbf, 0x6b, 0x63, 0x02,
+	0x21, 0x8d, 0x3b, 0x28, 0x34, 0xb2, 0x7e, 0xd0, 0xd0, 0x48, 0xf2, 0x90, 0x42, 0x23, 0x7f, 0x41,
+	0x07, 0xf1, 0x8f, 0xb1, 0x19, 0x79, 0xa9, 0xf8, 0x19, 0x19, 0xea, 0xe5, 0xbf, 0x2f, 0xf3, 0x77,
+	0x31, 0x51, 0xce, 0xc6, 0x67, 0x43, 0xbc, 0x84, 0x59, 0x96, 0xc6, 0x2e, 0xbe, 0x7c, 0x08, 0x0b,
+	0xf6, 0xd6, 0x8a, 0x26, 0xa3, 0xde, 0xca, 0xd4, 0x45, 0x90, 0xee, 0xc8, 0x41, 0x92, 0x0c, 0x7c,
+	0xa5, 0x44, 0x7e, 0x68, 0xd7, 0x2e, 0xd8, 0x77, 0xd0, 0xbe, 0xd1, 0x16, 0x0b, 0xb5, 0x61, 0x15,
+	0xe1, 0x27, 0xba, 0x2a, 0xdb, 0xe3, 0xd9, 0x71, 0xd4, 0x4f, 0x66, 0xd9, 0x90, 0xff, 0x33, 0xf7,
+	0xd0, 0xd0, 0xef, 0x4b, 0x22, 0x0a, 0xa1, 0x4f, 0x81, 0x41, 0xf0, 0xf8, 0x8f, 0x68, 0x5b, 0x3f,
+	0x2a, 0xaf, 0xa6, 0x0f, 0x58, 0x29, 0x08, 0x28, 0x2a, 0x03, 0x5d, 0xdf, 0xe7, 0x31, 0x48, 0x34,
+	0x16, 0x0f, 0x4e, 0xe9, 0x6c, 0x86, 0x1a, 0x04, 0x26, 0x9e, 0xf3, 0xe7, 0x25, 0x32, 0xb9, 0x0b,
+	0x4f, 0xe9, 0x8b, 0x3d, 0xad, 0x0e, 0x1d, 0x7b, 0x2a, 0xe2, 0x32, 0x46, 0x06, 0xc4, 0x65, 0xa0,
+	0x41, 0x99, 0xe2, 0xab, 0x11, 0xdc, 0xe1, 0x6c, 0x34, 0x63, 0x50, 0xd6, 0x20, 0x30, 0xf1, 0x90,
+	0x8b, 0x4d, 0xb8, 0xcd, 0x26, 0x8d, 0x63, 0x19, 0x78, 0x21, 0x94, 0xb3, 0x85, 0x45, 0x75, 0x30,
+	0x9d, 0xf7, 0x74, 0x8a, 0x04, 0x64, 0x48, 0x66, 0x07, 0xbc, 0x3e, 0xe4, 0x80, 0x7f, 0xbd, 0x44,
+	0x9e, 0xdc, 0xf1, 0x74, 0x1b, 0x3a, 0x26, 0x06, 0x7d, 0x82, 0xb3, 0x0b, 0x07, 0x3d, 0x86, 0x81,
+	0x41, 0xf8, 0x28, 0x75, 0xbb, 0xc6, 0xa3, 0xfd, 0x8d, 0xf2, 0x61, 0x8c, 0x52, 0x8a, 0x04, 0x64,
+	0x48, 0xee, 0x77, 0x59, 0x7e, 0xbb, 0x42, 0x9e, 0x1e, 0x42, 0x06, 0x28, 0x30, 0x90, 0x2e, 0x1d,
+	0xf4, 0x59, 0x7e, 0x48, 0x41, 0x9f, 0xfb, 0x1b, 0xae, 0xd7, 0x63, 0x45, 0x87, 0x0a, 0xd8, 0xfb,
+	0x46, 0x89, 0x9c, 0x1b, 0x2c, 0xb0, 0xd8, 0xef, 0x41, 0x15, 0x8e, 0xf4, 0xa4, 0x33, 0xe3, 0x45,
+	0x4f, 0x71, 0xf5, 0x4d, 0x0a, 0x04, 0x59, 0x5c, 0x7c, 0xaf, 0xbf, 0xeb, 0x26, 0x1b, 0xf1, 0xa5,
+	0xbb, 0x5e, 0x9c, 0x88, 0xac, 0x51, 0x13, 0xdc, 0x60, 0x28, 0x4b, 0xc1, 0xc0, 0x40, 0x72, 0xec,
+	0xd7, 0x5c, 0x78, 0x3d, 0x4c, 0x78, 0x25, 0x7e, 0xd9, 0x3a, 0x25, 0xdf, 0xd8, 0x31, 0x40, 0x90,
+	0xc5, 0x45, 0x72, 0xcc, 0x24, 0xcd, 0x3b, 0xca, 0x6f, 0x61, 0x8c, 0xdc, 0x82, 0x2a, 0x05, 0x03,
+	0x23, 0x1b, 0x09, 0x5b, 0xdd, 0x3d, 0x12, 0xd6, 0xf9, 0x27, 0x25, 0x72, 0x76, 0xa0, 0xc0, 0x3b,
+	0x1c, 0x9b, 0x7a, 0xf4, 0xa2, 0x57, 0xf7, 0xb9, 0xc3, 0xf6, 0x16, 0xf5, 0xf8, 0xc7, 0x03, 0x56,
+	0x9a, 0x88, 0x7a, 0xdc, 0x7f, 0x32, 0x87, 0x47, 0x6f, 0x3c, 0xfb, 0x02, 0x1d, 0x2b, 0x7b, 0x08,
+	0x74, 0xcc, 0x4c, 0x46, 0x75, 0xc8, 0xd3, 0xe1, 0x4f, 0x2a, 0x03, 0x87, 0x17, 0x2f, 0xc8, 0x43,
+	0x29, 0xc7, 0xe7, 0xc8, 0x09, 0x2f, 0x60, 0xef, 0xad, 0xad, 0xf4, 0xd6, 0x44, 0x22, 0x21, 0x9e,
+	0x2d, 0x53, 0x45, 0x53, 0xcc, 0x67, 0xe0, 0xd0, 0x57, 0xe3, 0x11, 0x0c, 0x3c, 0xdd, 0xdf, 0x90,
+	0xee, 0x91, 0x73, 0x2f, 0x91, 0x33, 0x72, 0x28, 0x36, 0xdc, 0x88, 0xb6, 0xc4, 0x61, 0x1b, 0x8b,
+	0xf8, 0x99, 0xb3, 0x3c, 0x06, 0x27, 0x07, 0x01, 0xf2, 0xeb, 0xe1, 0x94, 0x25, 0x61, 0xd7, 0x6b,
+	0x36, 0x6a, 0xe9, 0x29, 0x5b, 0xc5, 0x42, 0xe0, 0x30, 0x7d, 0x5e, 0xd4, 0x8f, 0xe6, 0xbc, 0x78,
+	0x89, 0xd4, 0xd5, 0x78, 0xf3, 0x50, 0x00, 0xb5, 0xc8, 0xfb, 0x42, 0x01, 0xd4, 0x0a, 0x37, 0xb0,
+	0x76, 0x7b, 0x83, 0xf5, 0xed, 0x64, 0x5c, 0x69, 0xbf, 0x86, 0x7d, 0x68, 0xcc, 0xf9, 0xd3, 0x11,
+	0x72, 0x2c, 0x95, 0x3c, 0x34, 0xa5, 0xf6, 0xb6, 0x76, 0x55, 0x7b, 0xb3, 0x28, 0x90, 0x5e, 0x20,
+	0x5f, 0x21, 0x34, 0xa2, 0x40, 0x7a, 0x01, 0x26, 0x47, 0xc5, 0x3f, 0x78, 0xe9, 0x68, 0x45, 0xdb,
+	0xd0, 0x0b, 0x84, 0x5b, 0xab, 0xba, 0x74, 0xcc, 0xb1, 0x52, 0x10, 0x50, 0x74, 0xfb, 0x19, 0x8f,
+	0x99, 0x89, 0x86, 0x1b, 0x0d, 0x1a, 0x95, 0x22, 0xcc, 0x31, 0x2b, 0x46, 0x8b, 0xdc, 0x0d, 0xca,
+	0x2c, 0x81, 0x14, 0x45, 0x7c, 0x5e, 0xa3, 0xae, 0x1e, 0x4b, 0x6a, 0x8c, 0x14, 0x11, 0x3a, 0x90,
+	0xcd, 0xcd, 0xca, 0xb5, 0xcd, 0xca, 0xda, 0x25, 0x4b, 0x98, 0x12, 0x59, 0xfc, 0x8b, 0x4f, 0x8b,
+	0xf0, 0x7f, 0x85, 0x30, 0x53, 0xb8, 0xb2, 0x9b, 0xe4, 0x68, 0xf3, 0x31, 0x65, 0xb4, 0x1b, 0x78,
+	0xeb, 0x34, 0x4e, 0xb8, 0x92, 0x5d, 0xa6, 0x8c, 0x96, 0x85, 0xa0, 0xe1, 0x28, 0x00, 0xc4, 0xec,
+	0xc3, 0x12, 0x43, 0x2b, 0xce, 0x04, 0x80, 0x15, 0x5d, 0x0c, 0x26, 0x8e, 0xa9, 0xc2, 0x27, 0x0f,
+	0x55, 0x85, 0x3f, 0xb6, 0x8b, 0x0a, 0x7f, 0x85, 0x9c, 0x71, 0x7b, 0x49, 0x88, 0x56, 0xbb, 0x69,
+	0xfe, 0x3e, 0xb0, 0x78, 0xef, 0x7e, 0x9c, 0xa9, 0x85, 0x94, 0xe3, 0xc6, 0x0a, 0xf5, 0xd7, 0xfb,
+	0x90, 0x20, 0xbf, 0xae, 0xf3, 0x0f, 0x2c, 0x72, 0x26, 0x77, 0x29, 0x3c, 0xba, 0x2e, 0xb3, 0xce,
+	0x97, 0xaa, 0xe4, 0x54, 0x4e, 0x6a, 0x61, 0x7b, 0xdb, 0xdc, 0x24, 0x56, 0x11, 0xde, 0x27, 0x69,
+	0x67, 0x0a, 0x39, 0x37, 0x39, 0x3b, 0x63, 0x6f, 0x56, 0x39, 0x6d, 0x19, 0x2b, 0x1f, 0xad, 0x65,
+	0xcc, 0x58, 0xeb, 0x95, 0x87, 0xba, 0xd6, 0xab, 0xbb, 0xac, 0xf5, 0x6f, 0x5a, 0xa4, 0xd1, 0x19,
+	0xf0, 0x9e, 0x45, 0x63, 0xa4, 0x88, 0x7b, 0xeb, 0xa0, 0xd7, 0x32, 0x66, 0x9e, 0xb8, 0x7f, 0x6f,
+	0x72, 0xe0, 0x33, 0x22, 0x30, 0xb0, 0x57, 0xce, 0xf7, 0xca, 0x84, 0xe5, 0xb5, 0x66, 0xe9, 0x23,
+	0xb7, 0xed, 0x8f, 0x9a, 0x19, 0xca, 0xad, 0xa2, 0xb2, 0x69, 0xf3, 0xc6, 0x55, 0x86, 0x73, 0x3e,
+	0x82, 0x79, 0x09, 0xcf, 0xb3, 0x9c, 0xb0, 0x34, 0x04, 0x27, 0xf4, 0x65, 0x2a, 0xf8, 0x72, 0xf1,
+	0xa9, 0xe0, 0xeb, 0xd9, 0x34, 0xf0, 0x3b, 0x4f, 0x71, 0xe5, 0x91, 0x9c, 0xe2, 0x5f, 0xb1, 0xc8,
+	0xa9, 0x9c, 0x59, 0xd0, 0xe2, 0x86, 0xb5, 0x83, 0xb8, 0x81, 0x9e, 0x0f, 0x82, 0x33, 0x0b, 0xb1,
+	0x44, 0x7b, 0x3e, 0x88, 0x72, 0x50, 0x18, 0xec, 0xad, 0x68, 0x7c, 0x1c, 0xfb, 0x52, 0xa7, 0x9b,
+	0x6c, 0x0b, 0x01, 0x45, 0xbf, 0x15, 0xad, 0x20, 0x60, 0x60, 0x39, 0x7f, 0xb3, 0xc4, 0x57, 0xa0,
+	0x70, 0x9f, 0x79, 0x2e, 0xf3, 0xba, 0xe7, 0xf0, 0x9e, 0x27, 0x1f, 0x26, 0xa4, 0x19, 0x76, 0xba,
+	0x28, 0xbc, 0xae, 0x86, 0xc2, 0xfc, 0x77, 0xf5, 0xa0, 0x82, 0xa8, 0x6c, 0x4f, 0x7f, 0x86, 0x2e,
+	0x03, 0x83, 0x5e, 0x8a, 0x97, 0x96, 0x77, 0xe5, 0xa5, 0x29, 0xb6, 0x52, 0xd9, 0x99, 0xad, 0x38,
+	0x7f, 0x6e, 0x91, 0x94, 0x98, 0x85, 0xaf, 0x1f, 0x60, 0x77, 0xb7, 0xc5, 0x0e, 0x5d, 0x2a, 0x4e,
+	0xa6, 0x43, 0xd6, 0x28, 0x96, 0x3d, 0xfb, 0x17, 0x38, 0x21, 0xdb, 0x17, 0x5e, 0x36, 0x7c, 0x54,
+	0xaf, 0x17, 0x47, 0x10, 0xfd, 0x74, 0xb8, 0x0d, 0x5b, 0x7b, 0xec, 0x38, 0xcf, 0x91, 0x93, 0x7d,
+	0x9d, 0x62, 0x0f, 0xf9, 0x85, 0x78, 0xfa, 0x64, 0x96, 0x2b, 0x0b, 0x3a, 0x06, 0x0e, 0x43, 0x5f,
+	0x99, 0x13, 0xd9, 0xe6, 0xd1, 0x7c, 0x72, 0x32, 0xce, 0xb6, 0x77, 0x58, 0x63, 0xa7, 0x3c, 0x65,
+	0xfb, 0x40, 0xd0, 0xdf, 0x09, 0xe7, 0xff, 0x88, 0xc5, 0x7f, 0xcb, 0x0b, 0x5a, 0xe1, 0x1d, 0x25,
+	0x98, 0x58, 0x03, 0x05, 0x13, 0xdc, 0x8f, 0xcd, 0x0d, 0xda, 0xea, 0xf9, 0x7d, 0x21, 0xcc, 0x2b,
+	0xa2, 0x1c, 0x14, 0x06, 0x62, 0xb7, 0x7a, 0xe2, 0xad, 0x88, 0xcc, 0xa2, 0x9c, 0x13, 0xe5, 0xa0,
+	0x30, 0x30, 0xd8, 0xc1, 0xf8, 0x48, 0xb9, 0x2e, 0x99, 0x94, 0x6f, 0x1c, 0x99, 0x31, 0xa4, 0xb0,
+	0x50, 0xdb, 0xa5, 0x84, 0x1c, 0x79, 0x44, 0x32, 0x6d, 0x97, 0xe2, 0x44, 0x31, 0x18, 0x18, 0x2c,
+	0x3e, 0xda, 0xef, 0xc5, 0xcc, 0x9c, 0x33, 0xa2, 0xf3, 0x17, 0xcf, 0x8a, 0x32, 0x50, 0x50, 0xe4,
+	0x26, 0x1d, 0x37, 0xe8, 0xb9, 0x3e, 0x8e, 0x90, 0xb8, 0xbf, 0xaa, 0x6d, 0xb8, 0xa8, 0x20, 0x60,
+	0x60, 0xe1, 0x17, 0x27, 0x5e, 0x87, 0x7e, 0x20, 0x0c, 0xa4, 0x87, 0xa3, 0xb6, 0xf0, 0x89, 0x72,
+	0x50, 0x18, 0xce, 0x9f, 0x59, 0xe4, 0xb8, 0x4e, 0xcc, 0xc0, 0x9f, 0xec, 0x37, 0xaf, 0xdb, 0xd6,
+	0xae, 0xd7, 0xed, 0x74, 0x18, 0x7a, 0x69, 0xa8, 0x30, 0x74, 0x33, 0x42, 0xbc, 0xbc, 0x63, 0x84,
+	0xf8, 0x0f, 0xeb, 0xe7, 0xa0, 0x79, 0x28, 0xf9, 0x58, 0xde, 0x53, 0xd0, 0xe8, 0xa0, 0xdf, 0x74,
+	0x55, 0x02, 0xa3, 0x71, 0x7e, 0x21, 0x99, 0x9d, 0x66, 0x48, 0x02, 0xe2, 0x2c, 0x91, 0xba, 0x32,
+	0x74, 0xc9, 0xdb, 0xaf, 0x95, 0x7f, 0xfb, 0x1d, 0x2a, 0x52, 0x75, 0x66, 0xed, 0x5b, 0xaf, 0x3d,
+	0xf5, 0x86, 0x3f, 0x7c, 0xed, 0xa9, 0x37, 0x7c, 0xf7, 0xb5, 0xa7, 0xde, 0xf0, 0xb1, 0xfb, 0x4f,
+	0x59, 0xdf, 0xba, 0xff, 0x94, 0xf5, 0x87, 0xf7, 0x9f, 0xb2, 0xbe, 0x7b, 0xff, 0x29, 0xeb, 0x7b,
+	0xf7, 0x9f, 0xb2, 0xbe, 0xf0, 0x5f, 0x9e, 0x7a, 0xc3, 0x07, 0x72, 0x5d, 0x5c, 0xf1, 0x9f, 0x67,
+	0x9b, 0xad, 0x0b, 0x5b, 0x17, 0x99, 0x97, 0x25, 0x6e, 0xaf, 0x0b, 0xc6, 0x9a, 0xba, 0x20, 0xb7,
+	0xd7, 0xff, 0x1d, 0x00, 0x09, 0x3e, 0xbe, 0xb0, 0xc6, 0xec, 0x00, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -8179,6 +8175,20 @@ func (m *ApplicationTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DeletedNodes) > 0 {
+		for iNdEx := len(m.DeletedNodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeletedNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ShardsCount))
 	i--
 	dAtA[i] = 0x20
@@ -13196,6 +13206,11 @@ func (m *ResourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.LastAppliedRevision)
+	copy(dAtA[i:], m.LastAppliedRevision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastAppliedRevision)))
+	i--
+	dAtA[i] = 0x5a
 	i = encodeVarintGenerated(dAtA, i, uint64(m.SyncWave))
 	i--
 	dAtA[i] = 0x50
@@ -16019,6 +16034,12 @@ func (m *ApplicationTree) Size() (n int) {
 		}
 	}
 	n += 1 + sovGenerated(uint64(m.ShardsCount))
+	if len(m.DeletedNodes) > 0 {
+		for _, e := range m.DeletedNodes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17889,6 +17910,8 @@ func (m *ResourceStatus) Size() (n int) {
 	n += 2
 	n += 2
 	n += 1 + sovGenerated(uint64(m.SyncWave))
+	l = len(m.LastAppliedRevision)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -19296,11 +19319,17 @@ func (this *ApplicationTree) String() string {
 		repeatedStringForHosts += strings.Replace(strings.Replace(f.String(), "HostInfo", "HostInfo", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForHosts += "}"
+	repeatedStringForDeletedNodes := "[]ResourceRef{"
+	for _, f := range this.DeletedNodes {
+		repeatedStringForDeletedNodes += strings.Replace(strings.Replace(f.String(), "ResourceRef", "ResourceRef", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDeletedNodes += "}"
 	s := strings.Join([]string{`&ApplicationTree{`,
 		`Nodes:` + repeatedStringForNodes + `,`,
 		`OrphanedNodes:` + repeatedStringForOrphanedNodes + `,`,
 		`Hosts:` + repeatedStringForHosts + `,`,
 		`ShardsCount:` + fmt.Sprintf("%v", this.ShardsCount) + `,`,
+		`DeletedNodes:` + repeatedStringForDeletedNodes + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20754,6 +20783,7 @@ func (this *ResourceStatus) String() string {
 		`Hook:` + fmt.Sprintf("%v", this.Hook) + `,`,
 		`RequiresPruning:` + fmt.Sprintf("%v", this.RequiresPruning) + `,`,
 		`SyncWave:` + fmt.Sprintf("%v", this.SyncWave) + `,`,
+		`LastAppliedRevision:` + fmt.Sprintf("%v", this.LastAppliedRevision) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -30078,6 +30108,40 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedNodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedNodes = append(m.DeletedNodes, ResourceRef{})
+			if err := m.DeletedNodes[len(m.DeletedNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -47327,6 +47391,38 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAppliedRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastAppliedRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
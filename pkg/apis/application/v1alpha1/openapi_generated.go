@@ -2550,11 +2550,25 @@ func schema_pkg_apis_application_v1alpha1_ApplicationTree(ref common.ReferenceCa
 							},
 						},
 					},
+					"deletedNodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletedNodes holds references to the nodes which were deleted since the previous tree. It is populated only in incremental updates of resource tree watches.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceRef"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HostInfo", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceNode"},
+			"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.HostInfo", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceNode", "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1.ResourceRef"},
 	}
 }
 
@@ -6857,6 +6871,13 @@ func schema_pkg_apis_application_v1alpha1_ResourceStatus(ref common.ReferenceCal
 							Format: "int64",
 						},
 					},
+					"lastAppliedRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastAppliedRevision is the revision of the sync which last created or changed the resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1548,6 +1548,8 @@ const (
 	ApplicationConditionSyncError = "SyncError"
 	// ApplicationConditionUnknownError indicates an unknown controller error
 	ApplicationConditionUnknownError = "UnknownError"
+	// ApplicationConditionQuotaExceededError indicates that application exceeds the application or cluster quota of its project
+	ApplicationConditionQuotaExceededError = "QuotaExceededError"
 	// ApplicationConditionSharedResourceWarning indicates that controller detected resources which belongs to more than one application
 	ApplicationConditionSharedResourceWarning = "SharedResourceWarning"
 	// ApplicationConditionRepeatedResourceWarning indicates that application source has resource with same Group, Kind, Name, Namespace multiple times
@@ -1556,6 +1558,12 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionResourceDependencyWarning indicates that application has resources with invalid or unresolvable dependencies
+	ApplicationConditionResourceDependencyWarning = "ResourceDependencyWarning"
+	// ApplicationConditionMaintenanceModeWarning indicates that sync operations are deferred because Argo CD or the application's project is in maintenance mode
+	ApplicationConditionMaintenanceModeWarning = "MaintenanceModeWarning"
+	// ApplicationConditionExpirationWarning indicates that application has a time to live after which it is deleted, or that its time to live is invalid
+	ApplicationConditionExpirationWarning = "ExpirationWarning"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -1600,6 +1608,9 @@ type HealthStatus struct {
 	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
 }
 
+// InfoItemLastAppliedRevision is the name of the resource node info item which holds the revision that last changed the resource
+const InfoItemLastAppliedRevision = "Last Applied Revision"
+
 // InfoItem contains arbitrary, human readable information about an application
 type InfoItem struct {
 	// Name is a human readable title for this piece of information.
@@ -1647,6 +1658,8 @@ type ApplicationTree struct {
 	Hosts []HostInfo `json:"hosts,omitempty" protobuf:"bytes,3,rep,name=hosts"`
 	// ShardsCount contains total number of shards the application tree is split into
 	ShardsCount int64 `json:"shardsCount,omitempty" protobuf:"bytes,4,opt,name=shardsCount"`
+	// DeletedNodes holds references to the nodes which were deleted since the previous tree. It is populated only in incremental updates of resource tree watches.
+	DeletedNodes []ResourceRef `json:"deletedNodes,omitempty" protobuf:"bytes,5,rep,name=deletedNodes"`
 }
 
 func (t *ApplicationTree) Merge(other *ApplicationTree) {
@@ -1821,6 +1834,8 @@ type ResourceStatus struct {
 	Hook            bool           `json:"hook,omitempty" protobuf:"bytes,8,opt,name=hook"`
 	RequiresPruning bool           `json:"requiresPruning,omitempty" protobuf:"bytes,9,opt,name=requiresPruning"`
 	SyncWave        int64          `json:"syncWave,omitempty" protobuf:"bytes,10,opt,name=syncWave"`
+	// LastAppliedRevision is the revision of the sync which last created or changed the resource
+	LastAppliedRevision string `json:"lastAppliedRevision,omitempty" protobuf:"bytes,11,opt,name=lastAppliedRevision"`
 }
 
 // GroupVersionKind returns the GVK schema type for given resource status
@@ -3245,11 +3260,33 @@ func (c *Cluster) RawRestConfig() (*rest.Config, error) {
 	}
 	config.DisableCompression = c.Config.DisableCompression
 	config.Timeout = K8sServerSideTimeout
-	config.QPS = K8sClientConfigQPS
-	config.Burst = K8sClientConfigBurst
+	config.QPS, config.Burst = c.k8sClientQPSAndBurst()
 	return config, nil
 }
 
+// k8sClientQPSAndBurst returns the QPS and burst of the K8s client of the cluster. The global settings are overridden
+// by the annotations of the cluster secret, so that fragile clusters can be protected and large clusters synced faster.
+func (c *Cluster) k8sClientQPSAndBurst() (float32, int) {
+	qps, burst := K8sClientConfigQPS, K8sClientConfigBurst
+	if val, ok := c.Annotations[common.AnnotationKeyK8sClientQPS]; ok {
+		if parsed, err := strconv.ParseFloat(val, 32); err == nil && parsed > 0 {
+			qps = float32(parsed)
+			// keep the default ratio between burst and QPS unless the burst is overridden too
+			burst = int(2 * qps)
+		} else {
+			log.Warnf("Ignoring invalid value '%s' of annotation %s of cluster %s", val, common.AnnotationKeyK8sClientQPS, c.Server)
+		}
+	}
+	if val, ok := c.Annotations[common.AnnotationKeyK8sClientBurst]; ok {
+		if parsed, err := strconv.Atoi(val); err == nil && parsed > 0 {
+			burst = parsed
+		} else {
+			log.Warnf("Ignoring invalid value '%s' of annotation %s of cluster %s", val, common.AnnotationKeyK8sClientBurst, c.Server)
+		}
+	}
+	return qps, burst
+}
+
 // RESTConfig returns a go-client REST config from cluster with tuned throttling and HTTP client settings.
 func (c *Cluster) RESTConfig() (*rest.Config, error) {
 	config, err := c.RawRestConfig()
@@ -4273,6 +4273,38 @@ func TestAppProject_ValidateDestinationServiceAccount(t *testing.T) {
 	}
 }
 
+func TestCluster_RawRestConfig_K8sClientQPSAndBurst(t *testing.T) {
+	newCluster := func(annotations map[string]string) *Cluster {
+		return &Cluster{Server: "https://192.168.99.100:8443", Annotations: annotations}
+	}
+
+	config, err := newCluster(nil).RawRestConfig()
+	require.NoError(t, err)
+	assert.InDelta(t, K8sClientConfigQPS, config.QPS, 0.001)
+	assert.Equal(t, K8sClientConfigBurst, config.Burst)
+
+	config, err = newCluster(map[string]string{argocdcommon.AnnotationKeyK8sClientQPS: "5"}).RawRestConfig()
+	require.NoError(t, err)
+	assert.InDelta(t, 5, config.QPS, 0.001)
+	assert.Equal(t, 10, config.Burst)
+
+	config, err = newCluster(map[string]string{
+		argocdcommon.AnnotationKeyK8sClientQPS:   "200",
+		argocdcommon.AnnotationKeyK8sClientBurst: "300",
+	}).RawRestConfig()
+	require.NoError(t, err)
+	assert.InDelta(t, 200, config.QPS, 0.001)
+	assert.Equal(t, 300, config.Burst)
+
+	config, err = newCluster(map[string]string{
+		argocdcommon.AnnotationKeyK8sClientQPS:   "fast",
+		argocdcommon.AnnotationKeyK8sClientBurst: "-1",
+	}).RawRestConfig()
+	require.NoError(t, err)
+	assert.InDelta(t, K8sClientConfigQPS, config.QPS, 0.001)
+	assert.Equal(t, K8sClientConfigBurst, config.Burst)
+}
+
 func TestCluster_ParseProxyUrl(t *testing.T) {
 	testData := []struct {
 		url            string
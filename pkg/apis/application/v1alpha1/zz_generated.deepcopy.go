@@ -1450,6 +1450,11 @@ func (in *ApplicationTree) DeepCopyInto(out *ApplicationTree) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DeletedNodes != nil {
+		in, out := &in.DeletedNodes, &out.DeletedNodes
+		*out = make([]ResourceRef, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -711,7 +711,11 @@ type ManifestResponse struct {
 	// Raw response of git verify-commit operation (always the empty string for Helm)
 	VerifyResult string `protobuf:"bytes,7,opt,name=verifyResult,proto3" json:"verifyResult,omitempty"`
 	// Commands is the list of commands used to hydrate the manifests
-	Commands             []string `protobuf:"bytes,8,rep,name=commands,proto3" json:"commands,omitempty"`
+	Commands []string `protobuf:"bytes,8,rep,name=commands,proto3" json:"commands,omitempty"`
+	// ToolName is the name of the tool which generated the manifests, e.g. helm or kustomize
+	ToolName string `protobuf:"bytes,9,opt,name=toolName,proto3" json:"toolName,omitempty"`
+	// ToolVersion is the version of the tool which generated the manifests
+	ToolVersion          string   `protobuf:"bytes,10,opt,name=toolVersion,proto3" json:"toolVersion,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -799,6 +803,20 @@ func (m *ManifestResponse) GetCommands() []string {
 	return nil
 }
 
+func (m *ManifestResponse) GetToolName() string {
+	if m != nil {
+		return m.ToolName
+	}
+	return ""
+}
+
+func (m *ManifestResponse) GetToolVersion() string {
+	if m != nil {
+		return m.ToolVersion
+	}
+	return ""
+}
+
 type ListRefsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -2475,156 +2493,157 @@ func init() {
 }
 
 var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 2376 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4d, 0x73, 0x1c, 0x47,
-	0x55, 0xfb, 0x25, 0xed, 0x3e, 0x59, 0x5f, 0x6d, 0x5b, 0x1e, 0xaf, 0x6d, 0xa1, 0x0c, 0xd8, 0xe5,
-	0xd8, 0xc9, 0xaa, 0x2c, 0x57, 0x62, 0x70, 0x42, 0x28, 0x45, 0xb6, 0x25, 0xc7, 0x96, 0x2d, 0xc6,
-	0x4e, 0x28, 0x83, 0x81, 0xea, 0x9d, 0x6d, 0xed, 0x76, 0x34, 0x1f, 0xed, 0x99, 0x1e, 0x05, 0xb9,
-	0x8a, 0x0b, 0x50, 0x5c, 0xb8, 0x73, 0xe0, 0xca, 0x6f, 0xa0, 0x38, 0x72, 0xa0, 0x28, 0x38, 0x52,
-	0x5c, 0xb8, 0x50, 0x05, 0xe5, 0x5f, 0x42, 0xf5, 0xc7, 0x7c, 0xee, 0xec, 0x4a, 0x61, 0x6d, 0x05,
-	0xb8, 0x48, 0xd3, 0xaf, 0x5f, 0xbf, 0xf7, 0xfa, 0x7d, 0xf5, 0x7b, 0xdd, 0x0b, 0x57, 0x02, 0xc2,
-	0xfc, 0x90, 0x04, 0x07, 0x24, 0x58, 0x93, 0x9f, 0x94, 0xfb, 0xc1, 0x61, 0xe6, 0xb3, 0xc3, 0x02,
-	0x9f, 0xfb, 0x08, 0x52, 0x48, 0xfb, 0x61, 0x9f, 0xf2, 0x41, 0xd4, 0xed, 0xd8, 0xbe, 0xbb, 0x86,
-	0x83, 0xbe, 0xcf, 0x02, 0xff, 0x73, 0xf9, 0xf1, 0xae, 0xdd, 0x5b, 0x3b, 0x58, 0x5f, 0x63, 0xfb,
-	0xfd, 0x35, 0xcc, 0x68, 0xb8, 0x86, 0x19, 0x73, 0xa8, 0x8d, 0x39, 0xf5, 0xbd, 0xb5, 0x83, 0x1b,
-	0xd8, 0x61, 0x03, 0x7c, 0x63, 0xad, 0x4f, 0x3c, 0x12, 0x60, 0x4e, 0x7a, 0x8a, 0x72, 0xfb, 0x42,
-	0xdf, 0xf7, 0xfb, 0x0e, 0x59, 0x93, 0xa3, 0x6e, 0xb4, 0xb7, 0x46, 0x5c, 0xc6, 0x35, 0x5b, 0xf3,
-	0x1f, 0x73, 0xb0, 0xb0, 0x83, 0x3d, 0xba, 0x47, 0x42, 0x6e, 0x91, 0x17, 0x11, 0x09, 0x39, 0x7a,
-	0x0e, 0x75, 0x21, 0x8c, 0x51, 0x59, 0xad, 0x5c, 0x9d, 0x5d, 0xdf, 0xee, 0xa4, 0xd2, 0x74, 0x62,
-	0x69, 0xe4, 0xc7, 0x8f, 0xed, 0x5e, 0xe7, 0x60, 0xbd, 0xc3, 0xf6, 0xfb, 0x1d, 0x21, 0x4d, 0x27,
-	0x23, 0x4d, 0x27, 0x96, 0xa6, 0x63, 0x25, 0xdb, 0xb2, 0x24, 0x55, 0xd4, 0x86, 0x66, 0x40, 0x0e,
-	0x68, 0x48, 0x7d, 0xcf, 0xa8, 0xae, 0x56, 0xae, 0xb6, 0xac, 0x64, 0x8c, 0x0c, 0x98, 0xf1, 0xfc,
-	0x4d, 0x6c, 0x0f, 0x88, 0x51, 0x5b, 0xad, 0x5c, 0x6d, 0x5a, 0xf1, 0x10, 0xad, 0xc2, 0x2c, 0x66,
-	0xec, 0x21, 0xee, 0x12, 0xe7, 0x01, 0x39, 0x34, 0xea, 0x72, 0x61, 0x16, 0x24, 0xd6, 0x62, 0xc6,
-	0x1e, 0x61, 0x97, 0x18, 0x0d, 0x39, 0x1b, 0x0f, 0xd1, 0x45, 0x68, 0x79, 0xd8, 0x25, 0x21, 0xc3,
-	0x36, 0x31, 0x9a, 0x72, 0x2e, 0x05, 0xa0, 0x9f, 0xc2, 0x52, 0x46, 0xf0, 0x27, 0x7e, 0x14, 0xd8,
-	0xc4, 0x00, 0xb9, 0xf5, 0xc7, 0x93, 0x6d, 0x7d, 0xa3, 0x48, 0xd6, 0x1a, 0xe6, 0x84, 0x7e, 0x04,
-	0x0d, 0x69, 0x79, 0x63, 0x76, 0xb5, 0xf6, 0x5a, 0xb5, 0xad, 0xc8, 0x22, 0x0f, 0x66, 0x98, 0x13,
-	0xf5, 0xa9, 0x17, 0x1a, 0xa7, 0x24, 0x87, 0xa7, 0x93, 0x71, 0xd8, 0xf4, 0xbd, 0x3d, 0xda, 0xdf,
-	0xc1, 0x1e, 0xee, 0x13, 0x97, 0x78, 0x7c, 0x57, 0x12, 0xb7, 0x62, 0x26, 0xe8, 0x25, 0x2c, 0xee,
-	0x47, 0x21, 0xf7, 0x5d, 0xfa, 0x92, 0x3c, 0x66, 0x62, 0x6d, 0x68, 0xcc, 0x49, 0x6d, 0x3e, 0x9a,
-	0x8c, 0xf1, 0x83, 0x02, 0x55, 0x6b, 0x88, 0x8f, 0x70, 0x92, 0xfd, 0xa8, 0x4b, 0x3e, 0x23, 0x81,
-	0xf4, 0xae, 0x79, 0xe5, 0x24, 0x19, 0x90, 0x72, 0x23, 0xaa, 0x47, 0xa1, 0xb1, 0xb0, 0x5a, 0x53,
-	0x6e, 0x94, 0x80, 0xd0, 0x55, 0x58, 0x38, 0x20, 0x01, 0xdd, 0x3b, 0x7c, 0x42, 0xfb, 0x1e, 0xe6,
-	0x51, 0x40, 0x8c, 0x45, 0xe9, 0x8a, 0x45, 0x30, 0x72, 0x61, 0x6e, 0x40, 0x1c, 0x57, 0xa8, 0x7c,
-	0x33, 0x20, 0xbd, 0xd0, 0x58, 0x92, 0xfa, 0xdd, 0x9a, 0xdc, 0x82, 0x92, 0x9c, 0x95, 0xa7, 0x2e,
-	0x04, 0xf3, 0x7c, 0x4b, 0x47, 0x8a, 0x8a, 0x11, 0xa4, 0x04, 0x2b, 0x80, 0xd1, 0x15, 0x98, 0xe7,
-	0x01, 0xb6, 0xf7, 0xa9, 0xd7, 0xdf, 0x21, 0x7c, 0xe0, 0xf7, 0x8c, 0xd3, 0x52, 0x13, 0x05, 0x28,
-	0xb2, 0x01, 0x11, 0x0f, 0x77, 0x1d, 0xd2, 0x53, 0xbe, 0xf8, 0xf4, 0x90, 0x91, 0xd0, 0x38, 0x23,
-	0x77, 0x71, 0xb3, 0x93, 0xc9, 0x50, 0x85, 0x04, 0xd1, 0xb9, 0x3b, 0xb4, 0xea, 0xae, 0xc7, 0x83,
-	0x43, 0xab, 0x84, 0x1c, 0xda, 0x87, 0x59, 0xb1, 0x8f, 0xd8, 0x15, 0xce, 0x4a, 0x57, 0xb8, 0x3f,
-	0x99, 0x8e, 0xb6, 0x53, 0x82, 0x56, 0x96, 0x3a, 0xea, 0x00, 0x1a, 0xe0, 0x70, 0x27, 0x72, 0x38,
-	0x65, 0x0e, 0x51, 0x62, 0x84, 0xc6, 0xb2, 0x54, 0x53, 0xc9, 0x0c, 0x7a, 0x00, 0x10, 0x90, 0xbd,
-	0x18, 0xef, 0x9c, 0xdc, 0xf9, 0xf5, 0x71, 0x3b, 0xb7, 0x12, 0x6c, 0xb5, 0xe3, 0xcc, 0x72, 0xc1,
-	0x5c, 0x6c, 0x83, 0xd8, 0x5c, 0x47, 0xbb, 0x0c, 0x6b, 0x43, 0xba, 0x58, 0xc9, 0x8c, 0xf0, 0x45,
-	0x0d, 0x95, 0x49, 0xeb, 0xbc, 0xf2, 0xd6, 0x0c, 0x08, 0x6d, 0xc3, 0xd7, 0xb0, 0xe7, 0xf9, 0x5c,
-	0x6e, 0x3f, 0x16, 0x65, 0x4b, 0xa7, 0xf7, 0x5d, 0xcc, 0x07, 0xa1, 0xd1, 0x96, 0xab, 0x8e, 0x42,
-	0x13, 0x2e, 0x41, 0xbd, 0x90, 0x63, 0xc7, 0x91, 0x48, 0xf7, 0xef, 0x18, 0x17, 0x94, 0x4b, 0xe4,
-	0xa1, 0xed, 0xbb, 0x70, 0x6e, 0x84, 0x71, 0xd1, 0x22, 0xd4, 0xf6, 0xc9, 0xa1, 0x3c, 0x14, 0x5a,
-	0x96, 0xf8, 0x44, 0x67, 0xa0, 0x71, 0x80, 0x9d, 0x88, 0xc8, 0x34, 0xde, 0xb4, 0xd4, 0xe0, 0x76,
-	0xf5, 0x9b, 0x95, 0xf6, 0x2f, 0x2b, 0xb0, 0x50, 0x50, 0x55, 0xc9, 0xfa, 0x1f, 0x66, 0xd7, 0xbf,
-	0x86, 0xc0, 0xd9, 0x7b, 0x8a, 0x83, 0x3e, 0xe1, 0x19, 0x41, 0xcc, 0xbf, 0x55, 0xc0, 0x28, 0xd8,
-	0xf0, 0x7b, 0x94, 0x0f, 0xee, 0x51, 0x87, 0x84, 0xe8, 0x16, 0xcc, 0x04, 0x0a, 0xa6, 0x8f, 0xba,
-	0x0b, 0x63, 0x4c, 0xbf, 0x3d, 0x65, 0xc5, 0xd8, 0xe8, 0x23, 0x68, 0xba, 0x84, 0xe3, 0x1e, 0xe6,
-	0x58, 0xcb, 0xbe, 0x5a, 0xb6, 0x52, 0x70, 0xd9, 0xd1, 0x78, 0xdb, 0x53, 0x56, 0xb2, 0x06, 0xbd,
-	0x07, 0x0d, 0x7b, 0x10, 0x79, 0xfb, 0xf2, 0x90, 0x9b, 0x5d, 0xbf, 0x34, 0x6a, 0xf1, 0xa6, 0x40,
-	0xda, 0x9e, 0xb2, 0x14, 0xf6, 0xc7, 0xd3, 0x50, 0x67, 0x38, 0xe0, 0xe6, 0x3d, 0x38, 0x53, 0xc6,
-	0x42, 0x9c, 0xac, 0xf6, 0x80, 0xd8, 0xfb, 0x61, 0xe4, 0x6a, 0x35, 0x27, 0x63, 0x84, 0xa0, 0x1e,
-	0xd2, 0x97, 0x4a, 0xd5, 0x35, 0x4b, 0x7e, 0x9b, 0x6f, 0xc3, 0xd2, 0x10, 0x37, 0x61, 0x54, 0x25,
-	0x9b, 0xa0, 0x70, 0x4a, 0xb3, 0x36, 0x23, 0x38, 0xfb, 0x54, 0xea, 0x22, 0x39, 0x5e, 0x4e, 0xa2,
-	0x56, 0x30, 0xb7, 0x61, 0xb9, 0xc8, 0x36, 0x64, 0xbe, 0x17, 0x12, 0x11, 0x6c, 0x32, 0x1f, 0x53,
-	0xd2, 0x4b, 0x67, 0xa5, 0x14, 0x4d, 0xab, 0x64, 0xc6, 0xfc, 0x6d, 0x15, 0x96, 0x2d, 0x12, 0xfa,
-	0xce, 0x01, 0x89, 0x93, 0xe5, 0xc9, 0x94, 0x3b, 0x3f, 0x80, 0x1a, 0x66, 0x4c, 0xbb, 0xc9, 0xfd,
-	0xd7, 0x56, 0x50, 0x58, 0x82, 0x2a, 0x7a, 0x07, 0x96, 0xb0, 0xdb, 0xa5, 0xfd, 0xc8, 0x8f, 0xc2,
-	0x78, 0x5b, 0xd2, 0xa9, 0x5a, 0xd6, 0xf0, 0x84, 0x48, 0x38, 0xa1, 0x8c, 0xc8, 0xfb, 0x5e, 0x8f,
-	0xfc, 0x44, 0xd6, 0x50, 0x35, 0x2b, 0x0b, 0x32, 0x6d, 0x38, 0x37, 0xa4, 0x24, 0xad, 0xf0, 0x6c,
-	0xd9, 0x56, 0x29, 0x94, 0x6d, 0xa5, 0x62, 0x54, 0x47, 0x88, 0x61, 0xbe, 0xaa, 0xc0, 0x62, 0x1a,
-	0x5c, 0x9a, 0xfc, 0x45, 0x68, 0xb9, 0x1a, 0x16, 0x1a, 0x15, 0x99, 0x33, 0x53, 0x40, 0xbe, 0x82,
-	0xab, 0x16, 0x2b, 0xb8, 0x65, 0x98, 0x56, 0x05, 0xb6, 0xde, 0xba, 0x1e, 0xe5, 0x44, 0xae, 0x17,
-	0x44, 0x5e, 0x01, 0x08, 0x93, 0x0c, 0x67, 0x4c, 0xcb, 0xd9, 0x0c, 0x04, 0x99, 0x70, 0x4a, 0x9d,
-	0xf7, 0x16, 0x09, 0x23, 0x87, 0x1b, 0x33, 0x12, 0x23, 0x07, 0x93, 0xf1, 0xe6, 0xbb, 0x2e, 0xf6,
-	0x7a, 0xa1, 0xd1, 0x94, 0x22, 0x27, 0x63, 0xd3, 0x87, 0x85, 0x87, 0x54, 0xec, 0x6f, 0x2f, 0x3c,
-	0x99, 0x50, 0x79, 0x1f, 0xea, 0x82, 0x99, 0x10, 0xaa, 0x1b, 0x60, 0xcf, 0x1e, 0x90, 0x58, 0x8f,
-	0xc9, 0x58, 0x24, 0x01, 0x8e, 0xfb, 0xa1, 0x51, 0x95, 0x70, 0xf9, 0x6d, 0xfe, 0xbe, 0xaa, 0x24,
-	0xdd, 0x60, 0x2c, 0xfc, 0xea, 0x1b, 0x80, 0xf2, 0x92, 0xa4, 0x36, 0x5c, 0x92, 0x14, 0x44, 0xfe,
-	0x32, 0x25, 0xc9, 0x6b, 0x3a, 0xe4, 0xcc, 0x08, 0x66, 0x36, 0x18, 0x13, 0x82, 0xa0, 0x1b, 0x50,
-	0xc7, 0x8c, 0x29, 0x85, 0x17, 0xf2, 0xb9, 0x46, 0x11, 0xff, 0xb5, 0x48, 0x12, 0xb5, 0x7d, 0x0b,
-	0x5a, 0x09, 0xe8, 0x28, 0xb6, 0xad, 0x2c, 0xdb, 0x55, 0x00, 0x55, 0x73, 0xdf, 0xf7, 0xf6, 0x7c,
-	0x61, 0x52, 0x11, 0x08, 0x7a, 0xa9, 0xfc, 0x36, 0x6f, 0xc7, 0x18, 0x52, 0xb6, 0x77, 0xa0, 0x41,
-	0x39, 0x71, 0x63, 0xe1, 0x96, 0xb3, 0xc2, 0xa5, 0x84, 0x2c, 0x85, 0x64, 0xfe, 0xb9, 0x09, 0xe7,
-	0x85, 0xc5, 0x9e, 0xc8, 0x10, 0xda, 0x60, 0xec, 0x0e, 0xe1, 0x98, 0x3a, 0xe1, 0x77, 0x23, 0x12,
-	0x1c, 0xbe, 0x61, 0xc7, 0xe8, 0xc3, 0xb4, 0x8a, 0x40, 0x9d, 0x2d, 0x5f, 0x7b, 0xfb, 0xa5, 0xc9,
-	0xa7, 0x3d, 0x57, 0xed, 0xcd, 0xf4, 0x5c, 0x65, 0x3d, 0x50, 0xfd, 0x84, 0x7a, 0xa0, 0xd1, 0x6d,
-	0x70, 0xa6, 0xb9, 0x9e, 0xce, 0x37, 0xd7, 0x25, 0xad, 0xc5, 0xcc, 0x71, 0x5b, 0x8b, 0x66, 0x69,
-	0x6b, 0xe1, 0x96, 0xc6, 0x71, 0x4b, 0xaa, 0xfb, 0xdb, 0x59, 0x0f, 0x1c, 0xe9, 0x6b, 0x93, 0x34,
-	0x19, 0xf0, 0x46, 0x9b, 0x8c, 0x4f, 0x73, 0x4d, 0x83, 0x6a, 0xdb, 0xdf, 0x3b, 0xde, 0x9e, 0xc6,
-	0xb4, 0x0f, 0xff, 0x77, 0xa5, 0xf7, 0x2f, 0x64, 0xc5, 0xc5, 0xfc, 0x54, 0x07, 0xc9, 0x61, 0x2f,
-	0xce, 0x21, 0x71, 0xec, 0xea, 0xa4, 0x25, 0xbe, 0xd1, 0x75, 0xa8, 0x0b, 0x25, 0xeb, 0x92, 0xf8,
-	0x5c, 0x56, 0x9f, 0xc2, 0x12, 0x1b, 0x8c, 0x3d, 0x61, 0xc4, 0xb6, 0x24, 0x12, 0xba, 0x0d, 0xad,
-	0xc4, 0xf1, 0x75, 0x64, 0x5d, 0xcc, 0xae, 0x48, 0xe2, 0x24, 0x5e, 0x96, 0xa2, 0x8b, 0xb5, 0x3d,
-	0x1a, 0x10, 0x5b, 0x16, 0x8c, 0x8d, 0xe1, 0xb5, 0x77, 0xe2, 0xc9, 0x64, 0x6d, 0x82, 0x8e, 0x6e,
-	0xc0, 0xb4, 0xba, 0xe7, 0x90, 0x11, 0x34, 0xbb, 0x7e, 0x7e, 0x38, 0x99, 0xc6, 0xab, 0x34, 0xa2,
-	0xf9, 0xa7, 0x0a, 0xbc, 0x95, 0x3a, 0x44, 0x1c, 0x4d, 0x71, 0xcd, 0xfe, 0xd5, 0x9f, 0xb8, 0x57,
-	0x60, 0x5e, 0x36, 0x09, 0xe9, 0x75, 0x87, 0xba, 0x79, 0x2b, 0x40, 0xcd, 0xdf, 0x55, 0xe0, 0xf2,
-	0xf0, 0x3e, 0x36, 0x07, 0x38, 0xe0, 0x89, 0x79, 0x4f, 0x62, 0x2f, 0xf1, 0x81, 0x57, 0x4d, 0x0f,
-	0xbc, 0xdc, 0xfe, 0x6a, 0xf9, 0xfd, 0x99, 0x7f, 0xa8, 0xc2, 0x6c, 0xc6, 0x81, 0xca, 0x0e, 0x4c,
-	0x51, 0x0c, 0x4a, 0xbf, 0x95, 0x6d, 0xa1, 0x3c, 0x14, 0x5a, 0x56, 0x06, 0x82, 0xf6, 0x01, 0x18,
-	0x0e, 0xb0, 0x4b, 0x38, 0x09, 0x44, 0x26, 0x17, 0x11, 0xff, 0x60, 0xf2, 0xec, 0xb2, 0x1b, 0xd3,
-	0xb4, 0x32, 0xe4, 0x45, 0x35, 0x2b, 0x59, 0x87, 0x3a, 0x7f, 0xeb, 0x11, 0xfa, 0x02, 0xe6, 0xf7,
-	0xa8, 0x43, 0x76, 0x53, 0x41, 0xa6, 0xa5, 0x20, 0x8f, 0x27, 0x17, 0xe4, 0x5e, 0x96, 0xae, 0x55,
-	0x60, 0x63, 0x5e, 0x83, 0xc5, 0x62, 0x3c, 0x09, 0x21, 0xa9, 0x8b, 0xfb, 0x89, 0xb6, 0xf4, 0xc8,
-	0x44, 0xb0, 0x58, 0x8c, 0x1f, 0xf3, 0x9f, 0x55, 0x38, 0x9b, 0x90, 0xdb, 0xf0, 0x3c, 0x3f, 0xf2,
-	0x6c, 0x79, 0x75, 0x58, 0x6a, 0x8b, 0x33, 0xd0, 0xe0, 0x94, 0x3b, 0x49, 0xe1, 0x23, 0x07, 0xe2,
-	0xec, 0xe2, 0xbe, 0xef, 0x70, 0xca, 0xb4, 0x81, 0xe3, 0xa1, 0xb2, 0xfd, 0x8b, 0x88, 0x06, 0xa4,
-	0x27, 0x33, 0x41, 0xd3, 0x4a, 0xc6, 0x62, 0x4e, 0x54, 0x35, 0xb2, 0xc4, 0x57, 0xca, 0x4c, 0xc6,
-	0xd2, 0xef, 0x7d, 0xc7, 0x21, 0xb6, 0x50, 0x47, 0xa6, 0x09, 0x28, 0x40, 0x65, 0x73, 0xc1, 0x03,
-	0xea, 0xf5, 0x75, 0x0b, 0xa0, 0x47, 0x42, 0x4e, 0x1c, 0x04, 0xf8, 0x50, 0x57, 0xfe, 0x6a, 0x80,
-	0x3e, 0x84, 0x9a, 0x8b, 0x99, 0x3e, 0xe8, 0xae, 0xe5, 0xb2, 0x43, 0x99, 0x06, 0x3a, 0x3b, 0x98,
-	0xa9, 0x93, 0x40, 0x2c, 0x6b, 0xbf, 0x0f, 0xcd, 0x18, 0xf0, 0xa5, 0x4a, 0xc2, 0xcf, 0x61, 0x2e,
-	0x97, 0x7c, 0xd0, 0x33, 0x58, 0x4e, 0x3d, 0x2a, 0xcb, 0x50, 0x17, 0x81, 0x6f, 0x1d, 0x29, 0x99,
-	0x35, 0x82, 0x80, 0xf9, 0x02, 0x96, 0x84, 0xcb, 0xc8, 0xc0, 0x3f, 0xa1, 0xd6, 0xe6, 0x03, 0x68,
-	0x25, 0x2c, 0x4b, 0x7d, 0xa6, 0x0d, 0xcd, 0x83, 0xf8, 0x4a, 0x57, 0xf5, 0x36, 0xc9, 0xd8, 0xdc,
-	0x00, 0x94, 0x95, 0x57, 0x9f, 0x40, 0xd7, 0xf3, 0x45, 0xf1, 0xd9, 0xe2, 0x71, 0x23, 0xd1, 0xe3,
-	0x9a, 0xf8, 0xef, 0x55, 0x58, 0xd8, 0xa2, 0xf2, 0x8e, 0xe4, 0x84, 0x92, 0xdc, 0x35, 0x58, 0x0c,
-	0xa3, 0xae, 0xeb, 0xf7, 0x22, 0x87, 0xe8, 0xa2, 0x40, 0x9f, 0xf4, 0x43, 0xf0, 0x71, 0xc9, 0x4f,
-	0x28, 0x8b, 0x61, 0x3e, 0xd0, 0xdd, 0xaf, 0xfc, 0x46, 0x1f, 0xc2, 0xf9, 0x47, 0xe4, 0x0b, 0xbd,
-	0x9f, 0x2d, 0xc7, 0xef, 0x76, 0xa9, 0xd7, 0x8f, 0x99, 0x34, 0x24, 0x93, 0xd1, 0x08, 0x65, 0xa5,
-	0xe2, 0x74, 0x79, 0xa9, 0x98, 0x74, 0xd0, 0x9b, 0xbe, 0xeb, 0x52, 0xae, 0x2b, 0xca, 0x1c, 0xcc,
-	0xfc, 0x79, 0x05, 0x16, 0x53, 0xcd, 0x6a, 0xdb, 0xdc, 0x52, 0x31, 0xa4, 0x2c, 0x73, 0x39, 0x6b,
-	0x99, 0x22, 0xea, 0x7f, 0x1e, 0x3e, 0xa7, 0xb2, 0xe1, 0xf3, 0xab, 0x2a, 0x9c, 0xdd, 0xa2, 0x3c,
-	0x4e, 0x5c, 0xf4, 0x7f, 0xcd, 0xca, 0x25, 0x36, 0xa9, 0x1f, 0xcf, 0x26, 0x8d, 0x12, 0x9b, 0x74,
-	0x60, 0xb9, 0xa8, 0x0c, 0x6d, 0x98, 0x33, 0xd0, 0x60, 0xf2, 0xd2, 0x59, 0xdd, 0x2b, 0xa8, 0x81,
-	0xf9, 0xb3, 0x19, 0xb8, 0xf4, 0x29, 0xeb, 0x61, 0x9e, 0xdc, 0x19, 0xdd, 0xf3, 0x03, 0x79, 0xeb,
-	0x7c, 0x32, 0x5a, 0x2c, 0xbc, 0x0c, 0x56, 0xc7, 0xbe, 0x0c, 0xd6, 0xc6, 0xbc, 0x0c, 0xd6, 0x8f,
-	0xf5, 0x32, 0xd8, 0x38, 0xb1, 0x97, 0xc1, 0xe1, 0x5e, 0x6b, 0xba, 0xb4, 0xd7, 0x7a, 0x96, 0xeb,
-	0x47, 0x66, 0x64, 0xd8, 0x7c, 0x2b, 0x1b, 0x36, 0x63, 0xad, 0x33, 0xf6, 0x49, 0xa3, 0xf0, 0xa0,
-	0xd6, 0x3c, 0xf2, 0x41, 0xad, 0x35, 0xfc, 0xa0, 0x56, 0xfe, 0x26, 0x03, 0x23, 0xdf, 0x64, 0xae,
-	0xc0, 0x7c, 0x78, 0xe8, 0xd9, 0xa4, 0x97, 0xdc, 0x24, 0xce, 0xaa, 0x6d, 0xe7, 0xa1, 0xb9, 0x88,
-	0x38, 0x55, 0x88, 0x88, 0xc4, 0x53, 0xe7, 0x32, 0x9e, 0x5a, 0x16, 0x27, 0xf3, 0x23, 0xdb, 0xdc,
-	0xc2, 0x73, 0xc9, 0x42, 0xe9, 0x73, 0xc9, 0x7f, 0x4d, 0xb3, 0xf5, 0x19, 0xac, 0x8c, 0xb2, 0xb2,
-	0x0e, 0x5e, 0x03, 0x66, 0xec, 0x01, 0xf6, 0xfa, 0xf2, 0x5a, 0x50, 0x76, 0xff, 0x7a, 0x38, 0xae,
-	0x3b, 0x58, 0xff, 0x23, 0xc0, 0x52, 0x5a, 0xf5, 0x8b, 0xbf, 0xd4, 0x26, 0xe8, 0x31, 0x2c, 0xc6,
-	0xcf, 0x4b, 0xf1, 0x45, 0x2e, 0x1a, 0xf7, 0x76, 0xd2, 0xbe, 0x58, 0x3e, 0xa9, 0x44, 0x33, 0xa7,
-	0x90, 0x0d, 0xe7, 0x8b, 0x04, 0xd3, 0x67, 0x9a, 0x6f, 0x8c, 0xa1, 0x9c, 0x60, 0x1d, 0xc5, 0xe2,
-	0x6a, 0x05, 0x3d, 0x83, 0xf9, 0xfc, 0x63, 0x02, 0xca, 0x95, 0x41, 0xa5, 0xef, 0x1b, 0x6d, 0x73,
-	0x1c, 0x4a, 0x22, 0xff, 0x73, 0xe1, 0x06, 0xb9, 0x7b, 0x73, 0x64, 0xe6, 0x6f, 0x04, 0xca, 0x5e,
-	0x1e, 0xda, 0x5f, 0x1f, 0x8b, 0x93, 0x50, 0xff, 0x00, 0x9a, 0xf1, 0x5d, 0x72, 0x5e, 0xcd, 0x85,
-	0x1b, 0xe6, 0xf6, 0x62, 0x9e, 0xde, 0x5e, 0x68, 0x4e, 0xa1, 0x8f, 0xd4, 0xe2, 0x0d, 0xc6, 0x4a,
-	0x16, 0x67, 0x6e, 0x50, 0xdb, 0xa7, 0x4b, 0x6e, 0x2d, 0xcd, 0x29, 0xf4, 0x1d, 0x98, 0x15, 0x5f,
-	0xbb, 0xfa, 0x79, 0x7f, 0xb9, 0xa3, 0x7e, 0x4d, 0xd2, 0x89, 0x7f, 0x4d, 0xd2, 0xb9, 0xeb, 0x32,
-	0x7e, 0xd8, 0x2e, 0xb9, 0x56, 0xd4, 0x04, 0x9e, 0xc3, 0xdc, 0x16, 0xe1, 0xe9, 0x2d, 0x00, 0xba,
-	0x7c, 0xac, 0xbb, 0x92, 0xb6, 0x59, 0x44, 0x1b, 0xbe, 0x48, 0x30, 0xa7, 0xd0, 0xaf, 0x2b, 0x70,
-	0x7a, 0x8b, 0xf0, 0x62, 0x5f, 0x8d, 0xde, 0x2d, 0x67, 0x32, 0xa2, 0xff, 0x6e, 0x3f, 0x9a, 0x34,
-	0x26, 0xf3, 0x64, 0xcd, 0x29, 0xf4, 0x9b, 0x0a, 0x9c, 0xcb, 0x08, 0x96, 0x6d, 0x94, 0xd1, 0x8d,
-	0xf1, 0xc2, 0x95, 0x34, 0xd5, 0xed, 0x4f, 0x26, 0xfc, 0xd5, 0x46, 0x86, 0xa4, 0x39, 0x85, 0x76,
-	0xa5, 0x4d, 0xd2, 0xba, 0x18, 0x5d, 0x2a, 0x2d, 0x80, 0x13, 0xee, 0x2b, 0xa3, 0xa6, 0x13, 0x3b,
-	0x7c, 0x02, 0xb3, 0x5b, 0x84, 0xc7, 0x05, 0x5a, 0xde, 0xd3, 0x0a, 0xb5, 0x73, 0x3e, 0x54, 0x8b,
-	0x35, 0x9d, 0xf4, 0x98, 0x25, 0x45, 0x2b, 0x53, 0x84, 0xe4, 0x63, 0xb5, 0xb4, 0x5a, 0xcb, 0x7b,
-	0x4c, 0x79, 0x0d, 0x63, 0x4e, 0xa1, 0x17, 0xb0, 0x5c, 0x9e, 0x2a, 0xd1, 0xdb, 0xc7, 0x3e, 0x34,
-	0xdb, 0xd7, 0x8e, 0x83, 0x1a, 0xb3, 0xfc, 0x78, 0xe3, 0x2f, 0xaf, 0x56, 0x2a, 0x7f, 0x7d, 0xb5,
-	0x52, 0xf9, 0xd7, 0xab, 0x95, 0xca, 0xf7, 0x6f, 0x1e, 0xf1, 0xeb, 0xae, 0xcc, 0x0f, 0xc6, 0x30,
-	0xa3, 0xb6, 0x43, 0x89, 0xc7, 0xbb, 0xd3, 0x32, 0xde, 0x6e, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0xa5, 0xe0, 0xaa, 0x70, 0x4f, 0x26, 0x00, 0x00,
+	// 2395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4d, 0x6f, 0x1c, 0x49,
+	0xd5, 0xf3, 0xe9, 0x99, 0xe7, 0xf8, 0xab, 0x92, 0x38, 0x9d, 0x4e, 0x62, 0xbc, 0x0d, 0x89, 0xb2,
+	0xc9, 0xee, 0x58, 0x71, 0xb4, 0x1b, 0xc8, 0x2e, 0x20, 0xaf, 0x93, 0xd8, 0xd9, 0xc4, 0x89, 0xe9,
+	0x64, 0x17, 0x05, 0x02, 0xa8, 0xa6, 0xa7, 0x3c, 0xd3, 0xeb, 0xfe, 0xa8, 0x74, 0x57, 0x7b, 0x71,
+	0x24, 0x2e, 0x80, 0xb8, 0x70, 0xe7, 0xc0, 0x09, 0x89, 0xdf, 0x80, 0x38, 0x72, 0x40, 0x08, 0x8e,
+	0x88, 0x0b, 0x17, 0x24, 0x50, 0x7e, 0x09, 0xaa, 0x8f, 0xee, 0xa9, 0xee, 0xe9, 0x19, 0x7b, 0x71,
+	0xe2, 0x05, 0x2e, 0x76, 0xd7, 0xab, 0x57, 0xef, 0xbd, 0x7a, 0x5f, 0xf5, 0x5e, 0xd5, 0xc0, 0x95,
+	0x88, 0xd0, 0x30, 0x26, 0xd1, 0x3e, 0x89, 0x56, 0xc5, 0xa7, 0xcb, 0xc2, 0xe8, 0x40, 0xfb, 0xec,
+	0xd0, 0x28, 0x64, 0x21, 0x82, 0x21, 0xc4, 0x7c, 0xd8, 0x77, 0xd9, 0x20, 0xe9, 0x76, 0x9c, 0xd0,
+	0x5f, 0xc5, 0x51, 0x3f, 0xa4, 0x51, 0xf8, 0x99, 0xf8, 0x78, 0xd7, 0xe9, 0xad, 0xee, 0xaf, 0xad,
+	0xd2, 0xbd, 0xfe, 0x2a, 0xa6, 0x6e, 0xbc, 0x8a, 0x29, 0xf5, 0x5c, 0x07, 0x33, 0x37, 0x0c, 0x56,
+	0xf7, 0x6f, 0x60, 0x8f, 0x0e, 0xf0, 0x8d, 0xd5, 0x3e, 0x09, 0x48, 0x84, 0x19, 0xe9, 0x49, 0xca,
+	0xe6, 0x85, 0x7e, 0x18, 0xf6, 0x3d, 0xb2, 0x2a, 0x46, 0xdd, 0x64, 0x77, 0x95, 0xf8, 0x94, 0x29,
+	0xb6, 0xd6, 0x3f, 0x66, 0x61, 0x7e, 0x1b, 0x07, 0xee, 0x2e, 0x89, 0x99, 0x4d, 0x5e, 0x24, 0x24,
+	0x66, 0xe8, 0x39, 0xd4, 0xb9, 0x30, 0x46, 0x65, 0xa5, 0x72, 0x75, 0x66, 0x6d, 0xab, 0x33, 0x94,
+	0xa6, 0x93, 0x4a, 0x23, 0x3e, 0x7e, 0xe4, 0xf4, 0x3a, 0xfb, 0x6b, 0x1d, 0xba, 0xd7, 0xef, 0x70,
+	0x69, 0x3a, 0x9a, 0x34, 0x9d, 0x54, 0x9a, 0x8e, 0x9d, 0x6d, 0xcb, 0x16, 0x54, 0x91, 0x09, 0xad,
+	0x88, 0xec, 0xbb, 0xb1, 0x1b, 0x06, 0x46, 0x75, 0xa5, 0x72, 0xb5, 0x6d, 0x67, 0x63, 0x64, 0xc0,
+	0x74, 0x10, 0x6e, 0x60, 0x67, 0x40, 0x8c, 0xda, 0x4a, 0xe5, 0x6a, 0xcb, 0x4e, 0x87, 0x68, 0x05,
+	0x66, 0x30, 0xa5, 0x0f, 0x71, 0x97, 0x78, 0x0f, 0xc8, 0x81, 0x51, 0x17, 0x0b, 0x75, 0x10, 0x5f,
+	0x8b, 0x29, 0x7d, 0x84, 0x7d, 0x62, 0x34, 0xc4, 0x6c, 0x3a, 0x44, 0x17, 0xa1, 0x1d, 0x60, 0x9f,
+	0xc4, 0x14, 0x3b, 0xc4, 0x68, 0x89, 0xb9, 0x21, 0x00, 0xfd, 0x04, 0x16, 0x35, 0xc1, 0x9f, 0x84,
+	0x49, 0xe4, 0x10, 0x03, 0xc4, 0xd6, 0x1f, 0x1f, 0x6f, 0xeb, 0xeb, 0x45, 0xb2, 0xf6, 0x28, 0x27,
+	0xf4, 0x43, 0x68, 0x08, 0xcb, 0x1b, 0x33, 0x2b, 0xb5, 0xd7, 0xaa, 0x6d, 0x49, 0x16, 0x05, 0x30,
+	0x4d, 0xbd, 0xa4, 0xef, 0x06, 0xb1, 0x71, 0x4a, 0x70, 0x78, 0x7a, 0x3c, 0x0e, 0x1b, 0x61, 0xb0,
+	0xeb, 0xf6, 0xb7, 0x71, 0x80, 0xfb, 0xc4, 0x27, 0x01, 0xdb, 0x11, 0xc4, 0xed, 0x94, 0x09, 0x7a,
+	0x09, 0x0b, 0x7b, 0x49, 0xcc, 0x42, 0xdf, 0x7d, 0x49, 0x1e, 0x53, 0xbe, 0x36, 0x36, 0x66, 0x85,
+	0x36, 0x1f, 0x1d, 0x8f, 0xf1, 0x83, 0x02, 0x55, 0x7b, 0x84, 0x0f, 0x77, 0x92, 0xbd, 0xa4, 0x4b,
+	0x3e, 0x25, 0x91, 0xf0, 0xae, 0x39, 0xe9, 0x24, 0x1a, 0x48, 0xba, 0x91, 0xab, 0x46, 0xb1, 0x31,
+	0xbf, 0x52, 0x93, 0x6e, 0x94, 0x81, 0xd0, 0x55, 0x98, 0xdf, 0x27, 0x91, 0xbb, 0x7b, 0xf0, 0xc4,
+	0xed, 0x07, 0x98, 0x25, 0x11, 0x31, 0x16, 0x84, 0x2b, 0x16, 0xc1, 0xc8, 0x87, 0xd9, 0x01, 0xf1,
+	0x7c, 0xae, 0xf2, 0x8d, 0x88, 0xf4, 0x62, 0x63, 0x51, 0xe8, 0x77, 0xf3, 0xf8, 0x16, 0x14, 0xe4,
+	0xec, 0x3c, 0x75, 0x2e, 0x58, 0x10, 0xda, 0x2a, 0x52, 0x64, 0x8c, 0x20, 0x29, 0x58, 0x01, 0x8c,
+	0xae, 0xc0, 0x1c, 0x8b, 0xb0, 0xb3, 0xe7, 0x06, 0xfd, 0x6d, 0xc2, 0x06, 0x61, 0xcf, 0x38, 0x2d,
+	0x34, 0x51, 0x80, 0x22, 0x07, 0x10, 0x09, 0x70, 0xd7, 0x23, 0x3d, 0xe9, 0x8b, 0x4f, 0x0f, 0x28,
+	0x89, 0x8d, 0x33, 0x62, 0x17, 0x37, 0x3b, 0x5a, 0x86, 0x2a, 0x24, 0x88, 0xce, 0xdd, 0x91, 0x55,
+	0x77, 0x03, 0x16, 0x1d, 0xd8, 0x25, 0xe4, 0xd0, 0x1e, 0xcc, 0xf0, 0x7d, 0xa4, 0xae, 0x70, 0x56,
+	0xb8, 0xc2, 0xfd, 0xe3, 0xe9, 0x68, 0x6b, 0x48, 0xd0, 0xd6, 0xa9, 0xa3, 0x0e, 0xa0, 0x01, 0x8e,
+	0xb7, 0x13, 0x8f, 0xb9, 0xd4, 0x23, 0x52, 0x8c, 0xd8, 0x58, 0x12, 0x6a, 0x2a, 0x99, 0x41, 0x0f,
+	0x00, 0x22, 0xb2, 0x9b, 0xe2, 0x9d, 0x13, 0x3b, 0xbf, 0x3e, 0x69, 0xe7, 0x76, 0x86, 0x2d, 0x77,
+	0xac, 0x2d, 0xe7, 0xcc, 0xf9, 0x36, 0x88, 0xc3, 0x24, 0x44, 0xc4, 0xa2, 0x61, 0x08, 0x17, 0x2b,
+	0x99, 0xe1, 0xbe, 0xa8, 0xa0, 0x22, 0x69, 0x9d, 0x97, 0xde, 0xaa, 0x81, 0xd0, 0x16, 0x7c, 0x05,
+	0x07, 0x41, 0xc8, 0xc4, 0xf6, 0x53, 0x51, 0x36, 0x55, 0x7a, 0xdf, 0xc1, 0x6c, 0x10, 0x1b, 0xa6,
+	0x58, 0x75, 0x18, 0x1a, 0x77, 0x09, 0x37, 0x88, 0x19, 0xf6, 0x3c, 0x81, 0x74, 0xff, 0x8e, 0x71,
+	0x41, 0xba, 0x44, 0x1e, 0x6a, 0xde, 0x85, 0x73, 0x63, 0x8c, 0x8b, 0x16, 0xa0, 0xb6, 0x47, 0x0e,
+	0xc4, 0xa1, 0xd0, 0xb6, 0xf9, 0x27, 0x3a, 0x03, 0x8d, 0x7d, 0xec, 0x25, 0x44, 0xa4, 0xf1, 0x96,
+	0x2d, 0x07, 0xb7, 0xab, 0x5f, 0xaf, 0x98, 0xbf, 0xa8, 0xc0, 0x7c, 0x41, 0x55, 0x25, 0xeb, 0x7f,
+	0xa0, 0xaf, 0x7f, 0x0d, 0x81, 0xb3, 0xfb, 0x14, 0x47, 0x7d, 0xc2, 0x34, 0x41, 0xac, 0xbf, 0x55,
+	0xc0, 0x28, 0xd8, 0xf0, 0xbb, 0x2e, 0x1b, 0xdc, 0x73, 0x3d, 0x12, 0xa3, 0x5b, 0x30, 0x1d, 0x49,
+	0x98, 0x3a, 0xea, 0x2e, 0x4c, 0x30, 0xfd, 0xd6, 0x94, 0x9d, 0x62, 0xa3, 0x6f, 0x41, 0xcb, 0x27,
+	0x0c, 0xf7, 0x30, 0xc3, 0x4a, 0xf6, 0x95, 0xb2, 0x95, 0x9c, 0xcb, 0xb6, 0xc2, 0xdb, 0x9a, 0xb2,
+	0xb3, 0x35, 0xe8, 0x3d, 0x68, 0x38, 0x83, 0x24, 0xd8, 0x13, 0x87, 0xdc, 0xcc, 0xda, 0xa5, 0x71,
+	0x8b, 0x37, 0x38, 0xd2, 0xd6, 0x94, 0x2d, 0xb1, 0x3f, 0x6a, 0x42, 0x9d, 0xe2, 0x88, 0x59, 0xf7,
+	0xe0, 0x4c, 0x19, 0x0b, 0x7e, 0xb2, 0x3a, 0x03, 0xe2, 0xec, 0xc5, 0x89, 0xaf, 0xd4, 0x9c, 0x8d,
+	0x11, 0x82, 0x7a, 0xec, 0xbe, 0x94, 0xaa, 0xae, 0xd9, 0xe2, 0xdb, 0x7a, 0x1b, 0x16, 0x47, 0xb8,
+	0x71, 0xa3, 0x4a, 0xd9, 0x38, 0x85, 0x53, 0x8a, 0xb5, 0x95, 0xc0, 0xd9, 0xa7, 0x42, 0x17, 0xd9,
+	0xf1, 0x72, 0x12, 0xb5, 0x82, 0xb5, 0x05, 0x4b, 0x45, 0xb6, 0x31, 0x0d, 0x83, 0x98, 0xf0, 0x60,
+	0x13, 0xf9, 0xd8, 0x25, 0xbd, 0xe1, 0xac, 0x90, 0xa2, 0x65, 0x97, 0xcc, 0x58, 0xbf, 0xad, 0xc2,
+	0x92, 0x4d, 0xe2, 0xd0, 0xdb, 0x27, 0x69, 0xb2, 0x3c, 0x99, 0x72, 0xe7, 0xfb, 0x50, 0xc3, 0x94,
+	0x1a, 0xd5, 0xd7, 0x91, 0xf7, 0xb4, 0x82, 0xc2, 0xe6, 0x54, 0xd1, 0x3b, 0xb0, 0x88, 0xfd, 0xae,
+	0xdb, 0x4f, 0xc2, 0x24, 0x4e, 0xb7, 0x25, 0x9c, 0xaa, 0x6d, 0x8f, 0x4e, 0xf0, 0x84, 0x13, 0x8b,
+	0x88, 0xbc, 0x1f, 0xf4, 0xc8, 0x8f, 0x45, 0x0d, 0x55, 0xb3, 0x75, 0x90, 0xe5, 0xc0, 0xb9, 0x11,
+	0x25, 0x29, 0x85, 0xeb, 0x65, 0x5b, 0xa5, 0x50, 0xb6, 0x95, 0x8a, 0x51, 0x1d, 0x23, 0x86, 0xf5,
+	0x9b, 0x2a, 0x2c, 0x0c, 0x83, 0x4b, 0x91, 0xbf, 0x08, 0x6d, 0x5f, 0xc1, 0x62, 0xa3, 0x22, 0x72,
+	0xe6, 0x10, 0x90, 0xaf, 0xe0, 0xaa, 0xc5, 0x0a, 0x6e, 0x09, 0x9a, 0xb2, 0xc0, 0x56, 0x5b, 0x57,
+	0xa3, 0x9c, 0xc8, 0xf5, 0x82, 0xc8, 0xcb, 0x00, 0x71, 0x96, 0xe1, 0x8c, 0xa6, 0x98, 0xd5, 0x20,
+	0xc8, 0x82, 0x53, 0xf2, 0xbc, 0xb7, 0x49, 0x9c, 0x78, 0xcc, 0x98, 0x16, 0x18, 0x39, 0x98, 0x88,
+	0xb7, 0xd0, 0xf7, 0x71, 0xd0, 0x8b, 0x8d, 0x96, 0x10, 0x39, 0x1b, 0xf3, 0x39, 0x16, 0x86, 0x9e,
+	0xc8, 0xec, 0x6d, 0xc9, 0x3b, 0x1d, 0x73, 0x3b, 0xf0, 0xef, 0xb4, 0x4c, 0x01, 0x99, 0xf8, 0x35,
+	0x90, 0x15, 0xc2, 0xfc, 0x43, 0x97, 0x6b, 0x67, 0x37, 0x3e, 0x99, 0x40, 0x7b, 0x1f, 0xea, 0x9c,
+	0x19, 0x17, 0xbb, 0x1b, 0xe1, 0xc0, 0x19, 0x90, 0xd4, 0x0a, 0xd9, 0x98, 0xa7, 0x10, 0x86, 0xfb,
+	0xb1, 0x51, 0x15, 0x70, 0xf1, 0x6d, 0xfd, 0xbe, 0x2a, 0x25, 0x5d, 0xa7, 0x34, 0xfe, 0xf2, 0xdb,
+	0x87, 0xf2, 0x82, 0xa6, 0x36, 0x5a, 0xd0, 0x14, 0x44, 0xfe, 0x22, 0x05, 0xcd, 0x6b, 0x3a, 0x22,
+	0xad, 0x04, 0xa6, 0xd7, 0x29, 0xe5, 0x82, 0xa0, 0x1b, 0x50, 0xc7, 0x94, 0x4a, 0x85, 0x17, 0x4e,
+	0x03, 0x85, 0xc2, 0xff, 0x2b, 0x91, 0x04, 0xaa, 0x79, 0x0b, 0xda, 0x19, 0xe8, 0x30, 0xb6, 0x6d,
+	0x9d, 0xed, 0x0a, 0x80, 0xac, 0xd8, 0xef, 0x07, 0xbb, 0x21, 0x37, 0x29, 0x0f, 0x23, 0xb5, 0x54,
+	0x7c, 0x5b, 0xb7, 0x53, 0x0c, 0x21, 0xdb, 0x3b, 0xd0, 0x70, 0x19, 0xf1, 0x53, 0xe1, 0x96, 0x74,
+	0xe1, 0x86, 0x84, 0x6c, 0x89, 0x64, 0xfd, 0xb9, 0x05, 0xe7, 0xb9, 0xc5, 0x9e, 0x88, 0x00, 0x5c,
+	0xa7, 0xf4, 0x0e, 0x61, 0xd8, 0xf5, 0xe2, 0xef, 0x24, 0x24, 0x3a, 0x78, 0xc3, 0x8e, 0xd1, 0x87,
+	0xa6, 0x8c, 0x5f, 0xa3, 0xfa, 0x66, 0x9a, 0xb7, 0x66, 0x5c, 0xe8, 0xd8, 0x6a, 0x6f, 0xa6, 0x63,
+	0x2b, 0xeb, 0xa0, 0xea, 0x27, 0xd4, 0x41, 0x8d, 0x6f, 0xa2, 0xb5, 0xd6, 0xbc, 0x99, 0x6f, 0xcd,
+	0x4b, 0x1a, 0x93, 0xe9, 0xa3, 0x36, 0x26, 0xad, 0xd2, 0xc6, 0xc4, 0x2f, 0x8d, 0xe3, 0xb6, 0x50,
+	0xf7, 0x37, 0x75, 0x0f, 0x1c, 0xeb, 0x6b, 0xc7, 0x69, 0x51, 0xe0, 0x8d, 0xb6, 0x28, 0x9f, 0xe4,
+	0x5a, 0x0e, 0xd9, 0xf4, 0xbf, 0x77, 0xb4, 0x3d, 0x4d, 0x68, 0x3e, 0xfe, 0xef, 0x0a, 0xf7, 0x9f,
+	0x8b, 0x7a, 0x8d, 0x86, 0x43, 0x1d, 0x64, 0xa5, 0x02, 0x3f, 0x87, 0xf8, 0xa1, 0xad, 0x92, 0x16,
+	0xff, 0x46, 0xd7, 0xa1, 0xce, 0x95, 0xac, 0x0a, 0xea, 0x73, 0xba, 0x3e, 0xb9, 0x25, 0xd6, 0x29,
+	0x7d, 0x42, 0x89, 0x63, 0x0b, 0x24, 0x74, 0x1b, 0xda, 0x99, 0xe3, 0xab, 0xc8, 0xba, 0xa8, 0xaf,
+	0xc8, 0xe2, 0x24, 0x5d, 0x36, 0x44, 0xe7, 0x6b, 0x7b, 0x6e, 0x44, 0x1c, 0x8e, 0x68, 0x34, 0x46,
+	0xd7, 0xde, 0x49, 0x27, 0xb3, 0xb5, 0x19, 0x3a, 0xba, 0x01, 0x4d, 0x79, 0x4b, 0x22, 0x22, 0x68,
+	0x66, 0xed, 0xfc, 0x68, 0x32, 0x4d, 0x57, 0x29, 0x44, 0xeb, 0x4f, 0x15, 0x78, 0x6b, 0xe8, 0x10,
+	0x69, 0x34, 0xa5, 0x15, 0xff, 0x97, 0x7f, 0xe2, 0x5e, 0x81, 0x39, 0xd1, 0x62, 0x0c, 0x2f, 0x4b,
+	0xe4, 0xbd, 0x5d, 0x01, 0x6a, 0xfd, 0xae, 0x02, 0x97, 0x47, 0xf7, 0xb1, 0x31, 0xc0, 0x11, 0xcb,
+	0xcc, 0x7b, 0x12, 0x7b, 0x49, 0x0f, 0xbc, 0xea, 0xf0, 0xc0, 0xcb, 0xed, 0xaf, 0x96, 0xdf, 0x9f,
+	0xf5, 0x87, 0x2a, 0xcc, 0x68, 0x0e, 0x54, 0x76, 0x60, 0xf2, 0x52, 0x52, 0xf8, 0xad, 0x68, 0x2a,
+	0xc5, 0xa1, 0xd0, 0xb6, 0x35, 0x08, 0xda, 0x03, 0xa0, 0x38, 0xc2, 0x3e, 0x61, 0x24, 0xe2, 0x99,
+	0x9c, 0x47, 0xfc, 0x83, 0xe3, 0x67, 0x97, 0x9d, 0x94, 0xa6, 0xad, 0x91, 0xe7, 0xb5, 0xb0, 0x60,
+	0x1d, 0xab, 0xfc, 0xad, 0x46, 0xe8, 0x73, 0x98, 0xdb, 0x75, 0x3d, 0xb2, 0x33, 0x14, 0xa4, 0xb9,
+	0x52, 0x3b, 0xfe, 0x29, 0xc9, 0x05, 0xb9, 0xa7, 0xd3, 0xb5, 0x0b, 0x6c, 0xac, 0x6b, 0xb0, 0x50,
+	0x8c, 0x27, 0x2e, 0xa4, 0xeb, 0xe3, 0x7e, 0xa6, 0x2d, 0x35, 0xb2, 0x10, 0x2c, 0x14, 0xe3, 0xc7,
+	0xfa, 0x67, 0x15, 0xce, 0x66, 0xe4, 0xd6, 0x83, 0x20, 0x4c, 0x02, 0x47, 0x5c, 0x3c, 0x96, 0xda,
+	0xe2, 0x0c, 0x34, 0x98, 0xcb, 0xbc, 0xac, 0xf0, 0x11, 0x03, 0x7e, 0x76, 0xf1, 0xea, 0x9a, 0xb9,
+	0x54, 0x19, 0x38, 0x1d, 0x4a, 0xdb, 0xbf, 0x48, 0xdc, 0x88, 0xf4, 0x44, 0x26, 0x68, 0xd9, 0xd9,
+	0x98, 0xcf, 0xf1, 0xaa, 0x46, 0x34, 0x08, 0x52, 0x99, 0xd9, 0x58, 0xf8, 0x7d, 0xe8, 0x79, 0xc4,
+	0xe1, 0xea, 0xd0, 0x5a, 0x88, 0x02, 0x94, 0xef, 0x34, 0x66, 0x91, 0x1b, 0xf4, 0x55, 0x03, 0xa1,
+	0x46, 0x5c, 0x4e, 0x1c, 0x45, 0xf8, 0x40, 0xf5, 0x0d, 0x72, 0x80, 0x3e, 0x84, 0x9a, 0x8f, 0xa9,
+	0x3a, 0xe8, 0xae, 0xe5, 0xb2, 0x43, 0x99, 0x06, 0x3a, 0xdb, 0x98, 0xca, 0x93, 0x80, 0x2f, 0x33,
+	0xdf, 0x87, 0x56, 0x0a, 0xf8, 0x42, 0x25, 0xe1, 0x67, 0x30, 0x9b, 0x4b, 0x3e, 0xe8, 0x19, 0x2c,
+	0x0d, 0x3d, 0x4a, 0x67, 0xa8, 0x8a, 0xc0, 0xb7, 0x0e, 0x95, 0xcc, 0x1e, 0x43, 0xc0, 0x7a, 0x01,
+	0x8b, 0xdc, 0x65, 0x44, 0xe0, 0x9f, 0x50, 0x6b, 0xf3, 0x01, 0xb4, 0x33, 0x96, 0xa5, 0x3e, 0x63,
+	0x42, 0x6b, 0x3f, 0xbd, 0x10, 0x96, 0xbd, 0x4d, 0x36, 0xb6, 0xd6, 0x01, 0xe9, 0xf2, 0xaa, 0x13,
+	0xe8, 0x7a, 0xbe, 0x28, 0x3e, 0x5b, 0x3c, 0x6e, 0x04, 0x7a, 0x5a, 0x13, 0xff, 0xbd, 0x0a, 0xf3,
+	0x9b, 0xae, 0xb8, 0x61, 0x39, 0xa1, 0x24, 0x77, 0x0d, 0x16, 0xe2, 0xa4, 0xeb, 0x87, 0xbd, 0xc4,
+	0x23, 0xaa, 0x28, 0x50, 0x27, 0xfd, 0x08, 0x7c, 0x52, 0xf2, 0xe3, 0xca, 0xa2, 0x98, 0x0d, 0x54,
+	0xef, 0x2c, 0xbe, 0xd1, 0x87, 0x70, 0xfe, 0x11, 0xf9, 0x5c, 0xed, 0x67, 0xd3, 0x0b, 0xbb, 0x5d,
+	0x37, 0xe8, 0xa7, 0x4c, 0x1a, 0x82, 0xc9, 0x78, 0x84, 0xb2, 0x52, 0xb1, 0x59, 0x5e, 0x2a, 0x66,
+	0xfd, 0xf7, 0x46, 0xe8, 0xfb, 0x2e, 0x53, 0x15, 0x65, 0x0e, 0x66, 0xfd, 0xac, 0x02, 0x0b, 0x43,
+	0xcd, 0x2a, 0xdb, 0xdc, 0x92, 0x31, 0x24, 0x2d, 0x73, 0x59, 0xb7, 0x4c, 0x11, 0xf5, 0x3f, 0x0f,
+	0x9f, 0x53, 0x7a, 0xf8, 0xfc, 0xb2, 0x0a, 0x67, 0x37, 0x5d, 0x96, 0x26, 0x2e, 0xf7, 0x7f, 0xcd,
+	0xca, 0x25, 0x36, 0xa9, 0x1f, 0xcd, 0x26, 0x8d, 0x12, 0x9b, 0x74, 0x60, 0xa9, 0xa8, 0x0c, 0x65,
+	0x98, 0x33, 0xd0, 0xa0, 0xe2, 0xca, 0x5a, 0xde, 0x2b, 0xc8, 0x81, 0xf5, 0xd3, 0x69, 0xb8, 0xf4,
+	0x09, 0xed, 0x61, 0x96, 0xdd, 0x38, 0xdd, 0x0b, 0x23, 0x71, 0x67, 0x7d, 0x32, 0x5a, 0x2c, 0xbc,
+	0x2b, 0x56, 0x27, 0xbe, 0x2b, 0xd6, 0x26, 0xbc, 0x2b, 0xd6, 0x8f, 0xf4, 0xae, 0xd8, 0x38, 0xb1,
+	0x77, 0xc5, 0xd1, 0x5e, 0xab, 0x59, 0xda, 0x6b, 0x3d, 0xcb, 0xf5, 0x23, 0xd3, 0x22, 0x6c, 0xbe,
+	0xa1, 0x87, 0xcd, 0x44, 0xeb, 0x4c, 0x7c, 0x10, 0x29, 0x3c, 0xc7, 0xb5, 0x0e, 0x7d, 0x8e, 0x6b,
+	0x8f, 0x3e, 0xc7, 0x95, 0xbf, 0xe8, 0xc0, 0xd8, 0x17, 0x9d, 0x2b, 0x30, 0x17, 0x1f, 0x04, 0x0e,
+	0xe9, 0xa5, 0x02, 0x1b, 0x33, 0x72, 0xdb, 0x79, 0x68, 0x2e, 0x22, 0x4e, 0x15, 0x22, 0x22, 0xf3,
+	0xd4, 0x59, 0xcd, 0x53, 0xcb, 0xe2, 0x64, 0x6e, 0x6c, 0x9b, 0x5b, 0x78, 0x6c, 0x99, 0x2f, 0x7d,
+	0x6c, 0xf9, 0xaf, 0x69, 0xb6, 0x3e, 0x85, 0xe5, 0x71, 0x56, 0x56, 0xc1, 0x6b, 0xc0, 0xb4, 0x33,
+	0xc0, 0x41, 0x5f, 0x5c, 0x0b, 0x8a, 0xee, 0x5f, 0x0d, 0x27, 0x75, 0x07, 0x6b, 0x7f, 0x04, 0x58,
+	0x1c, 0x56, 0xfd, 0xfc, 0xaf, 0xeb, 0x10, 0xf4, 0x18, 0x16, 0xd2, 0xc7, 0xa9, 0xf4, 0x1a, 0x18,
+	0x4d, 0x7a, 0x79, 0x31, 0x2f, 0x96, 0x4f, 0x4a, 0xd1, 0xac, 0x29, 0xe4, 0xc0, 0xf9, 0x22, 0xc1,
+	0xe1, 0x23, 0xcf, 0xd7, 0x26, 0x50, 0xce, 0xb0, 0x0e, 0x63, 0x71, 0xb5, 0x82, 0x9e, 0xc1, 0x5c,
+	0xfe, 0x29, 0x02, 0xe5, 0xca, 0xa0, 0xd2, 0xd7, 0x11, 0xd3, 0x9a, 0x84, 0x92, 0xc9, 0xff, 0x1c,
+	0xe6, 0x0b, 0xb7, 0xee, 0xc8, 0xca, 0xdf, 0x08, 0x94, 0xbd, 0x5b, 0x98, 0x5f, 0x9d, 0x88, 0x93,
+	0x51, 0xff, 0x00, 0x5a, 0xe9, 0x5d, 0x72, 0x5e, 0xcd, 0x85, 0x1b, 0x66, 0x73, 0x21, 0x4f, 0x6f,
+	0x37, 0xb6, 0xa6, 0xf8, 0x4b, 0x57, 0x7a, 0x57, 0x3a, 0xba, 0x58, 0xbb, 0x41, 0x35, 0x4f, 0x97,
+	0xdc, 0x5a, 0x5a, 0x53, 0xe8, 0xdb, 0x30, 0xc3, 0xbf, 0x76, 0xd4, 0x8f, 0x03, 0x96, 0x3a, 0xf2,
+	0xb7, 0x28, 0x9d, 0xf4, 0xb7, 0x28, 0x9d, 0xbb, 0xfc, 0xb7, 0x28, 0x66, 0xc9, 0xb5, 0xa2, 0x22,
+	0xf0, 0x1c, 0x66, 0x37, 0x09, 0x1b, 0xde, 0x02, 0xa0, 0xcb, 0x47, 0xba, 0x2b, 0x31, 0xad, 0x22,
+	0xda, 0xe8, 0x45, 0x82, 0x35, 0x85, 0x7e, 0x55, 0x81, 0xd3, 0x9b, 0x84, 0x15, 0xfb, 0x6a, 0xf4,
+	0x6e, 0x39, 0x93, 0x31, 0xfd, 0xb7, 0xf9, 0xe8, 0xb8, 0x31, 0x99, 0x27, 0x6b, 0x4d, 0xa1, 0x5f,
+	0x57, 0xe0, 0x9c, 0x26, 0x98, 0xde, 0x28, 0xa3, 0x1b, 0x93, 0x85, 0x2b, 0x69, 0xaa, 0xcd, 0x8f,
+	0x8f, 0xf9, 0x9b, 0x0f, 0x8d, 0xa4, 0x35, 0x85, 0x76, 0x84, 0x4d, 0x86, 0x75, 0x31, 0xba, 0x54,
+	0x5a, 0x00, 0x67, 0xdc, 0x97, 0xc7, 0x4d, 0x67, 0x76, 0xf8, 0x18, 0x66, 0x36, 0x09, 0x4b, 0x0b,
+	0xb4, 0xbc, 0xa7, 0x15, 0x6a, 0x67, 0xf3, 0x62, 0xf9, 0xa4, 0x16, 0x4d, 0x8b, 0x92, 0x96, 0x56,
+	0x84, 0xe4, 0x63, 0xb5, 0xb4, 0x5a, 0x33, 0xad, 0x49, 0x28, 0x19, 0xf5, 0x17, 0xb0, 0x54, 0x9e,
+	0x2a, 0xd1, 0xdb, 0x47, 0x3e, 0x34, 0xcd, 0x6b, 0x47, 0x41, 0x4d, 0x59, 0x7e, 0xb4, 0xfe, 0x97,
+	0x57, 0xcb, 0x95, 0xbf, 0xbe, 0x5a, 0xae, 0xfc, 0xeb, 0xd5, 0x72, 0xe5, 0x7b, 0x37, 0x0f, 0xf9,
+	0x6d, 0x98, 0xf6, 0x73, 0x33, 0x4c, 0x5d, 0xc7, 0x73, 0x49, 0xc0, 0xba, 0x4d, 0x11, 0x6f, 0x37,
+	0xff, 0x3d, 0x00, 0xdc, 0x11, 0xd1, 0x9a, 0x8d, 0x26, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3924,6 +3943,20 @@ func (m *ManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ToolVersion) > 0 {
+		i -= len(m.ToolVersion)
+		copy(dAtA[i:], m.ToolVersion)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.ToolVersion)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ToolName) > 0 {
+		i -= len(m.ToolName)
+		copy(dAtA[i:], m.ToolName)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.ToolName)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Commands) > 0 {
 		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Commands[iNdEx])
@@ -5835,6 +5868,14 @@ func (m *ManifestResponse) Size() (n int) {
 			n += 1 + l + sovRepository(uint64(l))
 		}
 	}
+	l = len(m.ToolName)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.ToolVersion)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8537,6 +8578,70 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToolName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToolName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToolVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToolVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
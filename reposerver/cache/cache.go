@@ -1,7 +1,10 @@
 package cache
 
 import (
+	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -34,6 +37,10 @@ type Cache struct {
 	repoCacheExpiration      time.Duration
 	revisionCacheExpiration  time.Duration
 	revisionCacheLockTimeout time.Duration
+	// gitRefsCacheExpiration overrides the expiration of cached Git references if not zero
+	gitRefsCacheExpiration time.Duration
+	// objectStore stores the chart archives shared with other replicas, if set
+	objectStore cacheutil.ObjectStore
 }
 
 // ClusterRuntimeInfo holds cluster runtime information
@@ -45,17 +52,67 @@ type ClusterRuntimeInfo interface {
 }
 
 func NewCache(cache *cacheutil.Cache, repoCacheExpiration time.Duration, revisionCacheExpiration time.Duration, revisionCacheLockTimeout time.Duration) *Cache {
-	return &Cache{cache, repoCacheExpiration, revisionCacheExpiration, revisionCacheLockTimeout}
+	return &Cache{
+		cache:                    cache,
+		repoCacheExpiration:      repoCacheExpiration,
+		revisionCacheExpiration:  revisionCacheExpiration,
+		revisionCacheLockTimeout: revisionCacheLockTimeout,
+	}
+}
+
+// objectStoreKeyPrefixes are the prefixes of the keys of the entries which are shared through an object store. These
+// entries are expensive to generate and depend on resolved revisions only.
+var objectStoreKeyPrefixes = []string{"mfst|", "appdetails|", "revisionmetadata|"}
+
+// chartObjectStoreTimeout limits the duration of the object store requests of chart archives, which are larger than
+// cache entries
+const chartObjectStoreTimeout = time.Minute
+
+// SetObjectStore shares the generated manifests, app details, revision metadata and Helm chart archives with other
+// replicas through the given object store, so that replicas don't have to regenerate entries which are missing from
+// the cache. Objects expire after the repo cache expiration.
+func (c *Cache) SetObjectStore(store cacheutil.ObjectStore) {
+	c.objectStore = store
+	c.cache.SetClient(cacheutil.NewObjectStoreClient(c.cache.GetClient(), store, objectStoreKeyPrefixes, c.repoCacheExpiration))
+}
+
+// helmChartObjectName returns the name of the object storing the chart archive with the given key
+func helmChartObjectName(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return "helm-charts/" + hex.EncodeToString(h[:]) + ".tgz"
+}
+
+// GetHelmChart returns the chart archive with the given key from the object store, or ErrCacheMiss if the archive or
+// the object store is missing
+func (c *Cache) GetHelmChart(key string) ([]byte, error) {
+	if c.objectStore == nil {
+		return nil, ErrCacheMiss
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), chartObjectStoreTimeout)
+	defer cancel()
+	return c.objectStore.Get(ctx, helmChartObjectName(key))
+}
+
+// SetHelmChart stores the chart archive with the given key in the object store, if any
+func (c *Cache) SetHelmChart(key string, data []byte) error {
+	if c.objectStore == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), chartObjectStoreTimeout)
+	defer cancel()
+	return c.objectStore.Put(ctx, helmChartObjectName(key), data)
 }
 
 func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...cacheutil.Options) func() (*Cache, error) {
 	var repoCacheExpiration time.Duration
 	var revisionCacheExpiration time.Duration
 	var revisionCacheLockTimeout time.Duration
+	var gitRefsCacheExpiration time.Duration
 
 	cmd.Flags().DurationVar(&repoCacheExpiration, "repo-cache-expiration", env.ParseDurationFromEnv("ARGOCD_REPO_CACHE_EXPIRATION", 24*time.Hour, 0, math.MaxInt64), "Cache expiration for repo state, incl. app lists, app details, manifest generation, revision meta-data")
 	cmd.Flags().DurationVar(&revisionCacheExpiration, "revision-cache-expiration", env.ParseDurationFromEnv("ARGOCD_RECONCILIATION_TIMEOUT", 3*time.Minute, 0, math.MaxInt64), "Cache expiration for cached revision")
 	cmd.Flags().DurationVar(&revisionCacheLockTimeout, "revision-cache-lock-timeout", env.ParseDurationFromEnv("ARGOCD_REVISION_CACHE_LOCK_TIMEOUT", 10*time.Second, 0, math.MaxInt64), "Cache TTL for locks to prevent duplicate requests on revisions, set to 0 to disable")
+	cmd.Flags().DurationVar(&gitRefsCacheExpiration, "git-refs-cache-expiration", env.ParseDurationFromEnv("ARGOCD_GIT_REFS_CACHE_EXPIRATION", 0, 0, math.MaxInt64), "Cache expiration for resolved Git references, defaults to the revision cache expiration. Increase it to reduce git ls-remote requests if webhooks are configured for all repositories")
 
 	repoFactory := cacheutil.AddCacheFlagsToCmd(cmd, opts...)
 
@@ -64,7 +121,9 @@ func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...cacheutil.Options) func() (*
 		if err != nil {
 			return nil, fmt.Errorf("error adding cache flags to cmd: %w", err)
 		}
-		return NewCache(cache, repoCacheExpiration, revisionCacheExpiration, revisionCacheLockTimeout), nil
+		c := NewCache(cache, repoCacheExpiration, revisionCacheExpiration, revisionCacheLockTimeout)
+		c.gitRefsCacheExpiration = gitRefsCacheExpiration
+		return c, nil
 	}
 }
 
@@ -141,6 +200,27 @@ func clusterRuntimeInfoKeyUnhashed(info ClusterRuntimeInfo) string {
 	return info.GetKubeVersion() + "|" + strings.Join(apiVersions, ",")
 }
 
+// enabledSourceTypesProvider is implemented by requests which restrict the source types manifests are generated for
+type enabledSourceTypesProvider interface {
+	GetEnabledSourceTypes() map[string]bool
+}
+
+// enabledSourceTypesKey gets the source types enabled or disabled by the request for a cache key, so that cached
+// manifests of a source type are not returned once the project of the application disables it. Returns an empty string
+// if the request does not restrict the source types.
+func enabledSourceTypesKey(info ClusterRuntimeInfo) string {
+	provider, ok := info.(enabledSourceTypesProvider)
+	if !ok {
+		return ""
+	}
+	var sourceTypes []string
+	for sourceType, enabled := range provider.GetEnabledSourceTypes() {
+		sourceTypes = append(sourceTypes, fmt.Sprintf("%s=%t", sourceType, enabled))
+	}
+	sort.Strings(sourceTypes)
+	return strings.Join(sourceTypes, ",")
+}
+
 func listApps(repoURL, revision string) string {
 	return fmt.Sprintf("ldir|%s|%s", repoURL, revision)
 }
@@ -192,7 +272,17 @@ func (c *Cache) SetGitReferences(repo string, references []*plumbing.Reference)
 	for i := range references {
 		input = append(input, references[i].Strings())
 	}
-	return c.cache.SetItem(gitRefsKey(repo), input, &cacheutil.CacheActionOpts{Expiration: c.revisionCacheExpiration})
+	expiration := c.revisionCacheExpiration
+	if c.gitRefsCacheExpiration > 0 {
+		expiration = c.gitRefsCacheExpiration
+	}
+	return c.cache.SetItem(gitRefsKey(repo), input, &cacheutil.CacheActionOpts{Expiration: expiration})
+}
+
+// InvalidateGitReferences deletes the cached references of the given Git repository, so that they are resolved again
+// the next time they are needed
+func (c *Cache) InvalidateGitReferences(repo string) error {
+	return c.cache.SetItem(gitRefsKey(repo), "", &cacheutil.CacheActionOpts{Delete: true})
 }
 
 // Converts raw cache items to plumbing.Reference objects
@@ -297,6 +387,9 @@ func manifestCacheKey(revision string, appSrc *appv1.ApplicationSource, srcRefs
 	//       when the _resolved_ revisions are already part of the key.
 	trackingKey := trackingKey(appLabelKey, trackingMethod)
 	key := fmt.Sprintf("mfst|%s|%s|%s|%s|%d", trackingKey, appName, revision, namespace, appSourceKey(appSrc, srcRefs, refSourceCommitSHAs)+clusterRuntimeInfoKey(info))
+	if sourceTypes := enabledSourceTypesKey(info); sourceTypes != "" {
+		key = fmt.Sprintf("%s|%d", key, hash.FNVa(sourceTypes))
+	}
 	if installationID != "" {
 		key = fmt.Sprintf("%s|%s", key, installationID)
 	}
@@ -322,6 +415,7 @@ func LogDebugManifestCacheKeyFields(message string, reason string, revision stri
 			"trackingKey": trackingKey(appLabelKey, trackingMethod),
 			"appName":     appName,
 			"clusterInfo": clusterRuntimeInfoKeyUnhashed(clusterInfo),
+			"sourceTypes": enabledSourceTypesKey(clusterInfo),
 			"reason":      reason,
 		}).Debug(message)
 	}
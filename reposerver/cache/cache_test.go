@@ -124,6 +124,11 @@ func TestCache_GetManifests(t *testing.T) {
 		err = cache.GetManifests("my-revision", &ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "other-app-label-value", value, map[string]string{"my-referenced-source": "my-referenced-revision"}, "")
 		assert.Equal(t, ErrCacheMiss, err)
 	})
+	t.Run("expect cache miss because of disabled source types", func(t *testing.T) {
+		disabledPlugin := &apiclient.ManifestRequest{EnabledSourceTypes: map[string]bool{"Plugin": false}}
+		err = cache.GetManifests("my-revision", &ApplicationSource{}, q.RefSources, disabledPlugin, "my-namespace", "", "my-app-label-key", "my-app-label-value", value, nil, "")
+		assert.Equal(t, ErrCacheMiss, err)
+	})
 	t.Run("expect cache hit", func(t *testing.T) {
 		err = cache.SetManifests(
 			"my-revision1", &ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value",
@@ -136,7 +141,7 @@ func TestCache_GetManifests(t *testing.T) {
 		assert.Equal(t, "my-source-type", value.ManifestResponse.SourceType)
 		assert.Equal(t, "my-revision1", value.ManifestResponse.Revision)
 	})
-	mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalSets: 2, ExternalGets: 8})
+	mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalSets: 2, ExternalGets: 9})
 }
 
 func TestCache_GetAppDetails(t *testing.T) {
@@ -2,10 +2,19 @@ package metrics
 
 import (
 	"context"
+	"fmt"
 	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/utils/text"
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
 
 	"github.com/argoproj/argo-cd/v2/util/env"
 	"github.com/argoproj/argo-cd/v2/util/git"
@@ -14,6 +23,14 @@ import (
 var (
 	lsRemoteParallelismLimit          = env.ParseInt64FromEnv("ARGOCD_GIT_LS_REMOTE_PARALLELISM_LIMIT", 0, 0, math.MaxInt64)
 	lsRemoteParallelismLimitSemaphore *semaphore.Weighted
+	// gitRequestRateLimiters limits the rate of ls-remote and fetch requests per Git host, so that the rate limits of
+	// Git providers are not exceeded
+	gitRequestRateLimiters = parseGitRequestRateLimits(env.ParseStringToStringFromEnv("ARGOCD_GIT_REQUEST_RATE_LIMITS", nil, ","))
+	// gitRequestBudgets tracks the remaining requests Git hosts allow until their rate limits reset
+	gitRequestBudgets = &gitRequestBudgetTracker{budgets: map[string]gitRequestBudget{}}
+	// gitRequestLowPriorityReserve is the number of remaining requests of the rate limit budget of a Git host which low
+	// priority requests leave to the other requests
+	gitRequestLowPriorityReserve = env.ParseInt64FromEnv("ARGOCD_GIT_REQUEST_LOW_PRIORITY_RESERVE", 100, 0, math.MaxInt64)
 )
 
 func init() {
@@ -22,9 +39,145 @@ func init() {
 	}
 }
 
-// NewGitClientEventHandlers creates event handlers that update Git related metrics
-func NewGitClientEventHandlers(metricsServer *MetricsServer) git.EventHandlers {
+// parseGitRequestRateLimits parses the maximum number of requests per second of Git hosts, e.g. github.com=10
+func parseGitRequestRateLimits(limits map[string]string) map[string]*rate.Limiter {
+	limiters := make(map[string]*rate.Limiter)
+	for host, limit := range limits {
+		requestsPerSecond, err := strconv.ParseFloat(limit, 64)
+		if err != nil || requestsPerSecond <= 0 {
+			log.Warnf("Ignoring invalid Git request rate limit '%s' of host '%s'", limit, host)
+			continue
+		}
+		limiters[strings.ToLower(host)] = rate.NewLimiter(rate.Limit(requestsPerSecond), max(int(requestsPerSecond), 1))
+	}
+	return limiters
+}
+
+// gitHost returns the host name of the given HTTP(S) or SSH repository URL
+func gitHost(repo string) string {
+	if ok, _ := git.IsSSHURL(repo); ok && !strings.HasPrefix(repo, "ssh://") {
+		// git@server:org/repo style URLs are not valid URLs
+		repo = "ssh://" + strings.Replace(repo, ":", "/", 1)
+	}
+	u, err := url.Parse(repo)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Hostname())
+}
+
+// gitRequestBudget is the number of requests a Git host still allows until its rate limit resets
+type gitRequestBudget struct {
+	remaining int64
+	reset     time.Time
+}
+
+// gitRequestBudgetTracker tracks the rate limit budgets of Git hosts as reported by the headers of their responses
+type gitRequestBudgetTracker struct {
+	lock    sync.Mutex
+	budgets map[string]gitRequestBudget
+}
+
+// parseGitRequestBudget parses the rate limit budget reported by the header of a response of a Git host. GitHub reports
+// it with the X-RateLimit-Remaining and X-RateLimit-Reset headers, GitLab with RateLimit-Remaining and RateLimit-Reset,
+// and both report the number of seconds to wait after exceeding the rate limit with Retry-After.
+func parseGitRequestBudget(header http.Header, now time.Time) (gitRequestBudget, bool) {
+	if retryAfter, err := strconv.ParseInt(header.Get("Retry-After"), 10, 64); err == nil {
+		return gitRequestBudget{remaining: 0, reset: now.Add(time.Duration(retryAfter) * time.Second)}, true
+	}
+	remaining, err := strconv.ParseInt(text.FirstNonEmpty(header.Get("X-RateLimit-Remaining"), header.Get("RateLimit-Remaining")), 10, 64)
+	if err != nil {
+		return gitRequestBudget{}, false
+	}
+	reset, err := strconv.ParseInt(text.FirstNonEmpty(header.Get("X-RateLimit-Reset"), header.Get("RateLimit-Reset")), 10, 64)
+	if err != nil {
+		return gitRequestBudget{}, false
+	}
+	return gitRequestBudget{remaining: remaining, reset: time.Unix(reset, 0)}, true
+}
+
+// update records the rate limit budget reported by the given response header of the Git host
+func (t *gitRequestBudgetTracker) update(host string, header http.Header, now time.Time) {
+	budget, ok := parseGitRequestBudget(header, now)
+	if !ok {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.budgets[host] = budget
+}
+
+// delay returns how long a request to the Git host must wait until the rate limit budget of the host allows it, and
+// counts the request against the budget otherwise. Low priority requests leave the reserved part of the budget to the
+// other requests.
+func (t *gitRequestBudgetTracker) delay(host string, lowPriority bool, now time.Time) time.Duration {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	budget, ok := t.budgets[host]
+	if !ok || !budget.reset.After(now) {
+		return 0
+	}
+	var reserve int64
+	if lowPriority {
+		reserve = gitRequestLowPriorityReserve
+	}
+	if budget.remaining > reserve {
+		budget.remaining--
+		t.budgets[host] = budget
+		return 0
+	}
+	return budget.reset.Sub(now)
+}
+
+type lowGitRequestPriorityKey struct{}
+
+// WithLowGitRequestPriority returns a copy of the context whose Git requests are low priority. Low priority requests
+// only use the part of the rate limit budget of a Git host which is not reserved for other requests.
+func WithLowGitRequestPriority(ctx context.Context) context.Context {
+	return context.WithValue(ctx, lowGitRequestPriorityKey{}, true)
+}
+
+func isLowGitRequestPriority(ctx context.Context) bool {
+	lowPriority, _ := ctx.Value(lowGitRequestPriorityKey{}).(bool)
+	return lowPriority
+}
+
+// waitForGitRequest blocks until a request to the host of the given repository is allowed by the rate limit budget
+// reported by the host and by the configured rate limit of the host. Returns an error without waiting if the budget
+// does not allow the request before the deadline of the context, or once the context is done.
+func waitForGitRequest(ctx context.Context, repo string) error {
+	host := gitHost(repo)
+	if delay := gitRequestBudgets.delay(host, isLowGitRequestPriority(ctx), time.Now()); delay > 0 {
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return fmt.Errorf("rate limit budget of Git host %s is exhausted for %s", host, delay.Round(time.Second))
+		}
+		log.Debugf("Waiting %s for the rate limit budget of Git host %s", delay.Round(time.Second), host)
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if limiter, ok := gitRequestRateLimiters[host]; ok {
+		if err := limiter.Wait(ctx); err != nil {
+			return fmt.Errorf("error waiting for the request rate limit of Git host %s: %w", host, err)
+		}
+	}
+	return nil
+}
+
+// NewGitClientEventHandlers creates event handlers that update Git related metrics and limit the rate of Git requests
+// of the request with the given context
+func NewGitClientEventHandlers(ctx context.Context, metricsServer *MetricsServer) git.EventHandlers {
 	return git.EventHandlers{
+		OnRequest: func(repo string) error {
+			return waitForGitRequest(ctx, repo)
+		},
+		OnResponseHeader: func(repo string, header http.Header) {
+			gitRequestBudgets.update(gitHost(repo), header, time.Now())
+		},
 		OnFetch: func(repo string) func() {
 			startTime := time.Now()
 			metricsServer.IncGitRequest(repo, GitRequestTypeFetch)
@@ -32,6 +185,9 @@ func NewGitClientEventHandlers(metricsServer *MetricsServer) git.EventHandlers {
 				metricsServer.ObserveGitRequestDuration(repo, GitRequestTypeFetch, time.Since(startTime))
 			}
 		},
+		OnFetchSize: func(repo string, size int64) {
+			metricsServer.ObserveGitFetchSize(repo, size)
+		},
 		OnLsRemote: func(repo string) func() {
 			startTime := time.Now()
 			metricsServer.IncGitRequest(repo, GitRequestTypeLsRemote)
@@ -1,11 +1,17 @@
 package metrics
 
 import (
+	"context"
+	"net/http"
 	"os"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
 )
 
 func TestMain(m *testing.M) {
@@ -25,7 +31,7 @@ func TestEdgeCasesAndErrorHandling(t *testing.T) {
 				t.Helper()
 				lsRemoteParallelismLimitSemaphore = nil
 				assert.NotPanics(t, func() {
-					NewGitClientEventHandlers(&MetricsServer{})
+					NewGitClientEventHandlers(context.Background(), &MetricsServer{})
 				})
 			},
 		},
@@ -40,7 +46,7 @@ func TestEdgeCasesAndErrorHandling(t *testing.T) {
 			testFunc: func(t *testing.T) {
 				t.Helper()
 				assert.NotPanics(t, func() {
-					NewGitClientEventHandlers(&MetricsServer{})
+					NewGitClientEventHandlers(context.Background(), &MetricsServer{})
 				})
 			},
 		},
@@ -55,7 +61,7 @@ func TestEdgeCasesAndErrorHandling(t *testing.T) {
 			testFunc: func(t *testing.T) {
 				t.Helper()
 				assert.NotPanics(t, func() {
-					NewGitClientEventHandlers(&MetricsServer{})
+					NewGitClientEventHandlers(context.Background(), &MetricsServer{})
 				})
 			},
 		},
@@ -93,7 +99,7 @@ func TestSemaphoreFunctionality(t *testing.T) {
 			testFunc: func(t *testing.T) {
 				t.Helper()
 				assert.NotPanics(t, func() {
-					NewGitClientEventHandlers(&MetricsServer{})
+					NewGitClientEventHandlers(context.Background(), &MetricsServer{})
 				})
 			},
 		},
@@ -108,7 +114,7 @@ func TestSemaphoreFunctionality(t *testing.T) {
 			testFunc: func(t *testing.T) {
 				t.Helper()
 				assert.NotPanics(t, func() {
-					NewGitClientEventHandlers(&MetricsServer{})
+					NewGitClientEventHandlers(context.Background(), &MetricsServer{})
 				})
 			},
 		},
@@ -125,3 +131,94 @@ func TestSemaphoreFunctionality(t *testing.T) {
 		})
 	}
 }
+
+func TestGitHost(t *testing.T) {
+	assert.Equal(t, "github.com", gitHost("https://github.com/argoproj/argo-cd.git"))
+	assert.Equal(t, "gitlab.example.com", gitHost("https://user@GitLab.example.com:8443/group/repo"))
+	assert.Equal(t, "github.com", gitHost("git@github.com:argoproj/argo-cd.git"))
+	assert.Equal(t, "github.com", gitHost("ssh://git@github.com:22/argoproj/argo-cd.git"))
+}
+
+func TestParseGitRequestRateLimits(t *testing.T) {
+	limiters := parseGitRequestRateLimits(map[string]string{
+		"GitHub.com":         "10",
+		"gitlab.example.com": "0.5",
+		"invalid.example":    "fast",
+		"zero.example":       "0",
+	})
+	require.Len(t, limiters, 2)
+	assert.Equal(t, rate.Limit(10), limiters["github.com"].Limit())
+	assert.Equal(t, 10, limiters["github.com"].Burst())
+	assert.Equal(t, rate.Limit(0.5), limiters["gitlab.example.com"].Limit())
+	assert.Equal(t, 1, limiters["gitlab.example.com"].Burst())
+}
+
+func TestWaitForGitRequest(t *testing.T) {
+	gitRequestRateLimiters = map[string]*rate.Limiter{"github.com": rate.NewLimiter(rate.Every(time.Hour), 1)}
+	defer func() {
+		gitRequestRateLimiters = map[string]*rate.Limiter{}
+	}()
+
+	start := time.Now()
+	require.NoError(t, waitForGitRequest(context.Background(), "https://github.com/argoproj/argo-cd.git"))
+	require.NoError(t, waitForGitRequest(context.Background(), "https://gitlab.com/argoproj/argo-cd.git"))
+	assert.Less(t, time.Since(start), time.Second, "requests within the burst and to other hosts must not wait")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	err := waitForGitRequest(ctx, "https://github.com/argoproj/argo-cd.git")
+	require.ErrorIs(t, err, context.Canceled, "requests over the limit must not be made once the context is canceled")
+}
+
+func TestParseGitRequestBudget(t *testing.T) {
+	now := time.Now()
+
+	budget, ok := parseGitRequestBudget(http.Header{"X-Ratelimit-Remaining": {"42"}, "X-Ratelimit-Reset": {"1700000000"}}, now)
+	require.True(t, ok)
+	assert.Equal(t, gitRequestBudget{remaining: 42, reset: time.Unix(1700000000, 0)}, budget)
+
+	budget, ok = parseGitRequestBudget(http.Header{"Ratelimit-Remaining": {"7"}, "Ratelimit-Reset": {"1700000000"}}, now)
+	require.True(t, ok)
+	assert.Equal(t, gitRequestBudget{remaining: 7, reset: time.Unix(1700000000, 0)}, budget)
+
+	budget, ok = parseGitRequestBudget(http.Header{"Retry-After": {"60"}}, now)
+	require.True(t, ok)
+	assert.Equal(t, gitRequestBudget{remaining: 0, reset: now.Add(time.Minute)}, budget)
+
+	_, ok = parseGitRequestBudget(http.Header{}, now)
+	assert.False(t, ok)
+}
+
+func TestGitRequestBudgetTracker(t *testing.T) {
+	defer func(reserve int64) { gitRequestLowPriorityReserve = reserve }(gitRequestLowPriorityReserve)
+	gitRequestLowPriorityReserve = 1
+
+	now := time.Now()
+	reset := now.Add(time.Minute).Unix()
+	tracker := &gitRequestBudgetTracker{budgets: map[string]gitRequestBudget{}}
+	tracker.update("github.com", http.Header{"X-Ratelimit-Remaining": {"2"}, "X-Ratelimit-Reset": {strconv.FormatInt(reset, 10)}}, now)
+
+	assert.Zero(t, tracker.delay("gitlab.com", true, now), "hosts without a reported budget are not limited")
+	assert.Zero(t, tracker.delay("github.com", true, now))
+	assert.Positive(t, tracker.delay("github.com", true, now), "low priority requests must leave the reserve to other requests")
+	assert.Zero(t, tracker.delay("github.com", false, now))
+	assert.Positive(t, tracker.delay("github.com", false, now), "requests must wait once the budget is exhausted")
+	assert.Zero(t, tracker.delay("github.com", false, time.Unix(reset, 0).Add(time.Second)), "budgets are no longer applied once they reset")
+}
+
+func TestWaitForGitRequest_ExhaustedBudget(t *testing.T) {
+	defer func() {
+		gitRequestBudgets = &gitRequestBudgetTracker{budgets: map[string]gitRequestBudget{}}
+	}()
+	gitRequestBudgets.update("github.com", http.Header{"Retry-After": {"3600"}}, time.Now())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	start := time.Now()
+	err := waitForGitRequest(WithLowGitRequestPriority(ctx), "https://github.com/argoproj/argo-cd.git")
+	require.ErrorContains(t, err, "rate limit budget of Git host github.com is exhausted")
+	assert.Less(t, time.Since(start), time.Second, "requests must fail immediately if the budget does not reset before the deadline")
+}
@@ -16,6 +16,7 @@ type MetricsServer struct {
 	gitLsRemoteFailCounter   *prometheus.CounterVec
 	gitRequestCounter        *prometheus.CounterVec
 	gitRequestHistogram      *prometheus.HistogramVec
+	gitFetchSizeHistogram    *prometheus.HistogramVec
 	repoPendingRequestsGauge *prometheus.GaugeVec
 	redisRequestCounter      *prometheus.CounterVec
 	redisRequestHistogram    *prometheus.HistogramVec
@@ -71,6 +72,16 @@ func NewMetricsServer() *MetricsServer {
 	)
 	registry.MustRegister(gitRequestHistogram)
 
+	gitFetchSizeHistogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_git_fetch_size_bytes",
+			Help:    "Size in bytes of the objects downloaded by git fetch requests.",
+			Buckets: prometheus.ExponentialBuckets(1024, 4, 11),
+		},
+		[]string{"repo"},
+	)
+	registry.MustRegister(gitFetchSizeHistogram)
+
 	repoPendingRequestsGauge := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "argocd_repo_pending_request_total",
@@ -105,6 +116,7 @@ func NewMetricsServer() *MetricsServer {
 		gitLsRemoteFailCounter:   gitLsRemoteFailCounter,
 		gitRequestCounter:        gitRequestCounter,
 		gitRequestHistogram:      gitRequestHistogram,
+		gitFetchSizeHistogram:    gitFetchSizeHistogram,
 		repoPendingRequestsGauge: repoPendingRequestsGauge,
 		redisRequestCounter:      redisRequestCounter,
 		redisRequestHistogram:    redisRequestHistogram,
@@ -136,6 +148,11 @@ func (m *MetricsServer) ObserveGitRequestDuration(repo string, requestType GitRe
 	m.gitRequestHistogram.WithLabelValues(repo, string(requestType)).Observe(duration.Seconds())
 }
 
+// ObserveGitFetchSize observes the size in bytes of the objects downloaded by a git fetch request
+func (m *MetricsServer) ObserveGitFetchSize(repo string, size int64) {
+	m.gitFetchSizeHistogram.WithLabelValues(repo).Observe(float64(size))
+}
+
 func (m *MetricsServer) DecPendingRepoRequest(repo string) {
 	m.repoPendingRequestsGauge.WithLabelValues(repo).Dec()
 }
@@ -1956,8 +1956,11 @@ func mkTempParameters(source string) string {
 func runWithTempTestdata(t *testing.T, path string, runner func(t *testing.T, path string)) {
 	t.Helper()
 	tempDir := mkTempParameters("./testdata/app-parameters")
+	// clean up in t.Cleanup, so that the copy is removed even if the runner fails the test
+	t.Cleanup(func() {
+		os.RemoveAll(tempDir)
+	})
 	runner(t, filepath.Join(tempDir, "app-parameters", path))
-	os.RemoveAll(tempDir)
 }
 
 func TestGenerateManifestsWithAppParameterFile(t *testing.T) {
@@ -4237,6 +4240,30 @@ func Test_GenerateManifests_Commands(t *testing.T) {
 
 		require.NoError(t, err)
 		assert.Equal(t, []string{"helm template . --name-template test-app --namespace test-namespace --include-crds"}, res.Commands)
+		assert.Equal(t, "helm", res.ToolName)
+	})
+
+	t.Run("directory", func(t *testing.T) {
+		service := newService(t, "testdata/recurse")
+
+		q := apiclient.ManifestRequest{
+			AppName:   "test-app",
+			Namespace: "test-namespace",
+			Repo:      &argoappv1.Repository{},
+			ApplicationSource: &argoappv1.ApplicationSource{
+				Path:      ".",
+				Directory: &argoappv1.ApplicationSourceDirectory{Recurse: true, Exclude: "*.json"},
+			},
+			ProjectName:        "something",
+			ProjectSourceRepos: []string{"*"},
+		}
+
+		res, err := service.GenerateManifest(context.Background(), &q)
+
+		require.NoError(t, err)
+		assert.Empty(t, res.Commands, "no command is run for directories")
+		assert.Equal(t, "argocd", res.ToolName)
+		assert.Equal(t, common.GetVersion().Version, res.ToolVersion)
 	})
 
 	t.Run("kustomize", func(t *testing.T) {
@@ -1,6 +1,7 @@
 package application
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -345,6 +346,10 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 		return nil, security.NamespaceNotPermittedError(appNs)
 	}
 
+	if err := s.validateProjectQuota(proj, a, appNs); err != nil {
+		return nil, err
+	}
+
 	// Don't let the app creator set the operation explicitly. Those requests should always go through the Sync API.
 	if a.Operation != nil {
 		log.WithFields(log.Fields{
@@ -390,6 +395,33 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 	return updated, nil
 }
 
+// validateProjectQuota returns an error if creating or updating the given application increases the usage of the
+// given project beyond its quota. Managed resources are enforced by the controller when syncs start.
+func (s *Server) validateProjectQuota(proj *appv1.AppProject, a *appv1.Application, appNs string) error {
+	apps, err := s.appLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("error listing applications: %w", err)
+	}
+	updated := a
+	updatedApps := make([]*appv1.Application, 0, len(apps)+1)
+	for _, existing := range apps {
+		if existing.Namespace == appNs && existing.Name == a.Name {
+			if a.Status.Resources == nil {
+				// a new spec of an existing application keeps managing its resources until it is synced
+				updated = a.DeepCopy()
+				updated.Status.Resources = existing.Status.Resources
+			}
+			continue
+		}
+		updatedApps = append(updatedApps, existing)
+	}
+	updatedApps = append(updatedApps, updated)
+	if err := argo.ValidateProjectQuota(proj, argo.GetProjectUsage(proj, apps), argo.GetProjectUsage(proj, updatedApps)); err != nil {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return nil
+}
+
 func (s *Server) queryRepoServer(ctx context.Context, proj *appv1.AppProject, action func(
 	client apiclient.RepoServerServiceClient,
 	helmRepos []*appv1.Repository,
@@ -429,6 +461,7 @@ func (s *Server) queryRepoServer(ctx context.Context, proj *appv1.AppProject, ac
 	if err != nil {
 		return fmt.Errorf("error getting settings enabled source types: %w", err)
 	}
+	enabledSourceTypes = argo.GetProjectEnabledSourceTypes(proj, enabledSourceTypes)
 	return action(client, permittedHelmRepos, permittedHelmCredentials, helmOptions, enabledSourceTypes)
 }
 
@@ -549,7 +582,7 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 	}
 
 	manifests := &apiclient.ManifestResponse{}
-	for _, manifestInfo := range manifestInfos {
+	for i, manifestInfo := range manifestInfos {
 		for i, manifest := range manifestInfo.Manifests {
 			obj := &unstructured.Unstructured{}
 			err = json.Unmarshal([]byte(manifest), obj)
@@ -569,6 +602,14 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			}
 		}
 		manifests.Manifests = append(manifests.Manifests, manifestInfo.Manifests...)
+		// expose the commands used to generate the manifests, so users can reproduce them locally
+		manifests.Commands = append(manifests.Commands, manifestInfo.Commands...)
+		// the tool is only reported if all sources were generated by the same tool
+		if i == 0 || (manifests.ToolName == manifestInfo.ToolName && manifests.ToolVersion == manifestInfo.ToolVersion) {
+			manifests.ToolName, manifests.ToolVersion = manifestInfo.ToolName, manifestInfo.ToolVersion
+		} else {
+			manifests.ToolName, manifests.ToolVersion = "", ""
+		}
 	}
 
 	return manifests, nil
@@ -887,6 +928,17 @@ func (s *Server) validateAndUpdateApp(ctx context.Context, newApp *appv1.Applica
 		return nil, fmt.Errorf("error validating and normalizing app: %w", err)
 	}
 
+	newProj := proj
+	if newApp.Spec.GetProject() != app.Spec.GetProject() {
+		newProj, err = s.getAppProject(ctx, newApp, log.WithField("application", newApp.Name))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := s.validateProjectQuota(newProj, newApp, app.Namespace); err != nil {
+		return nil, err
+	}
+
 	a, err := s.updateApp(app, newApp, ctx, merge)
 	if err != nil {
 		return nil, fmt.Errorf("error updating application: %w", err)
@@ -1481,7 +1533,42 @@ func (s *Server) ResourceTree(ctx context.Context, q *application.ResourcesQuery
 		return nil, err
 	}
 
-	return s.getAppResources(ctx, a)
+	tree, err := s.getAppResources(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	return filterResourceTree(q, tree), nil
+}
+
+// filterResourceTree returns the nodes of the given tree matching the namespace, group, kind, resource name, health
+// and search text of the given query, so that clients interested in a few resources of large applications don't have
+// to receive the whole tree. The name of the query is ignored, since older clients set it to the name of the application.
+func filterResourceTree(q *application.ResourcesQuery, tree *appv1.ApplicationTree) *appv1.ApplicationTree {
+	if q.GetNamespace() == "" && q.GetGroup() == "" && q.GetKind() == "" && q.GetResourceName() == "" && q.GetHealth() == "" && q.GetSearch() == "" {
+		return tree
+	}
+	filter := &application.ResourcesQuery{Name: q.ResourceName, Namespace: q.Namespace, Group: q.Group, Kind: q.Kind}
+	search := strings.ToLower(q.GetSearch())
+	filterNodes := func(nodes []appv1.ResourceNode) []appv1.ResourceNode {
+		var res []appv1.ResourceNode
+		for _, node := range nodes {
+			if !isMatchingResource(filter, kube.ResourceKey{Name: node.Name, Namespace: node.Namespace, Kind: node.Kind, Group: node.Group}) {
+				continue
+			}
+			if q.GetHealth() != "" && (node.Health == nil || !strings.EqualFold(string(node.Health.Status), q.GetHealth())) {
+				continue
+			}
+			if search != "" && !strings.Contains(strings.ToLower(strings.Join([]string{node.Group, node.Kind, node.Namespace, node.Name}, "/")), search) {
+				continue
+			}
+			res = append(res, node)
+		}
+		return res
+	}
+	filtered := *tree
+	filtered.Nodes = filterNodes(tree.Nodes)
+	filtered.OrphanedNodes = filterNodes(tree.OrphanedNodes)
+	return &filtered
 }
 
 func (s *Server) WatchResourceTree(q *application.ResourcesQuery, ws application.ApplicationService_WatchResourceTreeServer) error {
@@ -1491,16 +1578,100 @@ func (s *Server) WatchResourceTree(q *application.ResourcesQuery, ws application
 	}
 
 	cacheKey := argo.AppInstanceName(q.GetApplicationName(), q.GetAppNamespace(), s.ns)
+	sender := &resourceTreeSender{send: ws.Send, incremental: q.GetIncremental()}
 	return s.cache.OnAppResourcesTreeChanged(ws.Context(), cacheKey, func() error {
 		var tree appv1.ApplicationTree
 		err := s.cache.GetAppResourcesTree(cacheKey, &tree)
 		if err != nil {
 			return fmt.Errorf("error getting app resource tree: %w", err)
 		}
-		return ws.Send(&tree)
+		return sender.Send(filterResourceTree(q, &tree))
 	})
 }
 
+// resourceTreeSender sends resource trees to a watch stream. The controller publishes the tree after every
+// reconciliation, so trees identical to the previously sent one are skipped to save API server and network load. If
+// incremental is set, only the first tree is sent in full and the following ones only contain the nodes which were
+// added or updated, along with references to the deleted ones. Clients apply an update by removing the deleted nodes
+// and then replacing the nodes having the same group, kind, namespace and name as the sent ones.
+type resourceTreeSender struct {
+	send        func(*appv1.ApplicationTree) error
+	incremental bool
+	last        []byte
+	// lastNodes holds the marshaled nodes of the last sent tree, and lastKeys their order
+	lastNodes map[resourceTreeNodeKey]resourceTreeNode
+	lastKeys  []resourceTreeNodeKey
+}
+
+type resourceTreeNodeKey struct {
+	kube.ResourceKey
+	orphaned bool
+}
+
+type resourceTreeNode struct {
+	ref  appv1.ResourceRef
+	data []byte
+}
+
+func (s *resourceTreeSender) Send(tree *appv1.ApplicationTree) error {
+	data, err := tree.Marshal()
+	if err != nil {
+		return fmt.Errorf("error marshaling app resource tree: %w", err)
+	}
+	if s.last != nil && bytes.Equal(s.last, data) {
+		return nil
+	}
+	if !s.incremental {
+		if err := s.send(tree); err != nil {
+			return err
+		}
+		s.last = data
+		return nil
+	}
+
+	nodes := make(map[resourceTreeNodeKey]resourceTreeNode, len(tree.Nodes)+len(tree.OrphanedNodes))
+	keys := make([]resourceTreeNodeKey, 0, len(tree.Nodes)+len(tree.OrphanedNodes))
+	update := &appv1.ApplicationTree{Hosts: tree.Hosts, ShardsCount: tree.ShardsCount}
+	for _, orphaned := range []bool{false, true} {
+		treeNodes := tree.Nodes
+		if orphaned {
+			treeNodes = tree.OrphanedNodes
+		}
+		for _, node := range treeNodes {
+			nodeData, err := node.Marshal()
+			if err != nil {
+				return fmt.Errorf("error marshaling app resource tree node: %w", err)
+			}
+			key := resourceTreeNodeKey{ResourceKey: kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name), orphaned: orphaned}
+			nodes[key] = resourceTreeNode{ref: node.ResourceRef, data: nodeData}
+			keys = append(keys, key)
+			if last, ok := s.lastNodes[key]; ok && bytes.Equal(last.data, nodeData) {
+				continue
+			}
+			if orphaned {
+				update.OrphanedNodes = append(update.OrphanedNodes, node)
+			} else {
+				update.Nodes = append(update.Nodes, node)
+			}
+		}
+	}
+	for _, key := range s.lastKeys {
+		if _, ok := nodes[key]; !ok {
+			update.DeletedNodes = append(update.DeletedNodes, s.lastNodes[key].ref)
+		}
+	}
+	if s.lastNodes == nil {
+		update = tree
+	}
+	if err := s.send(update); err != nil {
+		return err
+	}
+	s.last = data
+	s.lastNodes = nodes
+	s.lastKeys = keys
+	return nil
+}
+
 func (s *Server) RevisionMetadata(ctx context.Context, q *application.RevisionMetadataQuery) (*appv1.RevisionMetadata, error) {
 	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbacpolicy.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
 	if err != nil {
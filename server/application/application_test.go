@@ -110,7 +110,7 @@ func fakeResolveRevisionResponseHelm() *apiclient.ResolveRevisionResponse {
 func fakeRepoServerClient(isHelm bool) *mocks.RepoServerServiceClient {
 	mockRepoServiceClient := mocks.RepoServerServiceClient{}
 	mockRepoServiceClient.On("ListApps", mock.Anything, mock.Anything).Return(fakeAppList(), nil)
-	mockRepoServiceClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{}, nil)
+	mockRepoServiceClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{Commands: []string{"helm template . --name-template test"}}, nil)
 	mockRepoServiceClient.On("GetAppDetails", mock.Anything, mock.Anything).Return(&apiclient.RepoAppDetailsResponse{}, nil)
 	mockRepoServiceClient.On("TestRepository", mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{}, nil)
 	mockRepoServiceClient.On("GetRevisionMetadata", mock.Anything, mock.Anything).Return(&appsv1.RevisionMetadata{}, nil)
@@ -659,6 +659,120 @@ func (t *TestResourceTreeServer) RecvMsg(m interface{}) error {
 	return nil
 }
 
+func TestResourceTreeSender(t *testing.T) {
+	var sent []*appsv1.ApplicationTree
+	sender := &resourceTreeSender{send: func(tree *appsv1.ApplicationTree) error {
+		sent = append(sent, tree)
+		return nil
+	}}
+	tree := &appsv1.ApplicationTree{Nodes: []appsv1.ResourceNode{{ResourceRef: appsv1.ResourceRef{Kind: "Pod", Name: "pod-1"}}}}
+
+	require.NoError(t, sender.Send(tree))
+	require.NoError(t, sender.Send(tree.DeepCopy()))
+	assert.Len(t, sent, 1)
+
+	changed := tree.DeepCopy()
+	changed.Nodes = append(changed.Nodes, appsv1.ResourceNode{ResourceRef: appsv1.ResourceRef{Kind: "Pod", Name: "pod-2"}})
+	require.NoError(t, sender.Send(changed))
+	assert.Len(t, sent, 2)
+
+	sender.send = func(*appsv1.ApplicationTree) error {
+		return fmt.Errorf("stream closed")
+	}
+	require.Error(t, sender.Send(tree))
+	sender.send = func(tree *appsv1.ApplicationTree) error {
+		sent = append(sent, tree)
+		return nil
+	}
+	// a tree which failed to be sent is not skipped
+	require.NoError(t, sender.Send(tree))
+	assert.Len(t, sent, 3)
+}
+
+func TestResourceTreeSender_Incremental(t *testing.T) {
+	var sent []*appsv1.ApplicationTree
+	sender := &resourceTreeSender{incremental: true, send: func(tree *appsv1.ApplicationTree) error {
+		sent = append(sent, tree)
+		return nil
+	}}
+	tree := &appsv1.ApplicationTree{
+		Nodes: []appsv1.ResourceNode{
+			{ResourceRef: appsv1.ResourceRef{Kind: "Pod", Name: "pod-1"}},
+			{ResourceRef: appsv1.ResourceRef{Kind: "Pod", Name: "pod-2"}},
+		},
+		OrphanedNodes: []appsv1.ResourceNode{{ResourceRef: appsv1.ResourceRef{Kind: "ConfigMap", Name: "orphan"}}},
+		Hosts:         []appsv1.HostInfo{{Name: "node-1"}},
+	}
+
+	require.NoError(t, sender.Send(tree))
+	require.Len(t, sent, 1)
+	assert.Same(t, tree, sent[0], "the first tree is sent in full")
+
+	changed := tree.DeepCopy()
+	changed.Nodes[0].Health = &appsv1.HealthStatus{Status: health.HealthStatusDegraded}
+	changed.Nodes = append(changed.Nodes[:1], appsv1.ResourceNode{ResourceRef: appsv1.ResourceRef{Kind: "Pod", Name: "pod-3"}})
+	require.NoError(t, sender.Send(changed))
+	require.Len(t, sent, 2)
+	update := sent[1]
+	require.Len(t, update.Nodes, 2)
+	assert.Equal(t, "pod-1", update.Nodes[0].Name)
+	assert.Equal(t, "pod-3", update.Nodes[1].Name)
+	assert.Empty(t, update.OrphanedNodes, "unchanged nodes are not sent")
+	assert.Equal(t, []appsv1.ResourceRef{{Kind: "Pod", Name: "pod-2"}}, update.DeletedNodes)
+	assert.Equal(t, tree.Hosts, update.Hosts)
+
+	adopted := changed.DeepCopy()
+	adopted.Nodes = append(adopted.Nodes, adopted.OrphanedNodes...)
+	adopted.OrphanedNodes = nil
+	require.NoError(t, sender.Send(adopted))
+	require.Len(t, sent, 3)
+	update = sent[2]
+	require.Len(t, update.Nodes, 1)
+	assert.Equal(t, "orphan", update.Nodes[0].Name)
+	assert.Equal(t, []appsv1.ResourceRef{{Kind: "ConfigMap", Name: "orphan"}}, update.DeletedNodes, "nodes which are no longer orphaned are deleted before being added")
+}
+
+func TestFilterResourceTree(t *testing.T) {
+	tree := &appsv1.ApplicationTree{
+		Nodes: []appsv1.ResourceNode{
+			{ResourceRef: appsv1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook"}},
+			{ResourceRef: appsv1.ResourceRef{Kind: "Pod", Namespace: "default", Name: "guestbook-1"}, Health: &appsv1.HealthStatus{Status: health.HealthStatusDegraded}},
+			{ResourceRef: appsv1.ResourceRef{Kind: "Pod", Namespace: "other", Name: "guestbook-2"}, Health: &appsv1.HealthStatus{Status: health.HealthStatusHealthy}},
+		},
+		OrphanedNodes: []appsv1.ResourceNode{
+			{ResourceRef: appsv1.ResourceRef{Kind: "ConfigMap", Namespace: "default", Name: "orphan"}},
+		},
+	}
+
+	assert.Same(t, tree, filterResourceTree(&application.ResourcesQuery{ApplicationName: ptr.To("guestbook"), Name: ptr.To("guestbook")}, tree))
+
+	filtered := filterResourceTree(&application.ResourcesQuery{Kind: ptr.To("Pod")}, tree)
+	require.Len(t, filtered.Nodes, 2)
+	assert.Equal(t, "guestbook-1", filtered.Nodes[0].Name)
+	assert.Equal(t, "guestbook-2", filtered.Nodes[1].Name)
+	assert.Empty(t, filtered.OrphanedNodes)
+
+	filtered = filterResourceTree(&application.ResourcesQuery{Namespace: ptr.To("default"), Group: ptr.To("")}, tree)
+	require.Len(t, filtered.Nodes, 2)
+	require.Len(t, filtered.OrphanedNodes, 1)
+	assert.Len(t, tree.Nodes, 3, "the original tree must not be modified")
+
+	filtered = filterResourceTree(&application.ResourcesQuery{ResourceName: ptr.To("guestbook-2")}, tree)
+	require.Len(t, filtered.Nodes, 1)
+	assert.Equal(t, "other", filtered.Nodes[0].Namespace)
+
+	filtered = filterResourceTree(&application.ResourcesQuery{Health: ptr.To("degraded")}, tree)
+	require.Len(t, filtered.Nodes, 1)
+	assert.Equal(t, "guestbook-1", filtered.Nodes[0].Name)
+	assert.Empty(t, filtered.OrphanedNodes)
+
+	filtered = filterResourceTree(&application.ResourcesQuery{Search: ptr.To("GUESTBOOK-")}, tree)
+	require.Len(t, filtered.Nodes, 2)
+	filtered = filterResourceTree(&application.ResourcesQuery{Search: ptr.To("configmap")}, tree)
+	assert.Empty(t, filtered.Nodes)
+	require.Len(t, filtered.OrphanedNodes, 1)
+}
+
 type TestPodLogsServer struct {
 	ctx context.Context
 }
@@ -818,8 +932,9 @@ func TestNoAppEnumeration(t *testing.T) {
 	})
 
 	t.Run("GetManifests", func(t *testing.T) {
-		_, err := appServer.GetManifests(adminCtx, &application.ApplicationManifestQuery{Name: ptr.To("test")})
+		res, err := appServer.GetManifests(adminCtx, &application.ApplicationManifestQuery{Name: ptr.To("test")})
 		require.NoError(t, err)
+		assert.Equal(t, []string{"helm template . --name-template test"}, res.Commands)
 		_, err = appServer.GetManifests(noRoleCtx, &application.ApplicationManifestQuery{Name: ptr.To("test")})
 		assert.Equal(t, permissionDeniedErr.Error(), err.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
 		_, err = appServer.GetManifests(adminCtx, &application.ApplicationManifestQuery{Name: ptr.To("doest-not-exist")})
@@ -1496,6 +1611,78 @@ func TestCreateApp(t *testing.T) {
 	assert.Equal(t, "default", app.Spec.Project)
 }
 
+func TestCreateAppExceedingProjectQuota(t *testing.T) {
+	quotaProj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "quota-proj",
+			Namespace:   "default",
+			Annotations: map[string]string{common.AnnotationKeyQuotaMaxApplications: "1"},
+		},
+		Spec: appsv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+	existingApp := newTestApp(func(app *appsv1.Application) {
+		app.Name = "existing-app"
+		app.Spec.Project = "quota-proj"
+	})
+	appServer := newTestAppServer(t, quotaProj, existingApp)
+
+	testApp := newTestApp(func(app *appsv1.Application) {
+		app.Spec.Project = "quota-proj"
+	})
+	_, err := appServer.Create(context.Background(), &application.ApplicationCreateRequest{Application: testApp})
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, err.Error(), "project quota-proj exceeds its quota of 1 applications: 2 applications")
+
+	// updating the existing application does not count it twice
+	existingApp.Spec.Destination.Namespace = "other-ns"
+	upsert := true
+	_, err = appServer.Create(context.Background(), &application.ApplicationCreateRequest{Application: existingApp, Upsert: &upsert})
+	require.NoError(t, err)
+}
+
+func TestUpdateAppExceedingProjectQuota(t *testing.T) {
+	quotaProj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "quota-proj",
+			Namespace:   "default",
+			Annotations: map[string]string{common.AnnotationKeyQuotaMaxClusters: "1", common.AnnotationKeyQuotaMaxApplications: "1"},
+		},
+		Spec: appsv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+	existingApp := newTestApp(func(app *appsv1.Application) {
+		app.Name = "existing-app"
+		app.Spec.Project = "quota-proj"
+	})
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, quotaProj, existingApp, testApp)
+
+	// moving an application to a project which reached its quota is rejected
+	testApp.Spec.Project = "quota-proj"
+	_, err := appServer.Update(context.Background(), &application.ApplicationUpdateRequest{Application: testApp})
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	_, err = appServer.UpdateSpec(context.Background(), &application.ApplicationUpdateSpecRequest{Name: &testApp.Name, Spec: &testApp.Spec})
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// changes which keep the usage of the project are allowed
+	existingApp.Spec.Destination.Namespace = "other-ns"
+	_, err = appServer.Update(context.Background(), &application.ApplicationUpdateRequest{Application: existingApp})
+	require.NoError(t, err)
+
+	patch := `[{"op": "replace", "path": "/spec/destination/namespace", "value": "patched-ns"}]`
+	_, err = appServer.Patch(context.Background(), &application.ApplicationPatchRequest{Name: &existingApp.Name, Patch: &patch, PatchType: ptr.To("json")})
+	require.NoError(t, err)
+}
+
 func TestCreateAppWithDestName(t *testing.T) {
 	appServer := newTestAppServer(t)
 	testApp := newTestAppWithDestName()
@@ -280,6 +280,7 @@ func (s *Server) Create(ctx context.Context, q *project.ProjectCreateRequest) (*
 	}
 	if err == nil {
 		s.logEvent(res, ctx, argo.EventReasonResourceCreated, "created project")
+		s.logPolicyLintFindings(ctx, res)
 	}
 	return res, err
 }
@@ -452,6 +453,7 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 	res, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(ctx, q.Project, metav1.UpdateOptions{})
 	if err == nil {
 		s.logEvent(res, ctx, argo.EventReasonResourceUpdated, "updated project")
+		s.logPolicyLintFindings(ctx, res)
 	}
 	return res, err
 }
@@ -514,6 +516,21 @@ func (s *Server) logEvent(a *v1alpha1.AppProject, ctx context.Context, reason st
 	s.auditLogger.LogAppProjEvent(a, eventInfo, message, user)
 }
 
+// logPolicyLintFindings emits a warning event if the role policies of the project contain rules which never apply, are
+// redundant or conflict with the global policy. Findings do not fail the request since they do not make the policies invalid.
+func (s *Server) logPolicyLintFindings(ctx context.Context, proj *v1alpha1.AppProject) {
+	findings := rbacpolicy.LintProjectPolicies([]v1alpha1.AppProject{*proj}, s.enf.GetUserPolicy(), s.enf.GetMatchMode())
+	if len(findings) == 0 {
+		return
+	}
+	messages := make([]string, len(findings))
+	for i := range findings {
+		messages[i] = findings[i].String()
+	}
+	message := fmt.Sprintf("project role policies have %d potential problems: %s", len(findings), strings.Join(messages, "; "))
+	s.auditLogger.LogAppProjEvent(proj, argo.EventInfo{Type: v1.EventTypeWarning, Reason: argo.EventReasonPolicyLint}, message, session.Username(ctx))
+}
+
 func (s *Server) GetSyncWindowsState(ctx context.Context, q *project.SyncWindowsQuery) (*project.SyncWindowsResponse, error) {
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceProjects, rbacpolicy.ActionGet, q.Name); err != nil {
 		return nil, err
@@ -253,6 +253,40 @@ func TestProjectServer(t *testing.T) {
 		assert.ElementsMatch(t, res.Spec.SourceRepos, updatedProj.Spec.SourceRepos)
 	})
 
+	t.Run("TestUpdateProjectLogsPolicyLintFindings", func(t *testing.T) {
+		enforcer.SetDefaultRole("role:admin")
+		eventsClientset := fake.NewSimpleClientset()
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", eventsClientset, apps.NewSimpleClientset(&existingProj), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+
+		require.NoError(t, enforcer.SetUserPolicy("g, my-org:contractors, role:restricted\np, role:restricted, applications, sync, */*, deny"))
+		defer func() {
+			require.NoError(t, enforcer.SetUserPolicy(""))
+		}()
+
+		updatedProj := existingProj.DeepCopy()
+		updatedProj.Spec.Roles = []v1alpha1.ProjectRole{{
+			Name:     "deployer",
+			Policies: []string{"p, proj:test:deployer, applications, sync, test/*, allow"},
+			Groups:   []string{"my-org:contractors"},
+		}}
+
+		_, err := projectServer.Update(context.Background(), &project.ProjectUpdateRequest{Project: updatedProj})
+		require.NoError(t, err)
+
+		events, err := eventsClientset.CoreV1().Events("default").List(context.Background(), v1.ListOptions{})
+		require.NoError(t, err)
+		var lintEvents []corev1.Event
+		for _, event := range events.Items {
+			if event.Reason == argo.EventReasonPolicyLint {
+				lintEvents = append(lintEvents, event)
+			}
+		}
+		require.Len(t, lintEvents, 1)
+		assert.Equal(t, corev1.EventTypeWarning, lintEvents[0].Type)
+		assert.Contains(t, lintEvents[0].Message, "it is overridden by global deny rule 'p, role:restricted, applications, sync, */*, deny'")
+	})
+
 	t.Run("TestRemoveDestinationUsedByAppSuccessfulIfPermittedByAnotherDestination", func(t *testing.T) {
 		proj := existingProj.DeepCopy()
 		proj.Spec.Destinations = []v1alpha1.ApplicationDestination{
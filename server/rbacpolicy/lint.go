@@ -0,0 +1,297 @@
+package rbacpolicy
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/glob"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+)
+
+// PolicyLintFinding is a potential problem in the policies of an AppProject role or of the global RBAC policy. Findings
+// in the global policy have an empty Project and the subject of the rule as Role.
+type PolicyLintFinding struct {
+	Project string
+	Role    string
+	Policy  string
+	Message string
+}
+
+func (f PolicyLintFinding) String() string {
+	if f.Project == "" {
+		return fmt.Sprintf("%s: '%s': %s", f.Role, f.Policy, f.Message)
+	}
+	return fmt.Sprintf("%s/%s: '%s': %s", f.Project, f.Role, f.Policy, f.Message)
+}
+
+// globMetaChars are the characters which have a special meaning in glob patterns
+const globMetaChars = "*?[]{}!\\"
+
+type policyRule struct {
+	line     string
+	subject  string
+	resource string
+	action   string
+	object   string
+	effect   string
+}
+
+func parsePolicyRule(line string) (policyRule, bool) {
+	parts := strings.Split(line, ",")
+	if len(parts) != 6 || strings.TrimSpace(parts[0]) != "p" {
+		return policyRule{}, false
+	}
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	return policyRule{line: strings.TrimSpace(line), subject: parts[1], resource: parts[2], action: parts[3], object: parts[4], effect: parts[5]}, true
+}
+
+// patternCovers returns whether the given pattern matches every value matched by the other pattern in the given match
+// mode. Coverage of one pattern by another is only reported if it can be proven cheaply, so a false result does not
+// mean that the patterns differ.
+func patternCovers(pattern, other, matchMode string) bool {
+	if pattern == other {
+		return true
+	}
+	if matchMode == rbac.RegexMatchMode {
+		// a regular expression is only known to cover literal values
+		if regexp.QuoteMeta(other) != other {
+			return false
+		}
+		matched, err := regexp.MatchString(pattern, other)
+		return err == nil && matched
+	}
+	if !strings.ContainsAny(other, globMetaChars) {
+		return glob.Match(pattern, other)
+	}
+	// patterns with the same number of segments cover each other if each segment does
+	if patternSegments, otherSegments := strings.Split(pattern, "/"), strings.Split(other, "/"); len(patternSegments) > 1 && len(patternSegments) == len(otherSegments) {
+		for i := range patternSegments {
+			if !patternCovers(patternSegments[i], otherSegments[i], matchMode) {
+				return false
+			}
+		}
+		return true
+	}
+	// a literal prefix followed by a wildcard covers every pattern starting with the same prefix
+	prefix, ok := strings.CutSuffix(pattern, "*")
+	return ok && !strings.ContainsAny(prefix, globMetaChars) && strings.HasPrefix(other, prefix)
+}
+
+// patternsOverlap returns whether the given patterns are known to match at least one common value
+func patternsOverlap(a, b, matchMode string) bool {
+	return patternCovers(a, b, matchMode) || patternCovers(b, a, matchMode)
+}
+
+// matchesAll returns whether the given pattern matches every value
+func matchesAll(pattern, matchMode string) bool {
+	if matchMode == rbac.RegexMatchMode {
+		return pattern == ".*" || pattern == "^.*$"
+	}
+	return pattern == "*"
+}
+
+// covers returns whether every request matched by the given rule is matched by this rule as well
+func (r policyRule) covers(other policyRule, matchMode string) bool {
+	return patternCovers(r.resource, other.resource, matchMode) &&
+		patternCovers(r.action, other.action, matchMode) &&
+		patternCovers(r.object, other.object, matchMode)
+}
+
+// overlaps returns whether at least one request is known to be matched by both rules
+func (r policyRule) overlaps(other policyRule, matchMode string) bool {
+	return patternsOverlap(r.resource, other.resource, matchMode) &&
+		patternsOverlap(r.action, other.action, matchMode) &&
+		patternsOverlap(r.object, other.object, matchMode)
+}
+
+// objectProject returns the project segment of the rule object, which is the whole object if it has no project segment
+func (r policyRule) objectProject() string {
+	return strings.SplitN(r.object, "/", 2)[0]
+}
+
+// spansProjects returns whether the rule applies to the objects of the given project as well as of other projects
+func (r policyRule) spansProjects(proj, matchMode string) bool {
+	segment := r.objectProject()
+	return segment != proj && patternCovers(segment, proj, matchMode)
+}
+
+// isOverlyBroad returns whether the rule allows every action on the objects of every project
+func (r policyRule) isOverlyBroad(matchMode string) bool {
+	return r.effect == "allow" && matchesAll(r.action, matchMode) && matchesAll(r.objectProject(), matchMode)
+}
+
+// parseGlobalPolicy returns the rules of the given CSV policy and the roles each subject is assigned to
+func parseGlobalPolicy(policy string) ([]policyRule, map[string][]string) {
+	var rules []policyRule
+	roles := make(map[string][]string)
+	for _, line := range strings.Split(policy, "\n") {
+		if rule, ok := parsePolicyRule(line); ok {
+			rules = append(rules, rule)
+			continue
+		}
+		parts := strings.Split(line, ",")
+		if len(parts) == 3 && strings.TrimSpace(parts[0]) == "g" {
+			subject := strings.TrimSpace(parts[1])
+			roles[subject] = append(roles[subject], strings.TrimSpace(parts[2]))
+		}
+	}
+	return rules, roles
+}
+
+// subjectRoles returns the given subject together with all roles it is transitively assigned to
+func subjectRoles(subject string, roles map[string][]string) map[string]bool {
+	res := map[string]bool{subject: true}
+	queue := []string{subject}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		for _, role := range roles[next] {
+			if !res[role] {
+				res[role] = true
+				queue = append(queue, role)
+			}
+		}
+	}
+	return res
+}
+
+// LintProjectPolicies statically analyzes the role policies of the given projects against the global policy, using the
+// given match mode of the policies. It reports rules which never apply because they are overridden by deny rules of the
+// same role or of the global policy, rules which are redundant because another rule of the same role covers them, and
+// deny rules which revoke permissions the global policy grants to the groups of the role across projects.
+func LintProjectPolicies(projects []v1alpha1.AppProject, globalPolicy string, matchMode string) []PolicyLintFinding {
+	globalRules, globalRoles := parseGlobalPolicy(globalPolicy)
+	var findings []PolicyLintFinding
+	for _, proj := range projects {
+		for _, role := range proj.Spec.Roles {
+			var rules []policyRule
+			for _, line := range role.Policies {
+				if rule, ok := parsePolicyRule(line); ok {
+					rules = append(rules, rule)
+				}
+			}
+			addFinding := func(rule policyRule, format string, args ...interface{}) {
+				findings = append(findings, PolicyLintFinding{Project: proj.Name, Role: role.Name, Policy: rule.line, Message: fmt.Sprintf(format, args...)})
+			}
+			for i, rule := range rules {
+				if rule.effect != "allow" {
+					for _, group := range role.Groups {
+						subjects := subjectRoles(group, globalRoles)
+						for _, globalRule := range globalRules {
+							if globalRule.effect == "allow" && subjects[globalRule.subject] && globalRule.spansProjects(proj.Name, matchMode) && globalRule.overlaps(rule, matchMode) {
+								addFinding(rule, "rule denies group '%s' in this project what global rule '%s' allows across projects", group, globalRule.line)
+							}
+						}
+					}
+					continue
+				}
+				if deny, ok := findCoveringRule(rules, rule, "deny", -1, matchMode); ok {
+					addFinding(rule, "rule never applies, it is overridden by deny rule '%s'", deny.line)
+					continue
+				}
+				if other, ok := findCoveringRule(rules, rule, "allow", i, matchMode); ok {
+					addFinding(rule, "rule is redundant, it is covered by rule '%s'", other.line)
+				}
+				for _, group := range role.Groups {
+					subjects := subjectRoles(group, globalRoles)
+					for _, globalRule := range globalRules {
+						if globalRule.effect == "deny" && subjects[globalRule.subject] && globalRule.covers(rule, matchMode) {
+							addFinding(rule, "rule never applies to group '%s', it is overridden by global deny rule '%s'", group, globalRule.line)
+						}
+					}
+				}
+			}
+		}
+	}
+	return findings
+}
+
+// LintGlobalPolicy statically analyzes the given global policy using the given match mode of the policies. It reports
+// rules which allow every action on the objects of every project.
+func LintGlobalPolicy(globalPolicy string, matchMode string) []PolicyLintFinding {
+	globalRules, _ := parseGlobalPolicy(globalPolicy)
+	var findings []PolicyLintFinding
+	for _, rule := range globalRules {
+		if !rule.isOverlyBroad(matchMode) {
+			continue
+		}
+		resource := rule.resource
+		if matchesAll(resource, matchMode) {
+			resource = "every resource"
+		}
+		findings = append(findings, PolicyLintFinding{Role: rule.subject, Policy: rule.line, Message: fmt.Sprintf("rule allows every action on %s of every project", resource)})
+	}
+	return findings
+}
+
+// findCoveringRule returns a rule with the given effect which covers the given rule. Rules covering each other are
+// identical in effect, so only the first of them is considered to cover the later ones.
+func findCoveringRule(rules []policyRule, rule policyRule, effect string, index int, matchMode string) (policyRule, bool) {
+	for j, other := range rules {
+		if j == index || other.effect != effect || !other.covers(rule, matchMode) {
+			continue
+		}
+		if index >= 0 && j > index && rule.covers(other, matchMode) {
+			continue
+		}
+		return other, true
+	}
+	return policyRule{}, false
+}
+
+// SubjectsAllowed returns the users, groups and project roles which are allowed to perform the given action on the given
+// object. The candidates are all subjects referenced by the user-defined policy and by the roles of the given projects.
+// The default role applies to every subject and is therefore reported separately as second return value.
+func SubjectsAllowed(builtinPolicy, userPolicy, defaultRole, matchMode string, projects []v1alpha1.AppProject, resource, action, object string) ([]string, bool, error) {
+	enf := rbac.NewEnforcer(nil, "", "", nil)
+	enf.SetMatchMode(matchMode)
+	if err := enf.SetBuiltinPolicy(builtinPolicy); err != nil {
+		return nil, false, fmt.Errorf("error setting built-in policy: %w", err)
+	}
+	if err := enf.SetUserPolicy(userPolicy); err != nil {
+		return nil, false, fmt.Errorf("error setting user policy: %w", err)
+	}
+
+	var runtimePolicy, projName string
+	if parts := strings.SplitN(object, "/", 2); len(parts) == 2 {
+		projName = parts[0]
+	}
+	candidates := make(map[string]bool)
+	rules, roles := parseGlobalPolicy(userPolicy)
+	for _, rule := range rules {
+		candidates[rule.subject] = true
+	}
+	for subject := range roles {
+		candidates[subject] = true
+	}
+	for _, proj := range projects {
+		if proj.Name == projName {
+			runtimePolicy = proj.ProjectPoliciesString()
+		}
+		for _, role := range proj.Spec.Roles {
+			candidates[fmt.Sprintf("proj:%s:%s", proj.Name, role.Name)] = true
+			for _, group := range role.Groups {
+				candidates[group] = true
+			}
+		}
+	}
+
+	var allowed []string
+	for subject := range candidates {
+		// roles are not subjects of requests, they are reported through the users and groups assigned to them
+		if strings.HasPrefix(subject, "role:") {
+			continue
+		}
+		if enf.EnforceRuntimePolicy(projName, runtimePolicy, subject, resource, action, object) {
+			allowed = append(allowed, subject)
+		}
+	}
+	sort.Strings(allowed)
+	defaultAllowed := defaultRole != "" && enf.EnforceRuntimePolicy(projName, runtimePolicy, defaultRole, resource, action, object)
+	return allowed, defaultAllowed, nil
+}
@@ -0,0 +1,151 @@
+package rbacpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/assets"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+)
+
+func newLintProj(name string, roles ...argoappv1.ProjectRole) argoappv1.AppProject {
+	return argoappv1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: argoappv1.AppProjectSpec{Roles: roles}}
+}
+
+func TestLintProjectPolicies(t *testing.T) {
+	t.Run("no findings", func(t *testing.T) {
+		proj := newLintProj("my-proj", argoappv1.ProjectRole{Name: "deployer", Policies: []string{
+			"p, proj:my-proj:deployer, applications, sync, my-proj/*, allow",
+			"p, proj:my-proj:deployer, applications, get, my-proj/*, allow",
+		}})
+		assert.Empty(t, LintProjectPolicies([]argoappv1.AppProject{proj}, "", ""))
+	})
+
+	t.Run("overridden by deny rule", func(t *testing.T) {
+		proj := newLintProj("my-proj", argoappv1.ProjectRole{Name: "deployer", Policies: []string{
+			"p, proj:my-proj:deployer, applications, sync, my-proj/prod-api, allow",
+			"p, proj:my-proj:deployer, applications, *, my-proj/prod-*, deny",
+		}})
+		findings := LintProjectPolicies([]argoappv1.AppProject{proj}, "", "")
+		require.Len(t, findings, 1)
+		assert.Equal(t, "p, proj:my-proj:deployer, applications, sync, my-proj/prod-api, allow", findings[0].Policy)
+		assert.Contains(t, findings[0].Message, "overridden by deny rule")
+	})
+
+	t.Run("redundant rules", func(t *testing.T) {
+		proj := newLintProj("my-proj", argoappv1.ProjectRole{Name: "deployer", Policies: []string{
+			"p, proj:my-proj:deployer, applications, sync, my-proj/api, allow",
+			"p, proj:my-proj:deployer, applications, sync, my-proj/*, allow",
+			"p, proj:my-proj:deployer, applications, sync, my-proj/*, allow",
+		}})
+		findings := LintProjectPolicies([]argoappv1.AppProject{proj}, "", "")
+		require.Len(t, findings, 2)
+		assert.Equal(t, "p, proj:my-proj:deployer, applications, sync, my-proj/api, allow", findings[0].Policy)
+		assert.Equal(t, "p, proj:my-proj:deployer, applications, sync, my-proj/*, allow", findings[1].Policy)
+		assert.Contains(t, findings[1].Message, "redundant")
+	})
+
+	t.Run("project admin role", func(t *testing.T) {
+		proj := newLintProj("my-proj", argoappv1.ProjectRole{Name: "admin", Policies: []string{
+			"p, proj:my-proj:admin, applications, *, my-proj/*, allow",
+		}})
+		assert.Empty(t, LintProjectPolicies([]argoappv1.AppProject{proj}, "", ""))
+	})
+
+	t.Run("overlapping patterns", func(t *testing.T) {
+		proj := newLintProj("my-proj", argoappv1.ProjectRole{Name: "deployer", Policies: []string{
+			"p, proj:my-proj:deployer, applications, sync, my-proj/prod-*, allow",
+			"p, proj:my-proj:deployer, applications, sync, my-proj/*-api, deny",
+		}})
+		assert.Empty(t, LintProjectPolicies([]argoappv1.AppProject{proj}, "", ""))
+	})
+
+	t.Run("regex match mode", func(t *testing.T) {
+		proj := newLintProj("my-proj", argoappv1.ProjectRole{Name: "deployer", Policies: []string{
+			"p, proj:my-proj:deployer, applications, sync, my-proj/prod-api, allow",
+			"p, proj:my-proj:deployer, applications, sync, my-proj/.*, deny",
+		}})
+		assert.Empty(t, LintProjectPolicies([]argoappv1.AppProject{proj}, "", rbac.GlobMatchMode))
+		findings := LintProjectPolicies([]argoappv1.AppProject{proj}, "", rbac.RegexMatchMode)
+		require.Len(t, findings, 1)
+		assert.Equal(t, "p, proj:my-proj:deployer, applications, sync, my-proj/prod-api, allow", findings[0].Policy)
+	})
+
+	t.Run("overridden by global deny rule", func(t *testing.T) {
+		proj := newLintProj("my-proj", argoappv1.ProjectRole{
+			Name:     "deployer",
+			Policies: []string{"p, proj:my-proj:deployer, applications, sync, my-proj/*, allow"},
+			Groups:   []string{"my-org:contractors"},
+		})
+		globalPolicy := "g, my-org:contractors, role:restricted\np, role:restricted, applications, sync, */*, deny"
+		findings := LintProjectPolicies([]argoappv1.AppProject{proj}, globalPolicy, "")
+		require.Len(t, findings, 1)
+		assert.Contains(t, findings[0].Message, "never applies to group 'my-org:contractors'")
+	})
+
+	t.Run("deny rule conflicts with global rule across projects", func(t *testing.T) {
+		proj := newLintProj("team-a", argoappv1.ProjectRole{
+			Name:     "restricted",
+			Policies: []string{"p, proj:team-a:restricted, applications, sync, team-a/*, deny"},
+			Groups:   []string{"my-org:sre"},
+		})
+		globalPolicy := "g, my-org:sre, role:sre\np, role:sre, applications, sync, team-*/*, allow\np, role:sre, applications, get, team-a/*, allow"
+		findings := LintProjectPolicies([]argoappv1.AppProject{proj}, globalPolicy, "")
+		require.Len(t, findings, 1)
+		assert.Equal(t, "p, proj:team-a:restricted, applications, sync, team-a/*, deny", findings[0].Policy)
+		assert.Contains(t, findings[0].Message, "global rule 'p, role:sre, applications, sync, team-*/*, allow' allows across projects")
+	})
+}
+
+func TestLintGlobalPolicy(t *testing.T) {
+	globalPolicy := "p, role:org-admin, applications, *, */*, allow\np, role:team-admin, applications, *, team-a/*, allow\np, role:ops, *, *, *, allow"
+	findings := LintGlobalPolicy(globalPolicy, "")
+	require.Len(t, findings, 2)
+	assert.Equal(t, "role:org-admin", findings[0].Role)
+	assert.Equal(t, "rule allows every action on applications of every project", findings[0].Message)
+	assert.Equal(t, "role:ops: 'p, role:ops, *, *, *, allow': rule allows every action on every resource of every project", findings[1].String())
+
+	findings = LintGlobalPolicy("p, role:org-admin, applications, .*, .*/.*, allow", rbac.RegexMatchMode)
+	require.Len(t, findings, 1)
+}
+
+func TestPatternCovers(t *testing.T) {
+	assert.True(t, patternCovers("my-proj/*", "my-proj/prod-*", rbac.GlobMatchMode))
+	assert.True(t, patternCovers("*", "team-*/*", rbac.GlobMatchMode))
+	assert.False(t, patternCovers("my-proj/prod-*", "my-proj/*", rbac.GlobMatchMode))
+	assert.False(t, patternCovers("my-proj/*-api", "my-proj/prod-*", rbac.GlobMatchMode))
+	assert.True(t, patternCovers("my-proj/.*", "my-proj/api", rbac.RegexMatchMode))
+	assert.False(t, patternCovers("my-proj/.*", "my-proj/prod-.*", rbac.RegexMatchMode))
+	assert.False(t, patternCovers("other-proj/.*", "my-proj/api", rbac.RegexMatchMode))
+	assert.True(t, patternCovers("*/*", "my-proj/prod-*", rbac.GlobMatchMode))
+	assert.False(t, patternCovers("*/api", "my-proj/prod-*", rbac.GlobMatchMode))
+}
+
+func TestSubjectsAllowed(t *testing.T) {
+	projects := []argoappv1.AppProject{
+		newLintProj("my-proj", argoappv1.ProjectRole{
+			Name:     "deployer",
+			Policies: []string{"p, proj:my-proj:deployer, applications, sync, my-proj/*, allow"},
+			Groups:   []string{"my-org:deployers"},
+		}),
+		newLintProj("other-proj", argoappv1.ProjectRole{
+			Name:     "deployer",
+			Policies: []string{"p, proj:other-proj:deployer, applications, sync, other-proj/*, allow"},
+			Groups:   []string{"my-org:other-deployers"},
+		}),
+	}
+	userPolicy := "g, my-org:admins, role:admin\ng, alice, role:readonly\np, bob, applications, sync, my-proj/api, allow"
+
+	allowed, defaultAllowed, err := SubjectsAllowed(assets.BuiltinPolicyCSV, userPolicy, "role:readonly", "", projects, ResourceApplications, ActionSync, "my-proj/api")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bob", "my-org:admins", "my-org:deployers", "proj:my-proj:deployer"}, allowed)
+	assert.False(t, defaultAllowed)
+
+	_, defaultAllowed, err = SubjectsAllowed(assets.BuiltinPolicyCSV, userPolicy, "role:readonly", "", projects, ResourceApplications, ActionGet, "my-proj/api")
+	require.NoError(t, err)
+	assert.True(t, defaultAllowed)
+}
@@ -242,6 +242,10 @@ func (s *Server) ListApps(ctx context.Context, q *repositorypkg.RepoAppsQuery) (
 	if err := s.isRepoPermittedInProject(ctx, q.Repo, q.AppProject); err != nil {
 		return nil, err
 	}
+	enabledSourceTypes, err := s.getProjectEnabledSourceTypes(ctx, q.AppProject)
+	if err != nil {
+		return nil, err
+	}
 
 	// Test the repo
 	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
@@ -251,8 +255,9 @@ func (s *Server) ListApps(ctx context.Context, q *repositorypkg.RepoAppsQuery) (
 	defer io.Close(conn)
 
 	apps, err := repoClient.ListApps(ctx, &apiclient.ListAppsRequest{
-		Repo:     repo,
-		Revision: q.Revision,
+		Repo:               repo,
+		Revision:           q.Revision,
+		EnabledSourceTypes: enabledSourceTypes,
 	})
 	if err != nil {
 		return nil, err
@@ -306,6 +311,10 @@ func (s *Server) GetAppDetails(ctx context.Context, q *repositorypkg.RepoAppDeta
 	if err := s.isRepoPermittedInProject(ctx, q.Source.RepoURL, q.AppProject); err != nil {
 		return nil, err
 	}
+	enabledSourceTypes, err := s.getProjectEnabledSourceTypes(ctx, q.AppProject)
+	if err != nil {
+		return nil, err
+	}
 
 	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
 	if err != nil {
@@ -339,13 +348,14 @@ func (s *Server) GetAppDetails(ctx context.Context, q *repositorypkg.RepoAppDeta
 	}
 
 	return repoClient.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
-		Repo:             repo,
-		Source:           q.Source,
-		Repos:            helmRepos,
-		KustomizeOptions: kustomizeOptions,
-		HelmOptions:      helmOptions,
-		AppName:          q.AppName,
-		RefSources:       refSources,
+		Repo:               repo,
+		Source:             q.Source,
+		Repos:              helmRepos,
+		KustomizeOptions:   kustomizeOptions,
+		HelmOptions:        helmOptions,
+		AppName:            q.AppName,
+		RefSources:         refSources,
+		EnabledSourceTypes: enabledSourceTypes,
 	})
 }
 
@@ -592,6 +602,19 @@ func (s *Server) isRepoPermittedInProject(ctx context.Context, repo string, proj
 	return nil
 }
 
+// getProjectEnabledSourceTypes returns the source types which are enabled globally and not disabled by the given project
+func (s *Server) getProjectEnabledSourceTypes(ctx context.Context, projName string) (map[string]bool, error) {
+	proj, err := argo.GetAppProjectByName(projName, applisters.NewAppProjectLister(s.projLister.GetIndexer()), s.namespace, s.settings, s.db, ctx)
+	if err != nil {
+		return nil, err
+	}
+	enabledSourceTypes, err := s.settings.GetEnabledSourceTypes()
+	if err != nil {
+		return nil, fmt.Errorf("error getting settings enabled source types: %w", err)
+	}
+	return argo.GetProjectEnabledSourceTypes(proj, enabledSourceTypes), nil
+}
+
 // isSourceInHistory checks if the supplied application source is either our current application
 // source, or was something which we synced to previously.
 func isSourceInHistory(app *v1alpha1.Application, source v1alpha1.ApplicationSource, index int32, versionId int32) bool {
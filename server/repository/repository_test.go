@@ -556,6 +556,36 @@ func TestRepositoryServerListApps(t *testing.T) {
 		assert.Equal(t, "Kustomize", resp.Items[0].Type)
 	})
 
+	t.Run("Test_WithProjectDisabledSourceTypes", func(t *testing.T) {
+		repoServerClient := mocks.RepoServerServiceClient{}
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &repoServerClient}
+		enforcer := newEnforcer(kubeclientset)
+		enforcer.SetDefaultRole("role:admin")
+		proj := defaultProj.DeepCopy()
+		proj.Annotations = map[string]string{common.AnnotationKeyDisabledSourceTypes: "Plugin"}
+		appLister, projLister := newAppAndProjLister(proj)
+
+		url := "https://test"
+		db := &dbmocks.ArgoDB{}
+		db.On("GetRepository", context.TODO(), url, "default").Return(&appsv1.Repository{Repo: url}, nil)
+		db.On("GetProjectRepositories", context.TODO(), "default").Return(nil, nil)
+		db.On("GetProjectClusters", context.TODO(), "default").Return(nil, nil)
+		repoServerClient.On("ListApps", context.TODO(), mock.MatchedBy(func(req *apiclient.ListAppsRequest) bool {
+			enabled, ok := req.EnabledSourceTypes[string(appsv1.ApplicationSourceTypePlugin)]
+			return ok && !enabled
+		})).Return(&apiclient.AppList{Apps: map[string]string{"path/to/dir": "Kustomize"}}, nil)
+
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr)
+		resp, err := s.ListApps(context.TODO(), &repository.RepoAppsQuery{
+			Repo:       "https://test",
+			Revision:   "HEAD",
+			AppName:    "foo",
+			AppProject: "default",
+		})
+		require.NoError(t, err)
+		assert.Len(t, resp.Items, 1)
+	})
+
 	t.Run("Test_WithAppCreateUpdatePrivilegesRepoNotAllowed", func(t *testing.T) {
 		repoServerClient := mocks.RepoServerServiceClient{}
 		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &repoServerClient}
@@ -683,6 +713,37 @@ func TestRepositoryServerGetAppDetails(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, expectedResp, *resp)
 	})
+	t.Run("Test_WithProjectDisabledSourceTypes", func(t *testing.T) {
+		repoServerClient := mocks.RepoServerServiceClient{}
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &repoServerClient}
+		enforcer := newEnforcer(kubeclientset)
+
+		url := "https://test"
+		db := &dbmocks.ArgoDB{}
+		db.On("ListHelmRepositories", context.TODO(), mock.Anything).Return(nil, nil)
+		db.On("GetRepository", context.TODO(), url, "default").Return(&appsv1.Repository{Repo: url}, nil)
+		db.On("GetProjectRepositories", context.TODO(), "default").Return(nil, nil)
+		db.On("GetProjectClusters", context.TODO(), "default").Return(nil, nil)
+		expectedResp := apiclient.RepoAppDetailsResponse{Type: "Directory"}
+		repoServerClient.On("GetAppDetails", context.TODO(), mock.MatchedBy(func(req *apiclient.RepoServerAppDetailsQuery) bool {
+			enabled, ok := req.EnabledSourceTypes[string(appsv1.ApplicationSourceTypePlugin)]
+			return ok && !enabled
+		})).Return(&expectedResp, nil)
+		proj := defaultProj.DeepCopy()
+		proj.Annotations = map[string]string{common.AnnotationKeyDisabledSourceTypes: "Plugin"}
+		appLister, projLister := newAppAndProjLister(proj)
+
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr)
+		resp, err := s.GetAppDetails(context.TODO(), &repository.RepoAppDetailsQuery{
+			Source: &appsv1.ApplicationSource{
+				RepoURL: url,
+			},
+			AppName:    "newapp",
+			AppProject: "default",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, expectedResp, *resp)
+	})
 	t.Run("Test_RepoNotPermitted", func(t *testing.T) {
 		repoServerClient := mocks.RepoServerServiceClient{}
 		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &repoServerClient}
@@ -121,6 +121,15 @@ func (s *Server) Get(ctx context.Context, q *settingspkg.SettingsQuery) (*settin
 		set.UiBannerPermanent = argoCDSettings.UiBannerPermanent
 		set.UiBannerPosition = argoCDSettings.UiBannerPosition
 		set.ControllerNamespace = s.mgr.GetNamespace()
+		if argoCDSettings.MaintenanceModeEnabled {
+			// maintenance mode takes precedence over the user-defined banner and cannot be dismissed
+			set.UiBannerContent = "Argo CD is in maintenance mode, sync operations are deferred."
+			if argoCDSettings.MaintenanceModeMessage != "" {
+				set.UiBannerContent = fmt.Sprintf("%s %s", set.UiBannerContent, argoCDSettings.MaintenanceModeMessage)
+			}
+			set.UiBannerURL = ""
+			set.UiBannerPermanent = true
+		}
 	}
 	if sessionmgr.LoggedIn(ctx) {
 		set.PasswordPattern = argoCDSettings.PasswordPattern
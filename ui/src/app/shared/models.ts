@@ -355,6 +355,7 @@ export interface ResourceStatus {
     hook?: boolean;
     requiresPruning?: boolean;
     syncWave?: number;
+    lastAppliedRevision?: string;
     orphaned?: boolean;
 }
 
@@ -398,6 +399,7 @@ export interface ApplicationTree {
     nodes: ResourceNode[];
     orphanedNodes: ResourceNode[];
     hosts: Node[];
+    deletedNodes?: ResourceRef[];
 }
 
 export interface ResourceID {
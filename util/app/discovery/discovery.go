@@ -37,16 +37,18 @@ func Discover(ctx context.Context, appPath, repoPath string, enableGenerateManif
 	apps := make(map[string]string)
 
 	// Check if it is CMP
-	conn, _, err := DetectConfigManagementPlugin(ctx, appPath, repoPath, "", env, tarExcludedGlobs)
-	if err == nil {
-		// Found CMP
-		io.Close(conn)
-
-		apps["."] = string(v1alpha1.ApplicationSourceTypePlugin)
-		return apps, nil
+	if IsManifestGenerationEnabled(v1alpha1.ApplicationSourceTypePlugin, enableGenerateManifests) {
+		conn, _, err := DetectConfigManagementPlugin(ctx, appPath, repoPath, "", env, tarExcludedGlobs)
+		if err == nil {
+			// Found CMP
+			io.Close(conn)
+
+			apps["."] = string(v1alpha1.ApplicationSourceTypePlugin)
+			return apps, nil
+		}
 	}
 
-	err = filepath.Walk(appPath, func(path string, info os.FileInfo, err error) error {
+	err := filepath.Walk(appPath, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/glob"
+	"github.com/argoproj/argo-cd/v2/util/helm"
 	"github.com/argoproj/argo-cd/v2/util/io"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
@@ -346,6 +348,7 @@ func ValidateRepo(
 	if err != nil {
 		return nil, fmt.Errorf("error getting enabled source types: %w", err)
 	}
+	enabledSourceTypes = GetProjectEnabledSourceTypes(proj, enabledSourceTypes)
 
 	sourceCondition, err := validateRepo(
 		ctx,
@@ -734,6 +737,14 @@ func verifyGenerateManifests(
 		})
 		return conditions // Can't perform the next check without settings.
 	}
+	helmVersions, err := settingsMgr.GetHelmVersions()
+	if err != nil {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("Error getting Helm versions: %v", err),
+		})
+		return conditions
+	}
 
 	for _, source := range sources {
 		repoRes, err := db.GetRepository(ctx, source.RepoURL, proj.Name)
@@ -752,6 +763,13 @@ func verifyGenerateManifests(
 			})
 			continue
 		}
+		if source.Helm != nil && helm.IsPinnedVersion(source.Helm.Version) && !slices.Contains(helmVersions, source.Helm.Version) {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("helm version %s is not registered", source.Helm.Version),
+			})
+			continue
+		}
 		installationID, err := settingsMgr.GetInstallationID()
 		if err != nil {
 			conditions = append(conditions, argoappv1.ApplicationCondition{
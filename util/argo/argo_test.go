@@ -460,6 +460,45 @@ func TestValidateRepo(t *testing.T) {
 	assert.Equal(t, kustomizeOptions, receivedRequest.KustomizeOptions)
 }
 
+func TestVerifyGenerateManifests_HelmVersion(t *testing.T) {
+	repo := &argoappv1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps", Type: "git"}
+	proj := &argoappv1.AppProject{Spec: argoappv1.AppProjectSpec{SourceRepos: []string{"*"}}}
+	db := &dbmocks.ArgoDB{}
+	db.On("GetRepository", context.Background(), repo.Repo, "").Return(repo, nil)
+	repoClient := &mocks.RepoServerServiceClient{}
+	repoClient.On("GenerateManifest", context.Background(), mock.Anything).Return(nil, nil)
+
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-cm",
+			Namespace: test.FakeArgoCDNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string]string{
+			"helm.versions": "v3.14.4",
+		},
+	}
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&cm), test.FakeArgoCDNamespace)
+
+	verify := func(version string) []argoappv1.ApplicationCondition {
+		source := argoappv1.ApplicationSource{RepoURL: repo.Repo, Path: "helm-guestbook", Helm: &argoappv1.ApplicationSourceHelm{Version: version}}
+		app := &argoappv1.Application{Spec: argoappv1.ApplicationSpec{
+			Source:      &source,
+			Destination: argoappv1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "default"},
+		}}
+		return verifyGenerateManifests(context.Background(), db, nil, nil, app, proj, app.Spec.GetSources(), repoClient, "", nil, nil, nil, settingsMgr, nil)
+	}
+
+	assert.Empty(t, verify("v3"))
+	assert.Empty(t, verify("v3.14.4"))
+	conditions := verify("v3.15.2")
+	require.Len(t, conditions, 1)
+	assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+	assert.Equal(t, "helm version v3.15.2 is not registered", conditions[0].Message)
+}
+
 func TestFormatAppConditions(t *testing.T) {
 	conditions := []argoappv1.ApplicationCondition{
 		{
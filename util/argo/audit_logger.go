@@ -22,6 +22,7 @@ type AuditLogger struct {
 	component      string
 	ns             string
 	enableEventLog map[string]bool
+	exporter       EventExporter
 }
 
 type EventInfo struct {
@@ -44,6 +45,7 @@ const (
 	EventReasonResourceActionRan  = "ResourceActionRan"
 	EventReasonOperationStarted   = "OperationStarted"
 	EventReasonOperationCompleted = "OperationCompleted"
+	EventReasonPolicyLint         = "PolicyLint"
 )
 
 func (l *AuditLogger) logEvent(objMeta ObjectRef, gvk schema.GroupVersionKind, info EventInfo, message string, logFields map[string]string, eventLabels map[string]string) {
@@ -64,6 +66,27 @@ func (l *AuditLogger) logEvent(objMeta ObjectRef, gvk schema.GroupVersionKind, i
 		logCtx = logCtx.WithField("name", objMeta.Name)
 	}
 	t := metav1.Time{Time: time.Now()}
+	if l.exporter != nil {
+		l.exporter.Export(ExportedEvent{
+			Timestamp: t.Time,
+			Component: l.component,
+			Type:      info.Type,
+			Reason:    info.Reason,
+			Message:   message,
+			InvolvedObject: ExportedEventObject{
+				APIVersion: gvk.GroupVersion().String(),
+				Kind:       gvk.Kind,
+				Name:       objMeta.Name,
+				Namespace:  objMeta.Namespace,
+				UID:        objMeta.UID,
+			},
+			Fields: logFields,
+			Labels: eventLabels,
+		})
+	}
+	if !l.enableK8SEventLog(info) {
+		return
+	}
 	event := v1.Event{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        fmt.Sprintf("%v.%x", objMeta.Name, t.UnixNano()),
@@ -101,7 +124,7 @@ func (l *AuditLogger) enableK8SEventLog(info EventInfo) bool {
 }
 
 func (l *AuditLogger) LogAppEvent(app *v1alpha1.Application, info EventInfo, message, user string, eventLabels map[string]string) {
-	if !l.enableK8SEventLog(info) {
+	if !l.enableK8SEventLog(info) && l.exporter == nil {
 		return
 	}
 
@@ -122,7 +145,7 @@ func (l *AuditLogger) LogAppEvent(app *v1alpha1.Application, info EventInfo, mes
 }
 
 func (l *AuditLogger) LogAppSetEvent(app *v1alpha1.ApplicationSet, info EventInfo, message, user string) {
-	if !l.enableK8SEventLog(info) {
+	if !l.enableK8SEventLog(info) && l.exporter == nil {
 		return
 	}
 
@@ -140,7 +163,7 @@ func (l *AuditLogger) LogAppSetEvent(app *v1alpha1.ApplicationSet, info EventInf
 }
 
 func (l *AuditLogger) LogResourceEvent(res *v1alpha1.ResourceNode, info EventInfo, message, user string) {
-	if !l.enableK8SEventLog(info) {
+	if !l.enableK8SEventLog(info) && l.exporter == nil {
 		return
 	}
 
@@ -162,7 +185,7 @@ func (l *AuditLogger) LogResourceEvent(res *v1alpha1.ResourceNode, info EventInf
 }
 
 func (l *AuditLogger) LogAppProjEvent(proj *v1alpha1.AppProject, info EventInfo, message, user string) {
-	if !l.enableK8SEventLog(info) {
+	if !l.enableK8SEventLog(info) && l.exporter == nil {
 		return
 	}
 
@@ -179,6 +202,12 @@ func (l *AuditLogger) LogAppProjEvent(proj *v1alpha1.AppProject, info EventInfo,
 	l.logEvent(objectMeta, v1alpha1.AppProjectSchemaGroupVersionKind, info, message, nil, nil)
 }
 
+// SetEventExporter configures the logger to additionally export all events, including the ones which are not enabled
+// as Kubernetes events, using the given exporter
+func (l *AuditLogger) SetEventExporter(exporter EventExporter) {
+	l.exporter = exporter
+}
+
 func NewAuditLogger(ns string, kIf kubernetes.Interface, component string, enableK8sEvent []string) *AuditLogger {
 	return &AuditLogger{
 		ns:             ns,
@@ -2,11 +2,17 @@ package argo
 
 import (
 	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
@@ -166,3 +172,91 @@ func TestLogResourceEvent(t *testing.T) {
 
 	assert.Empty(t, output)
 }
+
+type fakeEventExporter struct {
+	events []ExportedEvent
+}
+
+func (e *fakeEventExporter) Export(event ExportedEvent) {
+	e.events = append(e.events, event)
+}
+
+func TestLogAppEvent_Exporter(t *testing.T) {
+	logger := NewAuditLogger("default", fake.NewSimpleClientset(), _somecomponent, []string{"none"})
+	exporter := &fakeEventExporter{}
+	logger.SetEventExporter(exporter)
+
+	app := argoappv1.Application{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "testapp",
+			Namespace: "argocd",
+			UID:       "a-b-c-d-e",
+		},
+		Spec: argoappv1.ApplicationSpec{
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+		},
+	}
+
+	output := captureLogEntries(func() {
+		logger.LogAppEvent(&app, EventInfo{Reason: EventReasonOperationStarted, Type: "Normal"}, "Initiated automated sync", "", map[string]string{"team": "a"})
+	})
+
+	// events are exported even if Kubernetes events are disabled
+	assert.Empty(t, output)
+	require.Len(t, exporter.events, 1)
+	event := exporter.events[0]
+	assert.Equal(t, _somecomponent, event.Component)
+	assert.Equal(t, EventReasonOperationStarted, event.Reason)
+	assert.Equal(t, "Initiated automated sync", event.Message)
+	assert.Equal(t, ExportedEventObject{
+		APIVersion: "argoproj.io/v1alpha1",
+		Kind:       "Application",
+		Name:       "testapp",
+		Namespace:  "argocd",
+		UID:        "a-b-c-d-e",
+	}, event.InvolvedObject)
+	assert.Equal(t, "testns", event.Fields["dest-namespace"])
+	assert.Equal(t, map[string]string{"team": "a"}, event.Labels)
+	assert.False(t, event.Timestamp.IsZero())
+}
+
+func TestHTTPEventExporter(t *testing.T) {
+	received := make(chan ExportedEvent, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var event ExportedEvent
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		received <- event
+	}))
+	defer server.Close()
+
+	exporter := NewHTTPEventExporter(server.URL, map[string]string{"Authorization": "Bearer token"})
+	exporter.Export(ExportedEvent{Reason: EventReasonOperationCompleted, Message: "Sync operation to abc succeeded"})
+
+	select {
+	case event := <-received:
+		assert.Equal(t, EventReasonOperationCompleted, event.Reason)
+		assert.Equal(t, "Sync operation to abc succeeded", event.Message)
+	case <-time.After(10 * time.Second):
+		t.Fatal("event was not exported")
+	}
+}
+
+func TestHTTPEventExporter_DroppedEventsWarningIsRateLimited(t *testing.T) {
+	hook := test.NewGlobal()
+	defer hook.Reset()
+	// the queue is not consumed, so all events but the first are dropped
+	exporter := &httpEventExporter{queue: make(chan ExportedEvent, 1)}
+	for i := 0; i < 5; i++ {
+		exporter.Export(ExportedEvent{Reason: EventReasonOperationCompleted})
+	}
+
+	require.Len(t, hook.AllEntries(), 1)
+	assert.Equal(t, log.WarnLevel, hook.LastEntry().Level)
+	assert.Contains(t, hook.LastEntry().Message, "Dropped 1 events")
+	assert.Equal(t, 3, exporter.dropped)
+}
@@ -0,0 +1,118 @@
+package argo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// eventExportQueueSize is the number of events buffered by the HTTP event exporter. Events are dropped if the queue
+// is full, so that a slow or unavailable receiver never blocks the reconciliation.
+const eventExportQueueSize = 1000
+
+// droppedEventsWarningInterval is the minimum interval between two warnings about dropped events, so that a receiver
+// which cannot keep up does not flood the controller logs
+const droppedEventsWarningInterval = time.Minute
+
+// ExportedEvent is an audit event in the format sent to external event receivers
+type ExportedEvent struct {
+	Timestamp      time.Time           `json:"timestamp"`
+	Component      string              `json:"component"`
+	Type           string              `json:"type"`
+	Reason         string              `json:"reason"`
+	Message        string              `json:"message"`
+	InvolvedObject ExportedEventObject `json:"involvedObject"`
+	Fields         map[string]string   `json:"fields,omitempty"`
+	Labels         map[string]string   `json:"labels,omitempty"`
+}
+
+// ExportedEventObject references the object an exported event is about
+type ExportedEventObject struct {
+	APIVersion string    `json:"apiVersion"`
+	Kind       string    `json:"kind"`
+	Name       string    `json:"name"`
+	Namespace  string    `json:"namespace"`
+	UID        types.UID `json:"uid"`
+}
+
+// EventExporter sends audit events to an external system, e.g. to feed delivery metrics pipelines
+type EventExporter interface {
+	Export(event ExportedEvent)
+}
+
+type httpEventExporter struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+	queue   chan ExportedEvent
+
+	droppedLock        sync.Mutex
+	dropped            int
+	lastDroppedWarning time.Time
+}
+
+// NewHTTPEventExporter returns an EventExporter which posts each event as JSON to the given URL with the given
+// headers. Events are sent asynchronously in the order they were exported.
+func NewHTTPEventExporter(url string, headers map[string]string) EventExporter {
+	e := &httpEventExporter{
+		url:     url,
+		headers: headers,
+		client:  &http.Client{Timeout: 10 * time.Second},
+		queue:   make(chan ExportedEvent, eventExportQueueSize),
+	}
+	go e.run()
+	return e
+}
+
+func (e *httpEventExporter) Export(event ExportedEvent) {
+	select {
+	case e.queue <- event:
+	default:
+		e.droppedLock.Lock()
+		defer e.droppedLock.Unlock()
+		e.dropped++
+		if time.Since(e.lastDroppedWarning) >= droppedEventsWarningInterval {
+			log.Warnf("Dropped %d events since the last warning, including %s of %s '%s': export queue is full", e.dropped, event.Reason, event.InvolvedObject.Kind, event.InvolvedObject.Name)
+			e.dropped = 0
+			e.lastDroppedWarning = time.Now()
+		}
+	}
+}
+
+func (e *httpEventExporter) run() {
+	for event := range e.queue {
+		if err := e.send(event); err != nil {
+			log.Warnf("Failed to export event %s of %s '%s': %v", event.Reason, event.InvolvedObject.Kind, event.InvolvedObject.Name, err)
+		}
+	}
+}
+
+func (e *httpEventExporter) send(event ExportedEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("error marshaling event: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range e.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package argo
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// GetAppExpiration returns the time after which the application is deleted, or nil if the application has no time to live
+func GetAppExpiration(app *argoappv1.Application) (*time.Time, error) {
+	val, ok := app.GetAnnotations()[common.AnnotationKeyTTL]
+	if !ok {
+		return nil, nil
+	}
+	ttl, err := time.ParseDuration(val)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s annotation '%s': %w", common.AnnotationKeyTTL, val, err)
+	}
+	if ttl <= 0 {
+		return nil, fmt.Errorf("invalid %s annotation '%s': time to live must be positive", common.AnnotationKeyTTL, val)
+	}
+	expiresAt := app.CreationTimestamp.Add(ttl)
+	return &expiresAt, nil
+}
+
+// IsGeneratedByApplicationSet returns whether the application is managed by an ApplicationSet
+func IsGeneratedByApplicationSet(app *argoappv1.Application) bool {
+	owner := metav1.GetControllerOfNoCopy(app)
+	return owner != nil && owner.Kind == application.ApplicationSetKind
+}
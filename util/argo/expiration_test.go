@@ -0,0 +1,54 @@
+package argo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestGetAppExpiration(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newApp := func(ttl string) *argoappv1.Application {
+		return &argoappv1.Application{ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(created),
+			Annotations:       map[string]string{common.AnnotationKeyTTL: ttl},
+		}}
+	}
+
+	t.Run("no ttl", func(t *testing.T) {
+		expiresAt, err := GetAppExpiration(&argoappv1.Application{})
+		require.NoError(t, err)
+		assert.Nil(t, expiresAt)
+	})
+
+	t.Run("valid ttl", func(t *testing.T) {
+		expiresAt, err := GetAppExpiration(newApp("72h"))
+		require.NoError(t, err)
+		assert.Equal(t, created.Add(72*time.Hour), *expiresAt)
+	})
+
+	t.Run("invalid ttl", func(t *testing.T) {
+		_, err := GetAppExpiration(newApp("three days"))
+		require.Error(t, err)
+		_, err = GetAppExpiration(newApp("-1h"))
+		require.Error(t, err)
+	})
+}
+
+func TestIsGeneratedByApplicationSet(t *testing.T) {
+	app := &argoappv1.Application{}
+	assert.False(t, IsGeneratedByApplicationSet(app))
+
+	app.OwnerReferences = []metav1.OwnerReference{{Kind: "ApplicationSet", Name: "previews"}}
+	assert.False(t, IsGeneratedByApplicationSet(app), "only the controlling owner is considered")
+
+	app.OwnerReferences[0].Controller = ptr.To(true)
+	assert.True(t, IsGeneratedByApplicationSet(app))
+}
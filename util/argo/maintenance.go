@@ -0,0 +1,18 @@
+package argo
+
+import (
+	"strconv"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// IsProjectInMaintenanceMode returns whether the project is annotated to be in maintenance mode
+func IsProjectInMaintenanceMode(proj *argoappv1.AppProject) bool {
+	val, ok := proj.GetAnnotations()[common.AnnotationKeyMaintenanceMode]
+	if !ok {
+		return false
+	}
+	enabled, err := strconv.ParseBool(val)
+	return err == nil && enabled
+}
@@ -0,0 +1,133 @@
+package argo
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// ProjectQuota limits the usage of a shared Argo CD instance by the applications of an AppProject. The limits are
+// configured using the quota annotations of the project. A zero limit means unlimited.
+type ProjectQuota struct {
+	MaxApplications int
+	MaxResources    int
+	MaxClusters     int
+	// MaxApplicationManifests and MaxApplicationManifestsSize limit the manifests generated for each application
+	MaxApplicationManifests     int
+	MaxApplicationManifestsSize int64
+}
+
+// IsUnlimited returns whether none of the limits of the quota is set
+func (q ProjectQuota) IsUnlimited() bool {
+	return q.MaxApplications == 0 && q.MaxResources == 0 && q.MaxClusters == 0 &&
+		q.MaxApplicationManifests == 0 && q.MaxApplicationManifestsSize == 0
+}
+
+// GetProjectQuota returns the quota configured by the annotations of the given project
+func GetProjectQuota(proj *argoappv1.AppProject) (ProjectQuota, error) {
+	var quota ProjectQuota
+	for key, limit := range map[string]*int{
+		common.AnnotationKeyQuotaMaxApplications:         &quota.MaxApplications,
+		common.AnnotationKeyQuotaMaxResources:            &quota.MaxResources,
+		common.AnnotationKeyQuotaMaxClusters:             &quota.MaxClusters,
+		common.AnnotationKeyQuotaMaxApplicationManifests: &quota.MaxApplicationManifests,
+	} {
+		val, ok := proj.GetAnnotations()[key]
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(val)
+		if err != nil || n < 0 {
+			return ProjectQuota{}, fmt.Errorf("invalid value '%s' of annotation %s of project %s: must be a non-negative integer", val, key, proj.Name)
+		}
+		*limit = n
+	}
+	if val, ok := proj.GetAnnotations()[common.AnnotationKeyQuotaMaxApplicationManifestsSize]; ok {
+		size, err := resource.ParseQuantity(val)
+		if err != nil || size.Sign() < 0 {
+			return ProjectQuota{}, fmt.Errorf("invalid value '%s' of annotation %s of project %s: must be a non-negative quantity", val, common.AnnotationKeyQuotaMaxApplicationManifestsSize, proj.Name)
+		}
+		quota.MaxApplicationManifestsSize = size.Value()
+	}
+	return quota, nil
+}
+
+// ValidateApplicationManifestsQuota returns an error if the given number and total size in bytes of the manifests
+// generated for a single application exceed the quota of the given project. This protects the controller from
+// applications which suddenly render a huge number of objects, e.g. due to a bug in a generator.
+func ValidateApplicationManifestsQuota(proj *argoappv1.AppProject, manifests int, size int64) error {
+	quota, err := GetProjectQuota(proj)
+	if err != nil {
+		return err
+	}
+	if quota.MaxApplicationManifests > 0 && manifests > quota.MaxApplicationManifests {
+		return fmt.Errorf("generated manifests exceed the quota of project %s of %d manifests per application: %d manifests", proj.Name, quota.MaxApplicationManifests, manifests)
+	}
+	if quota.MaxApplicationManifestsSize > 0 && size > quota.MaxApplicationManifestsSize {
+		return fmt.Errorf("generated manifests exceed the quota of project %s of %s per application: %s",
+			proj.Name, resource.NewQuantity(quota.MaxApplicationManifestsSize, resource.BinarySI), resource.NewQuantity(size, resource.BinarySI))
+	}
+	return nil
+}
+
+// ProjectUsage is the usage of a shared Argo CD instance by the applications of an AppProject
+type ProjectUsage struct {
+	Applications int
+	Resources    int
+	Clusters     int
+}
+
+// GetProjectUsage returns the usage of the given project by the given applications. Applications which do not belong
+// to the project are ignored. Managed resources are counted using the resources of the application status.
+func GetProjectUsage(proj *argoappv1.AppProject, apps []*argoappv1.Application) ProjectUsage {
+	var usage ProjectUsage
+	clusters := make(map[string]bool)
+	for _, app := range apps {
+		if app.Spec.GetProject() != proj.Name {
+			continue
+		}
+		usage.Applications++
+		usage.Resources += len(app.Status.Resources)
+		if key := DestinationClusterKey(app.Spec.Destination); key != "" {
+			clusters[key] = true
+		}
+	}
+	usage.Clusters = len(clusters)
+	return usage
+}
+
+// DestinationClusterKey returns the key identifying the cluster of the given destination when counting the clusters
+// of a project, or an empty string if the destination has no cluster
+func DestinationClusterKey(dest argoappv1.ApplicationDestination) string {
+	if dest.Server != "" {
+		return dest.Server
+	}
+	if dest.Name != "" {
+		return "name:" + dest.Name
+	}
+	return ""
+}
+
+// ValidateProjectQuota returns an error if changing the usage of the given project from current to updated exceeds
+// the quota of the project. A limit is only enforced if the change increases the usage it limits, so that changes
+// which keep or reduce the usage, e.g. syncs pruning resources, are allowed even if the project exceeds its quota.
+func ValidateProjectQuota(proj *argoappv1.AppProject, current, updated ProjectUsage) error {
+	quota, err := GetProjectQuota(proj)
+	if err != nil {
+		return err
+	}
+	if quota.MaxApplications > 0 && updated.Applications > current.Applications && updated.Applications > quota.MaxApplications {
+		return fmt.Errorf("project %s exceeds its quota of %d applications: %d applications", proj.Name, quota.MaxApplications, updated.Applications)
+	}
+	if quota.MaxResources > 0 && updated.Resources > current.Resources && updated.Resources > quota.MaxResources {
+		return fmt.Errorf("project %s exceeds its quota of %d managed resources: %d resources", proj.Name, quota.MaxResources, updated.Resources)
+	}
+	if quota.MaxClusters > 0 && updated.Clusters > current.Clusters && updated.Clusters > quota.MaxClusters {
+		return fmt.Errorf("project %s exceeds its quota of %d destination clusters: %d clusters", proj.Name, quota.MaxClusters, updated.Clusters)
+	}
+	return nil
+}
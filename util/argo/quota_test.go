@@ -0,0 +1,103 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func newQuotaApp(name, project, server string, resources int) *argoappv1.Application {
+	app := &argoappv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: argoappv1.ApplicationSpec{
+			Project:     project,
+			Destination: argoappv1.ApplicationDestination{Server: server},
+		},
+	}
+	for i := 0; i < resources; i++ {
+		app.Status.Resources = append(app.Status.Resources, argoappv1.ResourceStatus{Kind: "ConfigMap"})
+	}
+	return app
+}
+
+func TestGetProjectQuota(t *testing.T) {
+	proj := &argoappv1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "my-proj", Annotations: map[string]string{
+		common.AnnotationKeyQuotaMaxApplications: "10",
+		common.AnnotationKeyQuotaMaxClusters:     "2",
+	}}}
+	quota, err := GetProjectQuota(proj)
+	require.NoError(t, err)
+	assert.Equal(t, ProjectQuota{MaxApplications: 10, MaxClusters: 2}, quota)
+
+	proj.Annotations[common.AnnotationKeyQuotaMaxResources] = "-1"
+	_, err = GetProjectQuota(proj)
+	assert.EqualError(t, err, "invalid value '-1' of annotation argocd.argoproj.io/quota-max-resources of project my-proj: must be a non-negative integer")
+}
+
+func TestGetProjectUsage(t *testing.T) {
+	proj := &argoappv1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "my-proj"}}
+	apps := []*argoappv1.Application{
+		newQuotaApp("app-1", "my-proj", "https://cluster-1", 3),
+		newQuotaApp("app-2", "my-proj", "https://cluster-2", 2),
+		newQuotaApp("app-3", "my-proj", "https://cluster-2", 0),
+		newQuotaApp("app-4", "other-proj", "https://cluster-3", 100),
+	}
+	assert.Equal(t, ProjectUsage{Applications: 3, Resources: 5, Clusters: 2}, GetProjectUsage(proj, apps))
+}
+
+func TestValidateProjectQuota(t *testing.T) {
+	newProj := func(key, val string) *argoappv1.AppProject {
+		return &argoappv1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "my-proj", Annotations: map[string]string{key: val}}}
+	}
+	current := ProjectUsage{Applications: 2, Resources: 5, Clusters: 2}
+
+	t.Run("unlimited", func(t *testing.T) {
+		updated := ProjectUsage{Applications: 20, Resources: 500, Clusters: 20}
+		require.NoError(t, ValidateProjectQuota(&argoappv1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "my-proj"}}, current, updated))
+	})
+	t.Run("within quota", func(t *testing.T) {
+		updated := ProjectUsage{Applications: 2, Resources: 6, Clusters: 2}
+		require.NoError(t, ValidateProjectQuota(newProj(common.AnnotationKeyQuotaMaxResources, "6"), current, updated))
+	})
+	t.Run("applications exceeded", func(t *testing.T) {
+		updated := ProjectUsage{Applications: 3, Resources: 5, Clusters: 2}
+		err := ValidateProjectQuota(newProj(common.AnnotationKeyQuotaMaxApplications, "2"), current, updated)
+		assert.EqualError(t, err, "project my-proj exceeds its quota of 2 applications: 3 applications")
+	})
+	t.Run("resources exceeded", func(t *testing.T) {
+		updated := ProjectUsage{Applications: 2, Resources: 7, Clusters: 2}
+		err := ValidateProjectQuota(newProj(common.AnnotationKeyQuotaMaxResources, "6"), current, updated)
+		assert.EqualError(t, err, "project my-proj exceeds its quota of 6 managed resources: 7 resources")
+	})
+	t.Run("clusters exceeded", func(t *testing.T) {
+		updated := ProjectUsage{Applications: 2, Resources: 5, Clusters: 3}
+		err := ValidateProjectQuota(newProj(common.AnnotationKeyQuotaMaxClusters, "2"), current, updated)
+		assert.EqualError(t, err, "project my-proj exceeds its quota of 2 destination clusters: 3 clusters")
+	})
+	t.Run("usage not increased above exceeded quota", func(t *testing.T) {
+		proj := newProj(common.AnnotationKeyQuotaMaxResources, "2")
+		require.NoError(t, ValidateProjectQuota(proj, current, current))
+		require.NoError(t, ValidateProjectQuota(proj, current, ProjectUsage{Applications: 2, Resources: 3, Clusters: 2}))
+	})
+}
+
+func TestValidateApplicationManifestsQuota(t *testing.T) {
+	proj := &argoappv1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "my-proj"}}
+	require.NoError(t, ValidateApplicationManifestsQuota(proj, 50000, 1<<30))
+
+	proj.Annotations = map[string]string{
+		common.AnnotationKeyQuotaMaxApplicationManifests:     "100",
+		common.AnnotationKeyQuotaMaxApplicationManifestsSize: "1Mi",
+	}
+	require.NoError(t, ValidateApplicationManifestsQuota(proj, 100, 1<<20))
+	require.EqualError(t, ValidateApplicationManifestsQuota(proj, 101, 1024), "generated manifests exceed the quota of project my-proj of 100 manifests per application: 101 manifests")
+	require.EqualError(t, ValidateApplicationManifestsQuota(proj, 10, 2<<20), "generated manifests exceed the quota of project my-proj of 1Mi per application: 2Mi")
+
+	proj.Annotations[common.AnnotationKeyQuotaMaxApplicationManifestsSize] = "a lot"
+	require.EqualError(t, ValidateApplicationManifestsQuota(proj, 10, 1024), "invalid value 'a lot' of annotation argocd.argoproj.io/quota-max-application-manifests-size of project my-proj: must be a non-negative quantity")
+}
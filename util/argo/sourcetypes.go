@@ -0,0 +1,30 @@
+package argo
+
+import (
+	"maps"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// GetProjectEnabledSourceTypes returns the given globally enabled source types, with the source types disabled by the
+// annotation of the given project set to false. Unlike the global settings, the annotation can disable config
+// management plugins, which allows to prevent untrusted teams from running arbitrary plugins. Like globally disabled
+// source types, sources of a disabled type are treated as plain YAML manifests.
+func GetProjectEnabledSourceTypes(proj *argoappv1.AppProject, enabledSourceTypes map[string]bool) map[string]bool {
+	val, ok := proj.GetAnnotations()[common.AnnotationKeyDisabledSourceTypes]
+	if !ok || strings.TrimSpace(val) == "" {
+		return enabledSourceTypes
+	}
+	res := maps.Clone(enabledSourceTypes)
+	if res == nil {
+		res = map[string]bool{}
+	}
+	for _, sourceType := range strings.Split(val, ",") {
+		if sourceType = strings.TrimSpace(sourceType); sourceType != "" {
+			res[sourceType] = false
+		}
+	}
+	return res
+}
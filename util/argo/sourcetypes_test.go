@@ -0,0 +1,24 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestGetProjectEnabledSourceTypes(t *testing.T) {
+	enabled := map[string]bool{"Helm": true, "Kustomize": true, "Directory": false, "Plugin": true}
+
+	proj := &argoappv1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	assert.Equal(t, enabled, GetProjectEnabledSourceTypes(proj, enabled))
+
+	proj.Annotations = map[string]string{common.AnnotationKeyDisabledSourceTypes: "Plugin, Helm"}
+	assert.Equal(t, map[string]bool{"Helm": false, "Kustomize": true, "Directory": false, "Plugin": false}, GetProjectEnabledSourceTypes(proj, enabled))
+	assert.True(t, enabled["Plugin"], "the global settings must not be modified")
+
+	assert.Equal(t, map[string]bool{"Plugin": false, "Helm": false}, GetProjectEnabledSourceTypes(proj, nil))
+}
@@ -71,6 +71,22 @@ func (c *Cache) SetAppManagedResources(appName string, managedResources []*appv1
 	return c.SetItem(appManagedResourcesKey(appName), managedResources, c.appStateCacheExpiration, managedResources == nil)
 }
 
+func appResourcesRevisionsKey(appName string) string {
+	return fmt.Sprintf("app|resources-revisions|%s", appName)
+}
+
+// GetAppResourcesRevisions returns the revisions which last changed the resources of the application, keyed by resource key
+func (c *Cache) GetAppResourcesRevisions(appName string, res *map[string]string) error {
+	return c.GetItem(appResourcesRevisionsKey(appName), res)
+}
+
+// SetAppResourcesRevisions stores the revisions which last changed the resources of the application, keyed by resource key.
+// The revisions are persisted in the resources of the application status by the next refresh of the application, the
+// cache only holds the revisions of syncs which completed since then.
+func (c *Cache) SetAppResourcesRevisions(appName string, revisions map[string]string) error {
+	return c.SetItem(appResourcesRevisionsKey(appName), revisions, c.appStateCacheExpiration, revisions == nil)
+}
+
 func appResourcesTreeKey(appName string, shard int64) string {
 	key := fmt.Sprintf("app|resources-tree|%s", appName)
 	if shard > 0 {
@@ -59,6 +59,24 @@ func TestCache_GetAppResourcesTree(t *testing.T) {
 	assert.Equal(t, &ApplicationTree{Nodes: []ResourceNode{{}}}, value)
 }
 
+func TestCache_GetAppResourcesRevisions(t *testing.T) {
+	cache := newFixtures().Cache
+	// cache miss
+	value := map[string]string{}
+	err := cache.GetAppResourcesRevisions("my-appname", &value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	err = cache.SetAppResourcesRevisions("my-appname", map[string]string{"apps/Deployment/default/my-name": "abc123"})
+	require.NoError(t, err)
+	// cache miss
+	err = cache.GetAppResourcesRevisions("other-appname", &value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// cache hit
+	err = cache.GetAppResourcesRevisions("my-appname", &value)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"apps/Deployment/default/my-name": "abc123"}, value)
+}
+
 func TestAddCacheFlagsToCmd(t *testing.T) {
 	cache, err := AddCacheFlagsToCmd(&cobra.Command{})()
 	require.NoError(t, err)
@@ -0,0 +1,381 @@
+package cache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/util/crypto"
+)
+
+const (
+	// objectStoreRequestTimeout limits the duration of a single object store request, so that a slow or unavailable
+	// object store only delays cache misses instead of blocking them
+	objectStoreRequestTimeout = 10 * time.Second
+	// objectStoreWriteQueueSize is the number of writes to the object store which are buffered. Writes are dropped if the
+	// queue is full, since the entries are still available in the cache client.
+	objectStoreWriteQueueSize = 1000
+	// objectStoreCleanupInterval is the interval at which expired objects are deleted from the object store
+	objectStoreCleanupInterval = time.Hour
+	// objectStoreCleanupTimeout limits the duration of the deletion of expired objects, which lists the whole bucket
+	objectStoreCleanupTimeout = 10 * time.Minute
+)
+
+// ObjectStore stores serialized cache entries as objects of a bucket
+type ObjectStore interface {
+	// Get returns the object with the given name or ErrCacheMiss if it does not exist
+	Get(ctx context.Context, name string) ([]byte, error)
+	Put(ctx context.Context, name string, data []byte) error
+	Delete(ctx context.Context, name string) error
+	// DeleteOlderThan deletes the objects which were last written before the given time
+	DeleteOlderThan(ctx context.Context, t time.Time) error
+}
+
+// NewObjectStoreClient creates a cache client which persists the entries whose key starts with one of the given prefixes
+// in an object store shared by all replicas, in addition to the given client. Cache misses of the given client are
+// served from the object store, so replicas don't have to regenerate entries which are evicted from or were never
+// written to the given client. Entries are written to the object store asynchronously, in the order they were written
+// to the given client. Entries loaded from the object store are written to the given client with the given expiration,
+// and objects which were written longer than the given expiration ago are periodically deleted from the object store.
+func NewObjectStoreClient(client CacheClient, store ObjectStore, keyPrefixes []string, expiration time.Duration) CacheClient {
+	c := &objectStoreClient{
+		client:         client,
+		store:          store,
+		keyPrefixes:    keyPrefixes,
+		expiration:     expiration,
+		requestTimeout: objectStoreRequestTimeout,
+		writes:         make(chan objectStoreWrite, objectStoreWriteQueueSize),
+	}
+	go c.runWrites()
+	if expiration > 0 {
+		go c.runCleanup(objectStoreCleanupInterval)
+	}
+	return c
+}
+
+type objectStoreClient struct {
+	client         CacheClient
+	store          ObjectStore
+	keyPrefixes    []string
+	expiration     time.Duration
+	requestTimeout time.Duration
+	writes         chan objectStoreWrite
+}
+
+// objectStoreWrite is a pending write of a key to the object store. The object is deleted if data is nil, unless
+// renameFrom is set, in which case the object stored under the renameFrom key is moved to the key.
+type objectStoreWrite struct {
+	key        string
+	data       []byte
+	renameFrom string
+}
+
+func (c *objectStoreClient) enqueueWrite(write objectStoreWrite) {
+	select {
+	case c.writes <- write:
+	default:
+		log.Warnf("Failed to write key '%s' to object store: write queue is full", write.key)
+	}
+}
+
+func (c *objectStoreClient) runWrites() {
+	for write := range c.writes {
+		ctx, cancel := context.WithTimeout(context.Background(), c.requestTimeout)
+		switch {
+		case write.renameFrom != "":
+			if err := c.renameObject(ctx, write.renameFrom, write.key); err != nil {
+				log.Warnf("Failed to rename key '%s' to '%s' in object store: %v", write.renameFrom, write.key, err)
+			}
+		case write.data == nil:
+			if err := c.store.Delete(ctx, objectName(write.key)); err != nil {
+				log.Warnf("Failed to delete key '%s' from object store: %v", write.key, err)
+			}
+		default:
+			if err := c.store.Put(ctx, objectName(write.key), write.data); err != nil {
+				log.Warnf("Failed to save key '%s' in object store: %v", write.key, err)
+			}
+		}
+		cancel()
+	}
+}
+
+func (c *objectStoreClient) renameObject(ctx context.Context, oldKey string, newKey string) error {
+	data, err := c.store.Get(ctx, objectName(oldKey))
+	if errors.Is(err, ErrCacheMiss) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := c.store.Put(ctx, objectName(newKey), data); err != nil {
+		return err
+	}
+	return c.store.Delete(ctx, objectName(oldKey))
+}
+
+// runCleanup deletes the expired objects from the object store at the given interval. Objects are shared by all
+// replicas, so concurrent cleanups of several replicas only delete the same objects.
+func (c *objectStoreClient) runCleanup(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		c.cleanup(time.Now())
+	}
+}
+
+func (c *objectStoreClient) cleanup(now time.Time) {
+	ctx, cancel := context.WithTimeout(context.Background(), objectStoreCleanupTimeout)
+	defer cancel()
+	if err := c.store.DeleteOlderThan(ctx, now.Add(-c.expiration)); err != nil {
+		log.Warnf("Failed to delete expired objects from object store: %v", err)
+	}
+}
+
+func (c *objectStoreClient) isStored(key string) bool {
+	for _, prefix := range c.keyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// objectName returns a name of the object storing the given key. Keys contain characters which are not allowed in
+// object names and might exceed the maximum length, so they are hashed.
+func objectName(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(h[:]) + ".json.gz"
+}
+
+func marshalObject(obj interface{}) ([]byte, error) {
+	buf := bytes.NewBuffer([]byte{})
+	w := gzip.NewWriter(buf)
+	if err := json.NewEncoder(w).Encode(obj); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func unmarshalObject(data []byte, obj interface{}) error {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if err := json.NewDecoder(r).Decode(obj); err != nil {
+		return fmt.Errorf("failed to decode cached data: %w", err)
+	}
+	return nil
+}
+
+// Set stores the given item in the given client and queues it to be stored in the object store. Items which must not be
+// overwritten are used as locks of a single replica and are never stored in the object store. Object store failures
+// are logged only, since the entry is still available in the given client.
+func (c *objectStoreClient) Set(item *Item) error {
+	if err := c.client.Set(item); err != nil {
+		return err
+	}
+	if !c.isStored(item.Key) || item.CacheActionOpts.DisableOverwrite {
+		return nil
+	}
+	if item.CacheActionOpts.Delete {
+		c.enqueueWrite(objectStoreWrite{key: item.Key})
+		return nil
+	}
+	data, err := marshalObject(item.Object)
+	if err != nil {
+		log.Warnf("Failed to encode key '%s' for object store: %v", item.Key, err)
+		return nil
+	}
+	c.enqueueWrite(objectStoreWrite{key: item.Key, data: data})
+	return nil
+}
+
+// Get returns the cache value from the given client if present. Otherwise loads it from the object store and persists
+// it in the given client to avoid future requests to the object store.
+func (c *objectStoreClient) Get(key string, obj interface{}) error {
+	err := c.client.Get(key, obj)
+	if !errors.Is(err, ErrCacheMiss) || !c.isStored(key) {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.requestTimeout)
+	defer cancel()
+	data, err := c.store.Get(ctx, objectName(key))
+	if err != nil {
+		if !errors.Is(err, ErrCacheMiss) {
+			log.Warnf("Failed to load key '%s' from object store: %v", key, err)
+		}
+		return ErrCacheMiss
+	}
+	if err := unmarshalObject(data, obj); err != nil {
+		log.Warnf("Failed to decode key '%s' from object store: %v", key, err)
+		return ErrCacheMiss
+	}
+	if err := c.client.Set(&Item{Key: key, Object: obj, CacheActionOpts: CacheActionOpts{Expiration: c.expiration}}); err != nil {
+		log.Warnf("Failed to save key '%s' loaded from object store: %v", key, err)
+	}
+	return nil
+}
+
+// Rename renames the key in the given client and queues the rename of the object stored under the old key. The object
+// is renamed even if the given client fails to rename the key, since the entry might only be stored in the object
+// store.
+func (c *objectStoreClient) Rename(oldKey string, newKey string, expiration time.Duration) error {
+	if c.isStored(oldKey) && c.isStored(newKey) {
+		c.enqueueWrite(objectStoreWrite{key: newKey, renameFrom: oldKey})
+	} else if c.isStored(oldKey) {
+		c.enqueueWrite(objectStoreWrite{key: oldKey})
+	}
+	return c.client.Rename(oldKey, newKey, expiration)
+}
+
+// Delete deletes the given key from the given client and queues its deletion from the object store, so that it is not
+// reordered with pending writes of the key.
+func (c *objectStoreClient) Delete(key string) error {
+	if c.isStored(key) {
+		c.enqueueWrite(objectStoreWrite{key: key})
+	}
+	return c.client.Delete(key)
+}
+
+func (c *objectStoreClient) OnUpdated(ctx context.Context, key string, callback func() error) error {
+	return c.client.OnUpdated(ctx, key, callback)
+}
+
+func (c *objectStoreClient) NotifyUpdated(key string) error {
+	return c.client.NotifyUpdated(key)
+}
+
+// NewEncryptedObjectStore creates an object store which encrypts the objects written to the given store with the given
+// 32 bytes key, so that the rendered Secrets of cached manifests are not readable by users having access to the bucket.
+// Objects which cannot be decrypted, e.g. objects written before the key was changed, are cache misses.
+func NewEncryptedObjectStore(store ObjectStore, key []byte) ObjectStore {
+	return &encryptedObjectStore{ObjectStore: store, key: key}
+}
+
+type encryptedObjectStore struct {
+	ObjectStore
+	key []byte
+}
+
+func (s *encryptedObjectStore) Get(ctx context.Context, name string) ([]byte, error) {
+	data, err := s.ObjectStore.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	data, err = crypto.Decrypt(data, s.key)
+	if err != nil {
+		log.Warnf("Failed to decrypt object '%s' of object store: %v", name, err)
+		return nil, ErrCacheMiss
+	}
+	return data, nil
+}
+
+func (s *encryptedObjectStore) Put(ctx context.Context, name string, data []byte) error {
+	data, err := crypto.Encrypt(data, s.key)
+	if err != nil {
+		return fmt.Errorf("error encrypting object: %w", err)
+	}
+	return s.ObjectStore.Put(ctx, name, data)
+}
+
+// S3ObjectStoreConfig configures an object store using the S3 API. Google Cloud Storage is supported using its
+// interoperability endpoint https://storage.googleapis.com and HMAC keys.
+type S3ObjectStoreConfig struct {
+	Bucket string
+	// Prefix is prepended to the names of all objects
+	Prefix   string
+	Endpoint string
+	Region   string
+	// ForcePathStyle addresses the bucket as part of the path instead of the host name, as required by most
+	// S3-compatible object stores
+	ForcePathStyle bool
+}
+
+// NewS3ObjectStore creates an object store using the S3 API. Credentials are resolved using the default credential
+// chain of the AWS SDK, e.g. the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables or IAM roles for
+// service accounts.
+func NewS3ObjectStore(config S3ObjectStoreConfig) (ObjectStore, error) {
+	awsConfig := &aws.Config{S3ForcePathStyle: aws.Bool(config.ForcePathStyle)}
+	if config.Endpoint != "" {
+		awsConfig.Endpoint = aws.String(config.Endpoint)
+	}
+	if config.Region != "" {
+		awsConfig.Region = aws.String(config.Region)
+	}
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating object store session: %w", err)
+	}
+	return &s3ObjectStore{client: s3.New(sess), bucket: config.Bucket, prefix: config.Prefix}, nil
+}
+
+type s3ObjectStore struct {
+	client *s3.S3
+	bucket string
+	prefix string
+}
+
+func (s *s3ObjectStore) Get(ctx context.Context, name string) ([]byte, error) {
+	out, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{Bucket: aws.String(s.bucket), Key: aws.String(s.prefix + name)})
+	if err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, ErrCacheMiss
+		}
+		return nil, err
+	}
+	defer out.Body.Close()
+	return io.ReadAll(out.Body)
+}
+
+func (s *s3ObjectStore) Put(ctx context.Context, name string, data []byte) error {
+	_, err := s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(s.prefix + name),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String("application/octet-stream"),
+	})
+	return err
+}
+
+func (s *s3ObjectStore) Delete(ctx context.Context, name string) error {
+	_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{Bucket: aws.String(s.bucket), Key: aws.String(s.prefix + name)})
+	return err
+}
+
+func (s *s3ObjectStore) DeleteOlderThan(ctx context.Context, t time.Time) error {
+	var deleteErr error
+	err := s.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{Bucket: aws.String(s.bucket), Prefix: aws.String(s.prefix)}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		var expired []*s3.ObjectIdentifier
+		for _, obj := range page.Contents {
+			if obj.LastModified != nil && obj.LastModified.Before(t) {
+				expired = append(expired, &s3.ObjectIdentifier{Key: obj.Key})
+			}
+		}
+		if len(expired) == 0 {
+			return true
+		}
+		_, deleteErr = s.client.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{Bucket: aws.String(s.bucket), Delete: &s3.Delete{Objects: expired, Quiet: aws.Bool(true)}})
+		return deleteErr == nil
+	})
+	if err != nil {
+		return err
+	}
+	return deleteErr
+}
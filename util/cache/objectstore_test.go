@@ -0,0 +1,202 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeObjectStore struct {
+	lock     sync.Mutex
+	objects  map[string][]byte
+	modified map[string]time.Time
+}
+
+func newFakeObjectStore() *fakeObjectStore {
+	return &fakeObjectStore{objects: map[string][]byte{}, modified: map[string]time.Time{}}
+}
+
+func (s *fakeObjectStore) Get(_ context.Context, name string) ([]byte, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	data, ok := s.objects[name]
+	if !ok {
+		return nil, ErrCacheMiss
+	}
+	return data, nil
+}
+
+func (s *fakeObjectStore) Put(_ context.Context, name string, data []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.objects[name] = data
+	s.modified[name] = time.Now()
+	return nil
+}
+
+func (s *fakeObjectStore) Delete(_ context.Context, name string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.objects, name)
+	return nil
+}
+
+func (s *fakeObjectStore) DeleteOlderThan(_ context.Context, t time.Time) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for name, modified := range s.modified {
+		if modified.Before(t) {
+			delete(s.objects, name)
+			delete(s.modified, name)
+		}
+	}
+	return nil
+}
+
+func (s *fakeObjectStore) len() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.objects)
+}
+
+// slowObjectStore blocks all requests until they are canceled
+type slowObjectStore struct{}
+
+func (s *slowObjectStore) Get(ctx context.Context, _ string) ([]byte, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (s *slowObjectStore) Put(ctx context.Context, _ string, _ []byte) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (s *slowObjectStore) Delete(ctx context.Context, _ string) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (s *slowObjectStore) DeleteOlderThan(ctx context.Context, _ time.Time) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestObjectStoreClient(t *testing.T) {
+	store := newFakeObjectStore()
+	replica1 := NewObjectStoreClient(NewInMemoryCache(time.Hour), store, []string{"mfst|"}, time.Hour)
+	replica2Cache := NewInMemoryCache(time.Hour)
+	replica2 := NewObjectStoreClient(replica2Cache, store, []string{"mfst|"}, time.Hour)
+
+	require.NoError(t, replica1.Set(&Item{Key: "mfst|my-key", Object: &foo{Bar: "bar"}}))
+	require.NoError(t, replica1.Set(&Item{Key: "other|my-key", Object: &foo{Bar: "bar"}}))
+	require.NoError(t, replica1.Set(&Item{Key: "mfst|locked", Object: CacheLockedValue, CacheActionOpts: CacheActionOpts{DisableOverwrite: true}}))
+	// objects are written asynchronously
+	assert.Eventually(t, func() bool { return store.len() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	t.Run("cache miss is served from object store", func(t *testing.T) {
+		obj := &foo{}
+		require.NoError(t, replica2.Get("mfst|my-key", obj))
+		assert.Equal(t, &foo{Bar: "bar"}, obj)
+		// the entry is persisted in the cache of the replica
+		cached := &foo{}
+		require.NoError(t, replica2Cache.Get("mfst|my-key", cached))
+		assert.Equal(t, &foo{Bar: "bar"}, cached)
+	})
+
+	t.Run("keys without prefix are not shared", func(t *testing.T) {
+		assert.Equal(t, ErrCacheMiss, replica2.Get("other|my-key", &foo{}))
+		assert.Equal(t, ErrCacheMiss, replica2.Get("mfst|locked", new(string)))
+	})
+
+	t.Run("delete removes object", func(t *testing.T) {
+		require.NoError(t, replica1.Delete("mfst|my-key"))
+		assert.Eventually(t, func() bool { return store.len() == 0 }, 5*time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestObjectStoreClient_SlowObjectStore(t *testing.T) {
+	client := NewObjectStoreClient(NewInMemoryCache(time.Hour), &slowObjectStore{}, []string{"mfst|"}, time.Hour)
+	client.(*objectStoreClient).requestTimeout = 10 * time.Millisecond
+
+	start := time.Now()
+	// writes do not wait for the object store
+	require.NoError(t, client.Set(&Item{Key: "mfst|my-key", Object: &foo{Bar: "bar"}}))
+	require.NoError(t, client.Delete("mfst|my-key"))
+	// reads give up after the request timeout
+	assert.Equal(t, ErrCacheMiss, client.Get("mfst|other-key", &foo{}))
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+// expirationRecordingClient records the expiration of the items written to the wrapped client
+type expirationRecordingClient struct {
+	CacheClient
+	expirations map[string]time.Duration
+}
+
+func (c *expirationRecordingClient) Set(item *Item) error {
+	c.expirations[item.Key] = item.CacheActionOpts.Expiration
+	return c.CacheClient.Set(item)
+}
+
+func TestObjectStoreClient_LoadedEntryExpiration(t *testing.T) {
+	store := newFakeObjectStore()
+	data, err := marshalObject(&foo{Bar: "bar"})
+	require.NoError(t, err)
+	require.NoError(t, store.Put(context.Background(), objectName("mfst|my-key"), data))
+
+	recorder := &expirationRecordingClient{CacheClient: NewInMemoryCache(time.Hour), expirations: map[string]time.Duration{}}
+	client := NewObjectStoreClient(recorder, store, []string{"mfst|"}, 24*time.Hour)
+	require.NoError(t, client.Get("mfst|my-key", &foo{}))
+	assert.Equal(t, 24*time.Hour, recorder.expirations["mfst|my-key"])
+}
+
+func TestObjectStoreClient_Rename(t *testing.T) {
+	store := newFakeObjectStore()
+	client := NewObjectStoreClient(NewInMemoryCache(time.Hour), store, []string{"mfst|"}, time.Hour)
+	require.NoError(t, client.Set(&Item{Key: "mfst|old", Object: &foo{Bar: "bar"}}))
+	assert.Eventually(t, func() bool { return store.len() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, client.Rename("mfst|old", "mfst|new", time.Hour))
+	assert.Eventually(t, func() bool {
+		_, err := store.Get(context.Background(), objectName("mfst|new"))
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	_, err := store.Get(context.Background(), objectName("mfst|old"))
+	require.ErrorIs(t, err, ErrCacheMiss, "the object must not be left under the old key")
+	assert.Equal(t, 1, store.len())
+}
+
+func TestObjectStoreClient_Cleanup(t *testing.T) {
+	store := newFakeObjectStore()
+	client := NewObjectStoreClient(NewInMemoryCache(time.Hour), store, []string{"mfst|"}, time.Hour).(*objectStoreClient)
+	require.NoError(t, store.Put(context.Background(), "expired", []byte("data")))
+	require.NoError(t, store.Put(context.Background(), "fresh", []byte("data")))
+	store.modified["expired"] = time.Now().Add(-2 * time.Hour)
+
+	client.cleanup(time.Now())
+	_, err := store.Get(context.Background(), "expired")
+	require.ErrorIs(t, err, ErrCacheMiss)
+	_, err = store.Get(context.Background(), "fresh")
+	require.NoError(t, err)
+}
+
+func TestEncryptedObjectStore(t *testing.T) {
+	store := newFakeObjectStore()
+	key := bytes.Repeat([]byte{1}, 32)
+	encrypted := NewEncryptedObjectStore(store, key)
+
+	require.NoError(t, encrypted.Put(context.Background(), "secret", []byte("kind: Secret")))
+	assert.NotContains(t, string(store.objects["secret"]), "kind: Secret")
+	data, err := encrypted.Get(context.Background(), "secret")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("kind: Secret"), data)
+
+	_, err = NewEncryptedObjectStore(store, bytes.Repeat([]byte{2}, 32)).Get(context.Background(), "secret")
+	require.ErrorIs(t, err, ErrCacheMiss, "objects which cannot be decrypted are cache misses")
+}
@@ -85,6 +85,12 @@ type Client interface {
 type EventHandlers struct {
 	OnLsRemote func(repo string) func()
 	OnFetch    func(repo string) func()
+	// OnFetchSize is called with the number of bytes added to the local object store by a successful fetch
+	OnFetchSize func(repo string, size int64)
+	// OnRequest is called before each ls-remote and fetch request, which is not made if it returns an error
+	OnRequest func(repo string) error
+	// OnResponseHeader is called with the header of each HTTP response received by ls-remote requests
+	OnResponseHeader func(repo string, header http.Header)
 }
 
 // nativeGitClient implements Client interface using git CLI
@@ -185,6 +191,18 @@ func NewClientExt(rawRepoURL string, root string, creds Creds, insecure bool, en
 
 var gitClientTimeout = env.ParseDurationFromEnv("ARGOCD_GIT_REQUEST_TIMEOUT", 15*time.Second, 0, math.MaxInt64)
 
+var (
+	// gitWriteCommitGraph makes fetches update the commit-graph file of the local repository, which speeds up the
+	// negotiation of subsequent fetches and history traversals of busy repositories
+	gitWriteCommitGraph = env.ParseBoolFromEnv("ARGOCD_GIT_WRITE_COMMIT_GRAPH", false)
+	// gitFetchNegotiationAlgorithm overrides the algorithm used by fetches to negotiate the commits to send, e.g.
+	// "skipping" reduces the number of negotiation rounds for repositories with many local commits
+	gitFetchNegotiationAlgorithm = env.StringFromEnv("ARGOCD_GIT_FETCH_NEGOTIATION_ALGORITHM", "")
+	// gitFetchSizeMetricsEnabled makes fetches measure the size of the downloaded objects even if no fetch tuning option
+	// is set, which requires two extra git commands per fetch
+	gitFetchSizeMetricsEnabled = env.ParseBoolFromEnv("ARGOCD_GIT_FETCH_SIZE_METRICS_ENABLED", false)
+)
+
 // Returns a HTTP client object suitable for go-git to use using the following
 // pattern:
 //   - If insecure is true, always returns a client with certificate verification
@@ -349,14 +367,55 @@ func (m *nativeGitClient) IsLFSEnabled() bool {
 	return m.enableLfs
 }
 
+// fetchConfigArgs returns the git configuration options which tune fetches of the local repository
+func fetchConfigArgs() []string {
+	var args []string
+	if gitWriteCommitGraph {
+		args = append(args, "-c", "fetch.writeCommitGraph=true")
+	}
+	if gitFetchNegotiationAlgorithm != "" {
+		args = append(args, "-c", "fetch.negotiationAlgorithm="+gitFetchNegotiationAlgorithm)
+	}
+	return args
+}
+
 func (m *nativeGitClient) fetch(revision string) error {
-	var err error
+	args := append(fetchConfigArgs(), "fetch", "origin")
 	if revision != "" {
-		err = m.runCredentialedCmd("fetch", "origin", revision, "--tags", "--force", "--prune")
-	} else {
-		err = m.runCredentialedCmd("fetch", "origin", "--tags", "--force", "--prune")
+		args = append(args, revision)
 	}
-	return err
+	return m.runCredentialedCmd(append(args, "--tags", "--force", "--prune")...)
+}
+
+// measureFetchSize returns whether fetches measure the size of the downloaded objects, which is only done if the metric
+// or one of the fetch tuning options is enabled
+func measureFetchSize() bool {
+	return gitFetchSizeMetricsEnabled || len(fetchConfigArgs()) > 0
+}
+
+// objectsSize returns the disk space in bytes used by the objects of the local repository
+func (m *nativeGitClient) objectsSize() (int64, error) {
+	out, err := m.runCmd("count-objects", "-v")
+	if err != nil {
+		return 0, err
+	}
+	return parseCountObjectsSize(out), nil
+}
+
+// parseCountObjectsSize sums the sizes of the loose and packed objects reported by "git count-objects -v"
+func parseCountObjectsSize(out string) int64 {
+	var size int64
+	for _, line := range strings.Split(out, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok || (key != "size" && key != "size-pack") {
+			continue
+		}
+		// sizes are reported in KiB
+		if kib, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64); err == nil {
+			size += kib * 1024
+		}
+	}
+	return size
 }
 
 // IsRevisionPresent checks to see if the given revision already exists locally.
@@ -376,13 +435,30 @@ func (m *nativeGitClient) IsRevisionPresent(revision string) bool {
 
 // Fetch fetches latest updates from origin
 func (m *nativeGitClient) Fetch(revision string) error {
+	if m.OnRequest != nil {
+		if err := m.OnRequest(m.repoURL); err != nil {
+			return err
+		}
+	}
 	if m.OnFetch != nil {
 		done := m.OnFetch(m.repoURL)
 		defer done()
 	}
 
+	measureSize := m.OnFetchSize != nil && measureFetchSize()
+	var sizeBefore int64
+	if measureSize {
+		sizeBefore, _ = m.objectsSize()
+	}
+
 	err := m.fetch(revision)
 
+	if err == nil && measureSize {
+		if sizeAfter, sizeErr := m.objectsSize(); sizeErr == nil {
+			m.OnFetchSize(m.repoURL, max(sizeAfter-sizeBefore, 0))
+		}
+	}
+
 	// When we have LFS support enabled, check for large files and fetch them too.
 	if err == nil && m.IsLFSEnabled() {
 		largeFiles, err := m.LsLargeFiles()
@@ -531,6 +607,11 @@ func (m *nativeGitClient) getRefs() ([]*plumbing.Reference, error) {
 		}()
 	}
 
+	if m.OnRequest != nil {
+		if err := m.OnRequest(m.repoURL); err != nil {
+			return nil, err
+		}
+	}
 	if m.OnLsRemote != nil {
 		done := m.OnLsRemote(m.repoURL)
 		defer done()
@@ -551,7 +632,13 @@ func (m *nativeGitClient) getRefs() ([]*plumbing.Reference, error) {
 	if err != nil {
 		return nil, err
 	}
-	res, err := listRemote(remote, &git.ListOptions{Auth: auth}, m.insecure, m.creds, m.proxy, m.noProxy)
+	var onResponseHeader func(http.Header)
+	if m.OnResponseHeader != nil {
+		onResponseHeader = func(header http.Header) {
+			m.OnResponseHeader(m.repoURL, header)
+		}
+	}
+	res, err := listRemote(remote, &git.ListOptions{Auth: auth}, m.insecure, m.creds, m.proxy, m.noProxy, onResponseHeader)
 	if err == nil && m.gitRefCache != nil {
 		if err := m.gitRefCache.SetGitReferences(m.repoURL, res); err != nil {
 			log.Warnf("Failed to store git references to cache: %v", err)
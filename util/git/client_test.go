@@ -1,6 +1,7 @@
 package git
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -75,6 +76,103 @@ func Test_nativeGitClient_Fetch_Prune(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_nativeGitClient_Fetch_Size(t *testing.T) {
+	defer func(metricsEnabled bool) { gitFetchSizeMetricsEnabled = metricsEnabled }(gitFetchSizeMetricsEnabled)
+	gitFetchSizeMetricsEnabled = true
+
+	tempDir, err := _createEmptyGitRepo()
+	require.NoError(t, err)
+
+	var fetchedRepo string
+	fetchedSize := int64(-1)
+	client, err := NewClient(fmt.Sprintf("file://%s", tempDir), NopCreds{}, true, false, "", "", WithEventHandlers(EventHandlers{
+		OnFetchSize: func(repo string, size int64) {
+			fetchedRepo = repo
+			fetchedSize = size
+		},
+	}))
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	err = client.Fetch("")
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("file://%s", tempDir), fetchedRepo)
+	assert.Positive(t, fetchedSize)
+}
+
+func Test_parseCountObjectsSize(t *testing.T) {
+	out := `count: 3
+size: 12
+in-pack: 120
+packs: 1
+size-pack: 100
+prune-packable: 0
+garbage: 0
+size-garbage: 0`
+	assert.Equal(t, int64(112*1024), parseCountObjectsSize(out))
+	assert.Equal(t, int64(0), parseCountObjectsSize(""))
+}
+
+func Test_fetchConfigArgs(t *testing.T) {
+	defer func(writeCommitGraph bool, negotiationAlgorithm string) {
+		gitWriteCommitGraph = writeCommitGraph
+		gitFetchNegotiationAlgorithm = negotiationAlgorithm
+	}(gitWriteCommitGraph, gitFetchNegotiationAlgorithm)
+
+	gitWriteCommitGraph = false
+	gitFetchNegotiationAlgorithm = ""
+	assert.Empty(t, fetchConfigArgs())
+
+	gitWriteCommitGraph = true
+	gitFetchNegotiationAlgorithm = "skipping"
+	assert.Equal(t, []string{"-c", "fetch.writeCommitGraph=true", "-c", "fetch.negotiationAlgorithm=skipping"}, fetchConfigArgs())
+}
+
+func Test_nativeGitClient_OnRequest(t *testing.T) {
+	tempDir, err := _createEmptyGitRepo()
+	require.NoError(t, err)
+
+	requestErr := errors.New("rate limit exceeded")
+	client, err := NewClient(fmt.Sprintf("file://%s", tempDir), NopCreds{}, true, false, "", "", WithEventHandlers(EventHandlers{
+		OnRequest: func(_ string) error {
+			return requestErr
+		},
+		OnFetch: func(_ string) func() {
+			t.Fatal("fetch must not be made if OnRequest returns an error")
+			return func() {}
+		},
+	}))
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	err = client.Fetch("")
+	require.ErrorIs(t, err, requestErr)
+}
+
+func Test_measureFetchSize(t *testing.T) {
+	defer func(writeCommitGraph bool, negotiationAlgorithm string, metricsEnabled bool) {
+		gitWriteCommitGraph = writeCommitGraph
+		gitFetchNegotiationAlgorithm = negotiationAlgorithm
+		gitFetchSizeMetricsEnabled = metricsEnabled
+	}(gitWriteCommitGraph, gitFetchNegotiationAlgorithm, gitFetchSizeMetricsEnabled)
+
+	gitWriteCommitGraph = false
+	gitFetchNegotiationAlgorithm = ""
+	gitFetchSizeMetricsEnabled = false
+	assert.False(t, measureFetchSize())
+
+	gitFetchSizeMetricsEnabled = true
+	assert.True(t, measureFetchSize())
+
+	gitFetchSizeMetricsEnabled = false
+	gitFetchNegotiationAlgorithm = "skipping"
+	assert.True(t, measureFetchSize())
+}
+
 func Test_IsAnnotatedTag(t *testing.T) {
 	tempDir := t.TempDir()
 	client, err := NewClient(fmt.Sprintf("file://%s", tempDir), NopCreds{}, true, false, "", "")
@@ -2,6 +2,7 @@ package git
 
 import (
 	"fmt"
+	nethttp "net/http"
 	neturl "net/url"
 
 	"github.com/go-git/go-git/v5"
@@ -16,8 +17,8 @@ import (
 // As workaround methods `newUploadPackSession`, `newClient` and `listRemote` were copied from https://github.com/src-d/go-git/blob/master/remote.go and modified to use
 // transport with InsecureSkipVerify flag is verification should be disabled.
 
-func newUploadPackSession(url string, auth transport.AuthMethod, insecure bool, creds Creds, proxy string, noProxy string) (transport.UploadPackSession, error) {
-	c, ep, err := newClient(url, insecure, creds, proxy, noProxy)
+func newUploadPackSession(url string, auth transport.AuthMethod, insecure bool, creds Creds, proxy string, noProxy string, onResponseHeader func(nethttp.Header)) (transport.UploadPackSession, error) {
+	c, ep, err := newClient(url, insecure, creds, proxy, noProxy, onResponseHeader)
 	if err != nil {
 		return nil, err
 	}
@@ -25,7 +26,7 @@ func newUploadPackSession(url string, auth transport.AuthMethod, insecure bool,
 	return c.NewUploadPackSession(ep, auth)
 }
 
-func newClient(url string, insecure bool, creds Creds, proxy string, noProxy string) (transport.Transport, *transport.Endpoint, error) {
+func newClient(url string, insecure bool, creds Creds, proxy string, noProxy string, onResponseHeader func(nethttp.Header)) (transport.Transport, *transport.Endpoint, error) {
 	ep, err := transport.NewEndpoint(url)
 	if err != nil {
 		return nil, nil, err
@@ -57,11 +58,30 @@ func newClient(url string, insecure bool, creds Creds, proxy string, noProxy str
 		return c, ep, nil
 	}
 
-	return http.NewClient(GetRepoHTTPClient(url, insecure, creds, proxy, noProxy)), ep, nil
+	httpClient := GetRepoHTTPClient(url, insecure, creds, proxy, noProxy)
+	if onResponseHeader != nil {
+		httpClient.Transport = &responseHeaderTransport{next: httpClient.Transport, onResponseHeader: onResponseHeader}
+	}
+	return http.NewClient(httpClient), ep, nil
+}
+
+// responseHeaderTransport passes the header of every HTTP response to a callback, e.g. to track the rate limits reported
+// by Git providers
+type responseHeaderTransport struct {
+	next             nethttp.RoundTripper
+	onResponseHeader func(nethttp.Header)
+}
+
+func (t *responseHeaderTransport) RoundTrip(req *nethttp.Request) (*nethttp.Response, error) {
+	res, err := t.next.RoundTrip(req)
+	if err == nil {
+		t.onResponseHeader(res.Header)
+	}
+	return res, err
 }
 
-func listRemote(r *git.Remote, o *git.ListOptions, insecure bool, creds Creds, proxy string, noProxy string) (rfs []*plumbing.Reference, err error) {
-	s, err := newUploadPackSession(r.Config().URLs[0], o.Auth, insecure, creds, proxy, noProxy)
+func listRemote(r *git.Remote, o *git.ListOptions, insecure bool, creds Creds, proxy string, noProxy string, onResponseHeader func(nethttp.Header)) (rfs []*plumbing.Reference, err error) {
+	s, err := newUploadPackSession(r.Config().URLs[0], o.Auth, insecure, creds, proxy, noProxy, onResponseHeader)
 	if err != nil {
 		return nil, err
 	}
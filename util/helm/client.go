@@ -54,6 +54,11 @@ type indexCache interface {
 	GetHelmIndex(repo string, indexData *[]byte) error
 }
 
+type chartStore interface {
+	GetHelmChart(key string) ([]byte, error)
+	SetHelmChart(key string, data []byte) error
+}
+
 type Client interface {
 	CleanChartCache(chart string, version string, project string) error
 	ExtractChart(chart string, version string, project string, passCredentials bool, manifestMaxExtractedSize int64, disableManifestMaxExtractedSize bool) (string, argoio.Closer, error)
@@ -70,6 +75,13 @@ func WithIndexCache(indexCache indexCache) ClientOpts {
 	}
 }
 
+// WithChartStore shares the chart archives with other replicas through the given store
+func WithChartStore(chartStore chartStore) ClientOpts {
+	return func(c *nativeHelmChart) {
+		c.chartStore = chartStore
+	}
+}
+
 func WithChartPaths(chartPaths argoio.TempPaths) ClientOpts {
 	return func(c *nativeHelmChart) {
 		c.chartCachePaths = chartPaths
@@ -105,6 +117,7 @@ type nativeHelmChart struct {
 	repoLock        sync.KeyLock
 	enableOci       bool
 	indexCache      indexCache
+	chartStore      chartStore
 	proxy           string
 	noProxy         string
 }
@@ -177,6 +190,9 @@ func (c *nativeHelmChart) ExtractChart(chart string, version string, project str
 		_ = os.RemoveAll(tempDir)
 		return "", nil, fmt.Errorf("error checking existence of cached chart path: %w", err)
 	}
+	if !exists {
+		exists = c.loadStoredChart(chart, version, project, cachedChartPath)
+	}
 
 	if !exists {
 		// create empty temp directory to extract chart from the registry
@@ -229,6 +245,7 @@ func (c *nativeHelmChart) ExtractChart(chart string, version string, project str
 		if err != nil {
 			return "", nil, fmt.Errorf("error renaming file from %s to %s: %w", chartFilePath, cachedChartPath, err)
 		}
+		c.storeChart(chart, version, project, cachedChartPath)
 	}
 
 	err = untarChart(tempDir, cachedChartPath, manifestMaxExtractedSize, disableManifestMaxExtractedSize)
@@ -385,12 +402,64 @@ func normalizeChartName(chart string) string {
 	return nc
 }
 
-func (c *nativeHelmChart) getCachedChartPath(chart string, version string, project string) (string, error) {
+// chartCacheKey returns the key of the cached archive of the given chart. The project is part of the key, so that charts
+// fetched using the credentials of a project are not shared with other projects.
+func (c *nativeHelmChart) chartCacheKey(chart string, version string, project string) (string, error) {
 	keyData, err := json.Marshal(map[string]string{"url": c.repoURL, "chart": chart, "version": version, "project": project})
 	if err != nil {
 		return "", fmt.Errorf("error marshaling cache key data: %w", err)
 	}
-	return c.chartCachePaths.GetPath(string(keyData))
+	return string(keyData), nil
+}
+
+func (c *nativeHelmChart) getCachedChartPath(chart string, version string, project string) (string, error) {
+	key, err := c.chartCacheKey(chart, version, project)
+	if err != nil {
+		return "", err
+	}
+	return c.chartCachePaths.GetPath(key)
+}
+
+// loadStoredChart writes the archive of the given chart from the chart store to the given path, and returns whether the
+// archive was found
+func (c *nativeHelmChart) loadStoredChart(chart string, version string, project string, cachedChartPath string) bool {
+	if c.chartStore == nil {
+		return false
+	}
+	key, err := c.chartCacheKey(chart, version, project)
+	if err != nil {
+		return false
+	}
+	data, err := c.chartStore.GetHelmChart(key)
+	if err != nil {
+		if !errors.Is(err, cache.ErrCacheMiss) {
+			log.Warnf("Failed to load chart %s:%s of %s from chart store: %v", chart, version, c.repoURL, err)
+		}
+		return false
+	}
+	if err := os.WriteFile(cachedChartPath, data, 0o600); err != nil {
+		log.Warnf("Failed to write chart %s:%s of %s loaded from chart store: %v", chart, version, c.repoURL, err)
+		return false
+	}
+	return true
+}
+
+// storeChart writes the archive of the given chart to the chart store
+func (c *nativeHelmChart) storeChart(chart string, version string, project string, cachedChartPath string) {
+	if c.chartStore == nil {
+		return
+	}
+	key, err := c.chartCacheKey(chart, version, project)
+	if err != nil {
+		return
+	}
+	data, err := os.ReadFile(cachedChartPath)
+	if err == nil {
+		err = c.chartStore.SetHelmChart(key, data)
+	}
+	if err != nil {
+		log.Warnf("Failed to save chart %s:%s of %s in chart store: %v", chart, version, c.repoURL, err)
+	}
 }
 
 // Ensures that given OCI registries URL does not have protocol
@@ -1,7 +1,9 @@
 package helm
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -17,6 +19,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v2"
 
+	"github.com/argoproj/argo-cd/v2/util/cache"
 	"github.com/argoproj/argo-cd/v2/util/io"
 )
 
@@ -87,6 +90,53 @@ func Test_nativeHelmChart_ExtractChart(t *testing.T) {
 	assert.True(t, info.IsDir())
 }
 
+type fakeChartStore struct {
+	charts map[string][]byte
+}
+
+func (f *fakeChartStore) GetHelmChart(key string) ([]byte, error) {
+	data, ok := f.charts[key]
+	if !ok {
+		return nil, cache.ErrCacheMiss
+	}
+	return data, nil
+}
+
+func (f *fakeChartStore) SetHelmChart(key string, data []byte) error {
+	f.charts[key] = data
+	return nil
+}
+
+func Test_nativeHelmChart_ExtractChart_chartStore(t *testing.T) {
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+	chartYAML := []byte("apiVersion: v2\nname: my-chart\nversion: 1.0.0\n")
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "my-chart/Chart.yaml", Mode: 0o644, Size: int64(len(chartYAML))}))
+	_, err := tw.Write(chartYAML)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+
+	store := &fakeChartStore{charts: map[string][]byte{}}
+	client := NewClient("https://charts.example.com", Creds{}, false, "", "", WithChartStore(store), WithChartPaths(io.NewRandomizedTempPaths(t.TempDir())))
+	key, err := client.(*nativeHelmChart).chartCacheKey("my-chart", "1.0.0", "default")
+	require.NoError(t, err)
+	store.charts[key] = buf.Bytes()
+
+	// the chart is not fetched from the unreachable repository since it is loaded from the chart store
+	path, closer, err := client.ExtractChart("my-chart", "1.0.0", "default", false, math.MaxInt64, false)
+	require.NoError(t, err)
+	defer io.Close(closer)
+	data, err := os.ReadFile(filepath.Join(path, "Chart.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, chartYAML, data)
+
+	otherProjectKey, err := client.(*nativeHelmChart).chartCacheKey("my-chart", "1.0.0", "other")
+	require.NoError(t, err)
+	assert.NotEqual(t, key, otherProjectKey, "charts must not be shared between projects")
+}
+
 func Test_nativeHelmChart_ExtractChartWithLimiter(t *testing.T) {
 	client := NewClient("https://argoproj.github.io/argo-helm", Creds{}, false, "", "")
 	_, _, err := client.ExtractChart("argo-cd", "0.7.1", "", false, 100, false)
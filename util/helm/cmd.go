@@ -19,8 +19,18 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/proxy"
 )
 
+// pinnedVersionRegex matches full Helm versions, e.g. v3.14.4. Applications pinned to such a version are rendered using
+// a binary named helm-<version>, which has to be installed in the PATH of the repo server.
+var pinnedVersionRegex = regexp.MustCompile(`^v3\.\d+\.\d+$`)
+
+// IsPinnedVersion returns whether the given version is a full Helm version, e.g. v3.14.4, rather than a major version
+func IsPinnedVersion(version string) bool {
+	return pinnedVersionRegex.MatchString(version)
+}
+
 // A thin wrapper around the "helm" command, adding logging and error translation.
 type Cmd struct {
+	binary    string
 	helmHome  string
 	WorkDir   string
 	IsLocal   bool
@@ -35,6 +45,18 @@ func NewCmd(workDir string, version string, proxy string, noProxy string) (*Cmd,
 	case "", "v3":
 		return NewCmdWithVersion(workDir, false, proxy, noProxy)
 	}
+	if IsPinnedVersion(version) {
+		binary := "helm-" + version
+		if _, err := exec.LookPath(binary); err != nil {
+			return nil, fmt.Errorf("helm version '%s' is not installed: %s not found in PATH", version, binary)
+		}
+		cmd, err := NewCmdWithVersion(workDir, false, proxy, noProxy)
+		if err != nil {
+			return nil, err
+		}
+		cmd.binary = binary
+		return cmd, nil
+	}
 	return nil, fmt.Errorf("helm chart version '%s' is not supported", version)
 }
 
@@ -51,7 +73,11 @@ var redactor = func(text string) string {
 }
 
 func (c Cmd) run(args ...string) (string, string, error) {
-	cmd := exec.Command("helm", args...)
+	binary := c.binary
+	if binary == "" {
+		binary = "helm"
+	}
+	cmd := exec.Command(binary, args...)
 	cmd.Dir = c.WorkDir
 	cmd.Env = os.Environ()
 	if !c.IsLocal {
@@ -2,6 +2,9 @@ package helm
 
 import (
 	"log"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -42,6 +45,21 @@ func TestNewCmd_helmInvalidVersion(t *testing.T) {
 	assert.EqualError(t, err, "helm chart version 'abcd' is not supported")
 }
 
+func TestNewCmd_helmPinnedVersion(t *testing.T) {
+	binDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "helm-v3.14.4"), []byte("#!/bin/sh\necho pinned $@\n"), 0o755))
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	cmd, err := NewCmd(".", "v3.14.4", "", "")
+	require.NoError(t, err)
+	out, _, err := cmd.run("version")
+	require.NoError(t, err)
+	assert.Equal(t, "pinned version", strings.TrimSpace(out))
+
+	_, err = NewCmd(".", "v3.1.0", "", "")
+	assert.EqualError(t, err, "helm version 'v3.1.0' is not installed: helm-v3.1.0 not found in PATH")
+}
+
 func TestNewCmd_withProxy(t *testing.T) {
 	cmd, err := NewCmd(".", "", "https://proxy:8888", ".argoproj.io")
 	require.NoError(t, err)
@@ -0,0 +1,146 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// EnvSupportedVersions overrides the range of Kubernetes versions supported by Argo CD, e.g. v1.28-v1.31
+	EnvSupportedVersions = "ARGOCD_SUPPORTED_KUBERNETES_VERSIONS"
+
+	// supportedPreviousMinorVersions is the number of minor versions before the version of the client libraries which
+	// are supported by default
+	supportedPreviousMinorVersions = 3
+)
+
+// ProbeResult is the outcome of probing the API server of a cluster
+type ProbeResult struct {
+	// Latency is the duration of the authenticated discovery request
+	Latency time.Duration
+	// ServerVersion is the git version of the API server, e.g. v1.31.1
+	ServerVersion string
+	// Err is set if the API server is not reachable or rejected the credentials
+	Err error
+}
+
+// ProbeAPIServer checks that the API server of the given config is reachable and accepts the configured credentials.
+// Unlike /version, the /api discovery endpoint requires an authenticated user, so expired or revoked credentials are
+// detected as well. The version of the API server is only requested if withVersion is true.
+func ProbeAPIServer(ctx context.Context, config *rest.Config, withVersion bool) ProbeResult {
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return ProbeResult{Err: fmt.Errorf("failed to create client: %w", err)}
+	}
+	start := time.Now()
+	err = clientset.Discovery().RESTClient().Get().AbsPath("/api").Do(ctx).Error()
+	result := ProbeResult{Latency: time.Since(start)}
+	switch {
+	case apierrors.IsUnauthorized(err):
+		result.Err = fmt.Errorf("credentials were rejected by the API server: %w", err)
+		return result
+	case apierrors.IsForbidden(err):
+		result.Err = fmt.Errorf("credentials are not permitted to perform discovery: %w", err)
+		return result
+	case err != nil:
+		result.Err = fmt.Errorf("API server is not reachable: %w", err)
+		return result
+	}
+	if !withVersion {
+		return result
+	}
+	version, err := clientset.Discovery().ServerVersion()
+	if err != nil {
+		result.Err = fmt.Errorf("failed to get server version: %w", err)
+		return result
+	}
+	result.ServerVersion = version.GitVersion
+	return result
+}
+
+// SupportedVersions is a range of Kubernetes v1 minor versions supported by Argo CD. The zero value supports any version.
+type SupportedVersions struct {
+	MinMinor int
+	MaxMinor int
+}
+
+func (v SupportedVersions) String() string {
+	return fmt.Sprintf("v1.%d-v1.%d", v.MinMinor, v.MaxMinor)
+}
+
+// ParseSupportedVersions parses a range of Kubernetes versions, e.g. v1.28-v1.31
+func ParseSupportedVersions(versions string) (SupportedVersions, error) {
+	minVersion, maxVersion, ok := strings.Cut(versions, "-")
+	if !ok {
+		return SupportedVersions{}, fmt.Errorf("invalid range of Kubernetes versions '%s', expected e.g. v1.28-v1.31", versions)
+	}
+	minMajor, minMinor, minOK := parseMajorMinor(strings.TrimSpace(minVersion))
+	maxMajor, maxMinor, maxOK := parseMajorMinor(strings.TrimSpace(maxVersion))
+	if !minOK || !maxOK || minMajor != 1 || maxMajor != 1 || minMinor > maxMinor {
+		return SupportedVersions{}, fmt.Errorf("invalid range of Kubernetes versions '%s', expected e.g. v1.28-v1.31", versions)
+	}
+	return SupportedVersions{MinMinor: minMinor, MaxMinor: maxMinor}, nil
+}
+
+// DefaultSupportedVersions returns the Kubernetes versions supported by default, which are the version of the client
+// libraries and the three minor versions before it, like the versions Argo CD is tested with. The client version is the
+// version of the k8s.io/client-go module, e.g. v0.31.0, and any version is supported if it is unknown.
+func DefaultSupportedVersions(clientVersion string) SupportedVersions {
+	_, clientMinor, ok := parseMajorMinor(clientVersion)
+	if !ok {
+		return SupportedVersions{}
+	}
+	return SupportedVersions{MinMinor: max(clientMinor-supportedPreviousMinorVersions, 0), MaxMinor: clientMinor}
+}
+
+// GetSupportedVersions returns the Kubernetes versions configured by the ARGOCD_SUPPORTED_KUBERNETES_VERSIONS env
+// variable, or the versions supported by default with the given client version
+func GetSupportedVersions(clientVersion string) SupportedVersions {
+	if val := os.Getenv(EnvSupportedVersions); val != "" {
+		versions, err := ParseSupportedVersions(val)
+		if err == nil {
+			return versions
+		}
+		log.Warnf("Ignoring %s: %v", EnvSupportedVersions, err)
+	}
+	return DefaultSupportedVersions(clientVersion)
+}
+
+// UnsupportedVersionMessage returns a message if the given Kubernetes version of an API server is not supported, or an
+// empty string otherwise. Unknown versions are ignored.
+func (v SupportedVersions) UnsupportedVersionMessage(serverVersion string) string {
+	if v == (SupportedVersions{}) {
+		return ""
+	}
+	major, minor, ok := parseMajorMinor(serverVersion)
+	if !ok || (major == 1 && minor >= v.MinMinor && minor <= v.MaxMinor) {
+		return ""
+	}
+	return fmt.Sprintf("Kubernetes version %s is not in the range %s supported by Argo CD", serverVersion, v)
+}
+
+func parseMajorMinor(version string) (int, int, bool) {
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, false
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	// minor versions of some distributions carry a suffix, e.g. 31+
+	minor, err := strconv.Atoi(strings.TrimRight(parts[1], "+"))
+	if err != nil {
+		return 0, 0, false
+	}
+	return major, minor, true
+}
@@ -0,0 +1,91 @@
+package kube
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+)
+
+func TestProbeAPIServer(t *testing.T) {
+	newServer := func(apiStatus int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/api":
+				w.WriteHeader(apiStatus)
+				if apiStatus == http.StatusOK {
+					_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":["v1"]}`))
+				} else {
+					_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","code":401,"reason":"Unauthorized"}`))
+				}
+			case "/version":
+				_, _ = w.Write([]byte(`{"major":"1","minor":"31","gitVersion":"v1.31.1"}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	}
+
+	t.Run("Successful", func(t *testing.T) {
+		server := newServer(http.StatusOK)
+		defer server.Close()
+		result := ProbeAPIServer(context.Background(), &rest.Config{Host: server.URL}, true)
+		require.NoError(t, result.Err)
+		assert.Equal(t, "v1.31.1", result.ServerVersion)
+		assert.Positive(t, result.Latency)
+	})
+
+	t.Run("WithoutVersion", func(t *testing.T) {
+		server := newServer(http.StatusOK)
+		defer server.Close()
+		result := ProbeAPIServer(context.Background(), &rest.Config{Host: server.URL}, false)
+		require.NoError(t, result.Err)
+		assert.Empty(t, result.ServerVersion)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		server := newServer(http.StatusUnauthorized)
+		defer server.Close()
+		result := ProbeAPIServer(context.Background(), &rest.Config{Host: server.URL}, true)
+		require.Error(t, result.Err)
+		assert.Contains(t, result.Err.Error(), "credentials were rejected")
+	})
+
+	t.Run("Unreachable", func(t *testing.T) {
+		server := newServer(http.StatusOK)
+		server.Close()
+		result := ProbeAPIServer(context.Background(), &rest.Config{Host: server.URL}, true)
+		require.Error(t, result.Err)
+		assert.Contains(t, result.Err.Error(), "not reachable")
+	})
+}
+
+func TestSupportedVersions(t *testing.T) {
+	versions := DefaultSupportedVersions("v0.31.0")
+	assert.Equal(t, SupportedVersions{MinMinor: 28, MaxMinor: 31}, versions)
+	assert.Empty(t, versions.UnsupportedVersionMessage("v1.31.1"))
+	assert.Empty(t, versions.UnsupportedVersionMessage("v1.30.4-eks-a737599"))
+	assert.Empty(t, versions.UnsupportedVersionMessage("v1.28.2"), "older supported versions must not be reported")
+	assert.Empty(t, versions.UnsupportedVersionMessage("unknown"))
+	assert.Equal(t, "Kubernetes version v1.27.2 is not in the range v1.28-v1.31 supported by Argo CD", versions.UnsupportedVersionMessage("v1.27.2"))
+	assert.NotEmpty(t, versions.UnsupportedVersionMessage("v1.34.0+k3s1"))
+	assert.Empty(t, DefaultSupportedVersions("").UnsupportedVersionMessage("v1.20.0"))
+
+	versions, err := ParseSupportedVersions("v1.25-v1.32")
+	require.NoError(t, err)
+	assert.Equal(t, SupportedVersions{MinMinor: 25, MaxMinor: 32}, versions)
+	_, err = ParseSupportedVersions("v1.32-v1.25")
+	require.Error(t, err)
+	_, err = ParseSupportedVersions("v1.32")
+	require.Error(t, err)
+
+	t.Setenv(EnvSupportedVersions, "v1.20-v1.33")
+	assert.Equal(t, SupportedVersions{MinMinor: 20, MaxMinor: 33}, GetSupportedVersions("v0.31.0"))
+	t.Setenv(EnvSupportedVersions, "invalid")
+	assert.Equal(t, SupportedVersions{MinMinor: 28, MaxMinor: 31}, GetSupportedVersions("v0.31.0"))
+}
@@ -428,7 +428,16 @@ func getSemverSafe() *semver.Version {
 }
 
 func Version(shortForm bool) (string, error) {
+	return VersionWithBinaryPath("", shortForm)
+}
+
+// VersionWithBinaryPath returns the version of the kustomize binary at the given path, or of the default kustomize
+// binary if the path is empty
+func VersionWithBinaryPath(binaryPath string, shortForm bool) (string, error) {
 	executable := "kustomize"
+	if binaryPath != "" {
+		executable = binaryPath
+	}
 	cmdArgs := []string{"version"}
 	if shortForm {
 		cmdArgs = append(cmdArgs, "--short")
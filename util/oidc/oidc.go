@@ -38,6 +38,7 @@ var InvalidRedirectURLError = fmt.Errorf("invalid return URL")
 const (
 	GrantTypeAuthorizationCode  = "authorization_code"
 	GrantTypeImplicit           = "implicit"
+	GrantTypeDeviceCode         = "urn:ietf:params:oauth:grant-type:device_code"
 	ResponseTypeCode            = "code"
 	UserInfoResponseCachePrefix = "userinfo_response"
 	AccessTokenCachePrefix      = "access_token"
@@ -45,10 +46,11 @@ const (
 
 // OIDCConfiguration holds a subset of interested fields from the OIDC configuration spec
 type OIDCConfiguration struct {
-	Issuer                 string   `json:"issuer"`
-	ScopesSupported        []string `json:"scopes_supported"`
-	ResponseTypesSupported []string `json:"response_types_supported"`
-	GrantTypesSupported    []string `json:"grant_types_supported,omitempty"`
+	Issuer                      string   `json:"issuer"`
+	ScopesSupported             []string `json:"scopes_supported"`
+	ResponseTypesSupported      []string `json:"response_types_supported"`
+	GrantTypesSupported         []string `json:"grant_types_supported,omitempty"`
+	DeviceAuthorizationEndpoint string   `json:"device_authorization_endpoint,omitempty"`
 }
 
 type ClaimsRequest struct {
@@ -526,6 +528,25 @@ func InferGrantType(oidcConf *OIDCConfiguration) string {
 	return GrantTypeImplicit
 }
 
+// SupportsDeviceCodeGrant returns whether the OIDC provider advertises support for the OAuth2 device
+// authorization grant (RFC 8628), which allows logging in from environments without a browser.
+func SupportsDeviceCodeGrant(oidcConf *OIDCConfiguration) bool {
+	if oidcConf.DeviceAuthorizationEndpoint == "" {
+		return false
+	}
+	// grant_types_supported is optional in the discovery document. If it is omitted, the presence of the
+	// device authorization endpoint is considered sufficient.
+	if len(oidcConf.GrantTypesSupported) == 0 {
+		return true
+	}
+	for _, grantType := range oidcConf.GrantTypesSupported {
+		if grantType == GrantTypeDeviceCode {
+			return true
+		}
+	}
+	return false
+}
+
 // AppendClaimsAuthenticationRequestParameter appends a OIDC claims authentication request parameter
 // to `opts` with the `requestedClaims`
 func AppendClaimsAuthenticationRequestParameter(opts []oauth2.AuthCodeOption, requestedClaims map[string]*oidc.Claim) []oauth2.AuthCodeOption {
@@ -868,3 +868,28 @@ func TestGetUserInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestSupportsDeviceCodeGrant(t *testing.T) {
+	t.Run("no device authorization endpoint", func(t *testing.T) {
+		assert.False(t, SupportsDeviceCodeGrant(&OIDCConfiguration{
+			GrantTypesSupported: []string{GrantTypeAuthorizationCode, GrantTypeDeviceCode},
+		}))
+	})
+	t.Run("grant types not advertised", func(t *testing.T) {
+		assert.True(t, SupportsDeviceCodeGrant(&OIDCConfiguration{
+			DeviceAuthorizationEndpoint: "https://idp.example.com/device/code",
+		}))
+	})
+	t.Run("device code grant advertised", func(t *testing.T) {
+		assert.True(t, SupportsDeviceCodeGrant(&OIDCConfiguration{
+			DeviceAuthorizationEndpoint: "https://idp.example.com/device/code",
+			GrantTypesSupported:         []string{GrantTypeAuthorizationCode, GrantTypeDeviceCode},
+		}))
+	})
+	t.Run("device code grant not advertised", func(t *testing.T) {
+		assert.False(t, SupportsDeviceCodeGrant(&OIDCConfiguration{
+			DeviceAuthorizationEndpoint: "https://idp.example.com/device/code",
+			GrantTypesSupported:         []string{GrantTypeAuthorizationCode},
+		}))
+	})
+}
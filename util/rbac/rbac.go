@@ -334,6 +334,20 @@ func (e *Enforcer) SetUserPolicy(policy string) error {
 	return e.LoadPolicy()
 }
 
+// GetUserPolicy returns the user-defined policy, as loaded from the RBAC configmap
+func (e *Enforcer) GetUserPolicy() string {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.adapter.userDefinedPolicy
+}
+
+// GetMatchMode returns the match mode of the policies, either glob or regex
+func (e *Enforcer) GetMatchMode() string {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.matchMode
+}
+
 // newInformers returns an informer which watches updates on the rbac configmap
 func (e *Enforcer) newInformer() cache.SharedIndexInformer {
 	tweakConfigMap := func(options *metav1.ListOptions) {
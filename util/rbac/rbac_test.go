@@ -430,6 +430,8 @@ p, alice, clusters, get, "https://github.com/argo[a-z]{4}/argo-[a-z]+.git", allo
 `
 	_ = enf.SetUserPolicy(policy)
 
+	assert.Equal(t, RegexMatchMode, enf.GetMatchMode())
+	assert.Equal(t, policy, enf.GetUserPolicy())
 	assert.True(t, enf.Enforce("alice", "clusters", "get", "https://github.com/argoproj/argo-cd.git"))
 	assert.False(t, enf.Enforce("alice", "clusters", "get", "https://github.com/argoproj/1argo-cd.git"))
 }
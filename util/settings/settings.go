@@ -129,6 +129,11 @@ type ArgoCDSettings struct {
 	// ImpersonationEnabled indicates whether Application sync privileges can be decoupled from control plane
 	// privileges using impersonation
 	ImpersonationEnabled bool `json:"impersonationEnabled"`
+	// MaintenanceModeEnabled indicates whether the application controller is in read-only maintenance mode, in which
+	// applications keep being refreshed but no sync operations are executed
+	MaintenanceModeEnabled bool `json:"maintenanceModeEnabled"`
+	// MaintenanceModeMessage is an optional message explaining why maintenance mode is enabled
+	MaintenanceModeMessage string `json:"maintenanceModeMessage,omitempty"`
 }
 
 type GoogleAnalytics struct {
@@ -513,6 +518,8 @@ const (
 	settingsMaxPodLogsToRender = "server.maxPodLogsToRender"
 	// helmValuesFileSchemesKey is the key to configure the list of supported helm values file schemas
 	helmValuesFileSchemesKey = "helm.valuesFileSchemes"
+	// helmVersionsKey is the key to configure the list of full helm versions applications are allowed to be pinned to
+	helmVersionsKey = "helm.versions"
 	// execEnabledKey is the key to configure whether the UI exec feature is enabled
 	execEnabledKey = "exec.enabled"
 	// execShellsKey is the key to configure which shells are allowed for `exec` and in what order they are tried
@@ -532,6 +539,10 @@ const (
 	RespectRBACValueNormal = "normal"
 	// impersonationEnabledKey is the key to configure whether the application sync decoupling through impersonation feature is enabled
 	impersonationEnabledKey = "application.sync.impersonation.enabled"
+	// MaintenanceModeEnabledKey is the key to configure whether the application controller is in read-only maintenance mode
+	MaintenanceModeEnabledKey = "controller.maintenance.enabled"
+	// MaintenanceModeMessageKey is the key to configure the message displayed while maintenance mode is enabled
+	MaintenanceModeMessageKey = "controller.maintenance.message"
 )
 
 const (
@@ -1119,6 +1130,21 @@ func (mgr *SettingsManager) GetHelmSettings() (*v1alpha1.HelmOptions, error) {
 	return helmOptions, nil
 }
 
+// GetHelmVersions returns the full helm versions, e.g. v3.14.4, applications are allowed to be pinned to
+func (mgr *SettingsManager) GetHelmVersions() ([]string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get argo-cd config map: %w", err)
+	}
+	var versions []string
+	for _, item := range strings.Split(argoCDCM.Data[helmVersionsKey], ",") {
+		if item := strings.TrimSpace(item); item != "" {
+			versions = append(versions, item)
+		}
+	}
+	return versions, nil
+}
+
 // GetKustomizeSettings loads the kustomize settings from argocd-cm ConfigMap
 func (mgr *SettingsManager) GetKustomizeSettings() (*KustomizeSettings, error) {
 	argoCDCM, err := mgr.getConfigMap()
@@ -1539,6 +1565,8 @@ func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *apiv1.Confi
 	settings.OIDCTLSInsecureSkipVerify = argoCDCM.Data[oidcTLSInsecureSkipVerifyKey] == "true"
 	settings.ExtensionConfig = argoCDCM.Data[extensionConfig]
 	settings.ImpersonationEnabled = argoCDCM.Data[impersonationEnabledKey] == "true"
+	settings.MaintenanceModeEnabled = IsMaintenanceModeEnabled(argoCDCM.Data)
+	settings.MaintenanceModeMessage = argoCDCM.Data[MaintenanceModeMessageKey]
 }
 
 // validateExternalURL ensures the external URL that is set on the configmap is valid
@@ -2357,3 +2385,19 @@ func (mgr *SettingsManager) IsImpersonationEnabled() (bool, error) {
 	}
 	return cm.Data[impersonationEnabledKey] == "true", nil
 }
+
+// GetMaintenanceMode returns whether the application controller is in read-only maintenance mode and the configured
+// maintenance message, if any
+func (mgr *SettingsManager) GetMaintenanceMode() (bool, string, error) {
+	cm, err := mgr.getConfigMap()
+	if err != nil {
+		return false, "", fmt.Errorf("error checking %s property in configmap: %w", MaintenanceModeEnabledKey, err)
+	}
+	return IsMaintenanceModeEnabled(cm.Data), cm.Data[MaintenanceModeMessageKey], nil
+}
+
+// IsMaintenanceModeEnabled returns whether the given argocd-cm data enables read-only maintenance mode
+func IsMaintenanceModeEnabled(data map[string]string) bool {
+	enabled, err := strconv.ParseBool(data[MaintenanceModeEnabledKey])
+	return err == nil && enabled
+}
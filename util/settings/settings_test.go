@@ -1477,6 +1477,20 @@ func TestGetHelmSettings(t *testing.T) {
 	}
 }
 
+func TestGetHelmVersions(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"helm.versions": "v3.14.4, v3.15.2,",
+	})
+	versions, err := settingsManager.GetHelmVersions()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v3.14.4", "v3.15.2"}, versions)
+
+	_, settingsManager = fixtures(nil)
+	versions, err = settingsManager.GetHelmVersions()
+	require.NoError(t, err)
+	assert.Empty(t, versions)
+}
+
 func TestArgoCDSettings_OIDCTLSConfig_OIDCTLSInsecureSkipVerify(t *testing.T) {
 	certParsed, err := tls.X509KeyPair(test.Cert, test.PrivateKey)
 	require.NoError(t, err)
@@ -1774,3 +1788,29 @@ func TestIsImpersonationEnabled(t *testing.T) {
 	require.NoError(t, err,
 		"when user enables the flag in argocd-cm config map, IsImpersonationEnabled() must not return any error")
 }
+
+func TestGetMaintenanceMode(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	enabled, message, err := settingsManager.GetMaintenanceMode()
+	require.NoError(t, err)
+	assert.False(t, enabled)
+	assert.Empty(t, message)
+
+	_, settingsManager = fixtures(map[string]string{
+		"controller.maintenance.enabled": "true",
+		"controller.maintenance.message": "Upgrading to the next minor release",
+	})
+	enabled, message, err = settingsManager.GetMaintenanceMode()
+	require.NoError(t, err)
+	assert.True(t, enabled)
+	assert.Equal(t, "Upgrading to the next minor release", message)
+}
+
+func TestIsMaintenanceModeEnabled(t *testing.T) {
+	assert.False(t, IsMaintenanceModeEnabled(map[string]string{}))
+	assert.False(t, IsMaintenanceModeEnabled(map[string]string{MaintenanceModeEnabledKey: "yes"}))
+	assert.False(t, IsMaintenanceModeEnabled(map[string]string{MaintenanceModeEnabledKey: "false"}))
+	assert.True(t, IsMaintenanceModeEnabled(map[string]string{MaintenanceModeEnabledKey: "true"}))
+	assert.True(t, IsMaintenanceModeEnabled(map[string]string{MaintenanceModeEnabledKey: "True"}))
+	assert.True(t, IsMaintenanceModeEnabled(map[string]string{MaintenanceModeEnabledKey: "1"}))
+}
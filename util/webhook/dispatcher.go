@@ -0,0 +1,242 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-playground/webhooks/v6/azuredevops"
+	"github.com/go-playground/webhooks/v6/bitbucket"
+	bitbucketserver "github.com/go-playground/webhooks/v6/bitbucket-server"
+	"github.com/go-playground/webhooks/v6/github"
+	"github.com/go-playground/webhooks/v6/gitlab"
+	"github.com/go-playground/webhooks/v6/gogs"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const payloadQueueSize = 50000
+
+// dedupPeriod is the period during which deliveries of an event identical to a dispatched one are ignored, e.g. when
+// the SCM provider redelivers an event which it considers to have timed out
+const dedupPeriod = time.Minute
+
+// EventConsumer handles the payloads of webhook events. Payloads are parsed events of the go-playground/webhooks
+// providers, and consumers ignore the payloads they are not interested in.
+type EventConsumer func(payload interface{})
+
+// Dispatcher receives webhook events of the supported SCM providers, verifies them using the webhook secrets of the
+// Argo CD settings and dispatches the parsed payloads to its consumers using a pool of workers, so that each event is
+// parsed and verified once whatever the number of consumers.
+type Dispatcher struct {
+	sync.WaitGroup         // for testing
+	github                 *github.Webhook
+	gitlab                 *gitlab.Webhook
+	bitbucket              *bitbucket.Webhook
+	bitbucketserver        *bitbucketserver.Webhook
+	azuredevops            *azuredevops.Webhook
+	gogs                   *gogs.Webhook
+	consumers              []EventConsumer
+	queue                  chan interface{}
+	maxWebhookPayloadSizeB int64
+
+	lock sync.Mutex
+	// dispatched holds the time at which the recently dispatched events were received, by event key
+	dispatched map[string]time.Time
+}
+
+// NewDispatcher returns a dispatcher which dispatches the events to the given consumers using webhookParallelism
+// workers. Payloads larger than maxWebhookPayloadSizeB are rejected.
+func NewDispatcher(set *settings.ArgoCDSettings, webhookParallelism int, maxWebhookPayloadSizeB int64, consumers ...EventConsumer) *Dispatcher {
+	githubWebhook, err := github.New(github.Options.Secret(set.WebhookGitHubSecret))
+	if err != nil {
+		log.Warnf("Unable to init the GitHub webhook")
+	}
+	gitlabWebhook, err := gitlab.New(gitlab.Options.Secret(set.WebhookGitLabSecret))
+	if err != nil {
+		log.Warnf("Unable to init the GitLab webhook")
+	}
+	bitbucketWebhook, err := bitbucket.New(bitbucket.Options.UUID(set.WebhookBitbucketUUID))
+	if err != nil {
+		log.Warnf("Unable to init the Bitbucket webhook")
+	}
+	bitbucketserverWebhook, err := bitbucketserver.New(bitbucketserver.Options.Secret(set.WebhookBitbucketServerSecret))
+	if err != nil {
+		log.Warnf("Unable to init the Bitbucket Server webhook")
+	}
+	gogsWebhook, err := gogs.New(gogs.Options.Secret(set.WebhookGogsSecret))
+	if err != nil {
+		log.Warnf("Unable to init the Gogs webhook")
+	}
+	azuredevopsWebhook, err := azuredevops.New(azuredevops.Options.BasicAuth(set.WebhookAzureDevOpsUsername, set.WebhookAzureDevOpsPassword))
+	if err != nil {
+		log.Warnf("Unable to init the Azure DevOps webhook")
+	}
+
+	d := &Dispatcher{
+		github:                 githubWebhook,
+		gitlab:                 gitlabWebhook,
+		bitbucket:              bitbucketWebhook,
+		bitbucketserver:        bitbucketserverWebhook,
+		azuredevops:            azuredevopsWebhook,
+		gogs:                   gogsWebhook,
+		consumers:              consumers,
+		queue:                  make(chan interface{}, payloadQueueSize),
+		maxWebhookPayloadSizeB: maxWebhookPayloadSizeB,
+		dispatched:             map[string]time.Time{},
+	}
+	d.startWorkerPool(webhookParallelism)
+	return d
+}
+
+func (d *Dispatcher) startWorkerPool(webhookParallelism int) {
+	for i := 0; i < webhookParallelism; i++ {
+		d.Add(1)
+		go func() {
+			defer d.Done()
+			for {
+				payload, ok := <-d.queue
+				if !ok {
+					return
+				}
+				d.Dispatch(payload)
+			}
+		}()
+	}
+}
+
+// Dispatch passes the given payload to each of the consumers
+func (d *Dispatcher) Dispatch(payload interface{}) {
+	for _, consumer := range d.consumers {
+		consumer(payload)
+	}
+}
+
+// Stop stops the workers once the queued events are dispatched. Events must no longer be received afterwards.
+func (d *Dispatcher) Stop() {
+	close(d.queue)
+	d.Wait()
+}
+
+// isDuplicate returns whether an event with the given key was dispatched during the dedup period, and records the
+// event otherwise
+func (d *Dispatcher) isDuplicate(key string, now time.Time) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	for k, received := range d.dispatched {
+		if now.Sub(received) >= dedupPeriod {
+			delete(d.dispatched, k)
+		}
+	}
+	if _, ok := d.dispatched[key]; ok {
+		return true
+	}
+	d.dispatched[key] = now
+	return false
+}
+
+func (d *Dispatcher) forget(key string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	delete(d.dispatched, key)
+}
+
+func (d *Dispatcher) Handler(w http.ResponseWriter, r *http.Request) {
+	var payload interface{}
+	var event string
+
+	r.Body = http.MaxBytesReader(w, r.Body, d.maxWebhookPayloadSizeB)
+	// the body is read upfront, so that identical deliveries of an event can be detected
+	body, err := io.ReadAll(r.Body)
+	if err == nil {
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	switch {
+	case err != nil:
+	case r.Header.Get("X-Vss-Activityid") != "":
+		event = "azuredevops"
+		payload, err = d.azuredevops.Parse(r, azuredevops.GitPushEventType, azuredevops.GitPullRequestCreatedEventType, azuredevops.GitPullRequestUpdatedEventType, azuredevops.GitPullRequestMergedEventType)
+		if errors.Is(err, azuredevops.ErrBasicAuthVerificationFailed) {
+			log.WithField(common.SecurityField, common.SecurityHigh).Infof("Azure DevOps webhook basic auth verification failed")
+		}
+	// Gogs needs to be checked before GitHub since it carries both Gogs and (incompatible) GitHub headers
+	case r.Header.Get("X-Gogs-Event") != "":
+		event = "gogs/" + r.Header.Get("X-Gogs-Event")
+		payload, err = d.gogs.Parse(r, gogs.PushEvent)
+		if errors.Is(err, gogs.ErrHMACVerificationFailed) {
+			log.WithField(common.SecurityField, common.SecurityHigh).Infof("Gogs webhook HMAC verification failed")
+		}
+	case r.Header.Get("X-GitHub-Event") != "":
+		event = "github/" + r.Header.Get("X-GitHub-Event")
+		payload, err = d.github.Parse(r, github.PushEvent, github.PullRequestEvent, github.PingEvent)
+		if errors.Is(err, github.ErrHMACVerificationFailed) {
+			log.WithField(common.SecurityField, common.SecurityHigh).Infof("GitHub webhook HMAC verification failed")
+		}
+	case r.Header.Get("X-Gitlab-Event") != "":
+		event = "gitlab/" + r.Header.Get("X-Gitlab-Event")
+		payload, err = d.gitlab.Parse(r, gitlab.PushEvents, gitlab.TagEvents, gitlab.MergeRequestEvents, gitlab.SystemHookEvents)
+		if errors.Is(err, gitlab.ErrGitLabTokenVerificationFailed) {
+			log.WithField(common.SecurityField, common.SecurityHigh).Infof("GitLab webhook token verification failed")
+		}
+	case r.Header.Get("X-Hook-UUID") != "":
+		event = "bitbucket/" + r.Header.Get("X-Event-Key")
+		payload, err = d.bitbucket.Parse(r, bitbucket.RepoPushEvent)
+		if errors.Is(err, bitbucket.ErrUUIDVerificationFailed) {
+			log.WithField(common.SecurityField, common.SecurityHigh).Infof("BitBucket webhook UUID verification failed")
+		}
+	case r.Header.Get("X-Event-Key") != "":
+		event = "bitbucketserver/" + r.Header.Get("X-Event-Key")
+		payload, err = d.bitbucketserver.Parse(r, bitbucketserver.RepositoryReferenceChangedEvent, bitbucketserver.DiagnosticsPingEvent)
+		if errors.Is(err, bitbucketserver.ErrHMACVerificationFailed) {
+			log.WithField(common.SecurityField, common.SecurityHigh).Infof("BitBucket webhook HMAC verification failed")
+		}
+	default:
+		log.Debug("Ignoring unknown webhook event")
+		http.Error(w, "Unknown webhook event", http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		// If the error is due to a large payload, return a more user-friendly error message
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) || err.Error() == "error parsing payload" {
+			msg := fmt.Sprintf("Webhook processing failed: The payload is either too large or corrupted. Please check the payload size (must be under %v MB) and ensure it is valid JSON", d.maxWebhookPayloadSizeB/1024/1024)
+			log.WithField(common.SecurityField, common.SecurityHigh).Warn(msg)
+			http.Error(w, msg, http.StatusBadRequest)
+			return
+		}
+
+		log.Infof("Webhook processing failed: %s", err)
+		status := http.StatusBadRequest
+		if r.Method != http.MethodPost {
+			status = http.StatusMethodNotAllowed
+		}
+		http.Error(w, fmt.Sprintf("Webhook processing failed: %s", html.EscapeString(err.Error())), status)
+		return
+	}
+
+	hash := sha256.Sum256(body)
+	key := event + "/" + hex.EncodeToString(hash[:])
+	if d.isDuplicate(key, time.Now()) {
+		log.Infof("Ignoring duplicate %s webhook event", event)
+		return
+	}
+
+	select {
+	case d.queue <- payload:
+	default:
+		d.forget(key)
+		log.Info("Queue is full, discarding webhook payload")
+		http.Error(w, "Queue is full, discarding webhook payload", http.StatusServiceUnavailable)
+	}
+}
@@ -0,0 +1,87 @@
+package webhook
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-playground/webhooks/v6/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+func newGitHubPushRequest(t *testing.T, eventJSON []byte) *http.Request {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/api/webhook", nil)
+	req.Header.Set("X-GitHub-Event", "push")
+	req.Body = io.NopCloser(bytes.NewReader(eventJSON))
+	return req
+}
+
+func TestDispatcher_DispatchesToAllConsumers(t *testing.T) {
+	var lock sync.Mutex
+	var received []string
+	consumer := func(name string) EventConsumer {
+		return func(payload interface{}) {
+			lock.Lock()
+			defer lock.Unlock()
+			if _, ok := payload.(github.PushPayload); ok {
+				received = append(received, name)
+			}
+		}
+	}
+	d := NewDispatcher(&settings.ArgoCDSettings{}, 1, 1024*1024, consumer("apps"), consumer("appsets"))
+
+	eventJSON, err := os.ReadFile("testdata/github-commit-event.json")
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	d.Handler(w, newGitHubPushRequest(t, eventJSON))
+	d.Stop()
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{"apps", "appsets"}, received)
+}
+
+func TestDispatcher_IgnoresDuplicateEvents(t *testing.T) {
+	var lock sync.Mutex
+	dispatched := 0
+	d := NewDispatcher(&settings.ArgoCDSettings{}, 1, 1024*1024, func(interface{}) {
+		lock.Lock()
+		defer lock.Unlock()
+		dispatched++
+	})
+
+	eventJSON, err := os.ReadFile("testdata/github-commit-event.json")
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		d.Handler(w, newGitHubPushRequest(t, eventJSON))
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+	otherJSON, err := os.ReadFile("testdata/github-tag-event.json")
+	require.NoError(t, err)
+	d.Handler(httptest.NewRecorder(), newGitHubPushRequest(t, otherJSON))
+	d.Stop()
+
+	assert.Equal(t, 2, dispatched, "identical deliveries of an event must be dispatched once")
+}
+
+func TestDispatcher_IsDuplicate(t *testing.T) {
+	d := &Dispatcher{dispatched: map[string]time.Time{}}
+	now := time.Now()
+
+	assert.False(t, d.isDuplicate("github/push/abc", now))
+	assert.True(t, d.isDuplicate("github/push/abc", now.Add(dedupPeriod/2)))
+	assert.False(t, d.isDuplicate("github/push/def", now))
+	assert.False(t, d.isDuplicate("github/push/abc", now.Add(dedupPeriod)), "events are no longer duplicates once the dedup period elapsed")
+
+	d.forget("github/push/abc")
+	assert.False(t, d.isDuplicate("github/push/abc", now.Add(dedupPeriod)))
+}
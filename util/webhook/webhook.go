@@ -2,26 +2,20 @@ package webhook
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"html"
-	"net/http"
 	"net/url"
 	"regexp"
 	"strings"
-	"sync"
 
 	"github.com/go-playground/webhooks/v6/azuredevops"
 	"github.com/go-playground/webhooks/v6/bitbucket"
 	bitbucketserver "github.com/go-playground/webhooks/v6/bitbucket-server"
 	"github.com/go-playground/webhooks/v6/github"
 	"github.com/go-playground/webhooks/v6/gitlab"
-	"github.com/go-playground/webhooks/v6/gogs"
 	gogsclient "github.com/gogits/go-gogs-client"
 	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/v2/reposerver/cache"
@@ -43,94 +37,34 @@ type settingsSource interface {
 // https://github.com/shadow-maint/shadow/blob/master/libmisc/chkname.c#L36
 const usernameRegex = `[a-zA-Z0-9_\.][a-zA-Z0-9_\.-]{0,30}[a-zA-Z0-9_\.\$-]?`
 
-const payloadQueueSize = 50000
-
 var _ settingsSource = &settings.SettingsManager{}
 
 type ArgoCDWebhookHandler struct {
-	sync.WaitGroup         // for testing
-	repoCache              *cache.Cache
-	serverCache            *servercache.Cache
-	db                     db.ArgoDB
-	ns                     string
-	appNs                  []string
-	appClientset           appclientset.Interface
-	github                 *github.Webhook
-	gitlab                 *gitlab.Webhook
-	bitbucket              *bitbucket.Webhook
-	bitbucketserver        *bitbucketserver.Webhook
-	azuredevops            *azuredevops.Webhook
-	gogs                   *gogs.Webhook
-	settingsSrc            settingsSource
-	queue                  chan interface{}
-	maxWebhookPayloadSizeB int64
+	*Dispatcher
+	repoCache    *cache.Cache
+	serverCache  *servercache.Cache
+	db           db.ArgoDB
+	ns           string
+	appNs        []string
+	appClientset appclientset.Interface
+	settingsSrc  settingsSource
 }
 
 func NewHandler(namespace string, applicationNamespaces []string, webhookParallelism int, appClientset appclientset.Interface, set *settings.ArgoCDSettings, settingsSrc settingsSource, repoCache *cache.Cache, serverCache *servercache.Cache, argoDB db.ArgoDB, maxWebhookPayloadSizeB int64) *ArgoCDWebhookHandler {
-	githubWebhook, err := github.New(github.Options.Secret(set.WebhookGitHubSecret))
-	if err != nil {
-		log.Warnf("Unable to init the GitHub webhook")
-	}
-	gitlabWebhook, err := gitlab.New(gitlab.Options.Secret(set.WebhookGitLabSecret))
-	if err != nil {
-		log.Warnf("Unable to init the GitLab webhook")
-	}
-	bitbucketWebhook, err := bitbucket.New(bitbucket.Options.UUID(set.WebhookBitbucketUUID))
-	if err != nil {
-		log.Warnf("Unable to init the Bitbucket webhook")
-	}
-	bitbucketserverWebhook, err := bitbucketserver.New(bitbucketserver.Options.Secret(set.WebhookBitbucketServerSecret))
-	if err != nil {
-		log.Warnf("Unable to init the Bitbucket Server webhook")
-	}
-	gogsWebhook, err := gogs.New(gogs.Options.Secret(set.WebhookGogsSecret))
-	if err != nil {
-		log.Warnf("Unable to init the Gogs webhook")
-	}
-	azuredevopsWebhook, err := azuredevops.New(azuredevops.Options.BasicAuth(set.WebhookAzureDevOpsUsername, set.WebhookAzureDevOpsPassword))
-	if err != nil {
-		log.Warnf("Unable to init the Azure DevOps webhook")
-	}
-
 	acdWebhook := ArgoCDWebhookHandler{
-		ns:                     namespace,
-		appNs:                  applicationNamespaces,
-		appClientset:           appClientset,
-		github:                 githubWebhook,
-		gitlab:                 gitlabWebhook,
-		bitbucket:              bitbucketWebhook,
-		bitbucketserver:        bitbucketserverWebhook,
-		azuredevops:            azuredevopsWebhook,
-		gogs:                   gogsWebhook,
-		settingsSrc:            settingsSrc,
-		repoCache:              repoCache,
-		serverCache:            serverCache,
-		db:                     argoDB,
-		queue:                  make(chan interface{}, payloadQueueSize),
-		maxWebhookPayloadSizeB: maxWebhookPayloadSizeB,
+		ns:           namespace,
+		appNs:        applicationNamespaces,
+		appClientset: appClientset,
+		settingsSrc:  settingsSrc,
+		repoCache:    repoCache,
+		serverCache:  serverCache,
+		db:           argoDB,
 	}
-
-	acdWebhook.startWorkerPool(webhookParallelism)
+	acdWebhook.Dispatcher = NewDispatcher(set, webhookParallelism, maxWebhookPayloadSizeB, acdWebhook.HandleEvent)
 
 	return &acdWebhook
 }
 
-func (a *ArgoCDWebhookHandler) startWorkerPool(webhookParallelism int) {
-	for i := 0; i < webhookParallelism; i++ {
-		a.Add(1)
-		go func() {
-			defer a.Done()
-			for {
-				payload, ok := <-a.queue
-				if !ok {
-					return
-				}
-				a.HandleEvent(payload)
-			}
-		}()
-	}
-}
-
 func ParseRevision(ref string) string {
 	refParts := strings.SplitN(ref, "/", 3)
 	return refParts[len(refParts)-1]
@@ -299,6 +233,16 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
 		}
 	}
 
+	var repoURLs []string
+	for _, app := range apps.Items {
+		for _, source := range app.Spec.GetSources() {
+			repoURLs = append(repoURLs, source.RepoURL)
+		}
+	}
+	for _, webURL := range webURLs {
+		InvalidateGitReferences(a.repoCache, webURL, repoURLs)
+	}
+
 	for _, webURL := range webURLs {
 		repoRegexp, err := getWebUrlRegex(webURL)
 		if err != nil {
@@ -329,6 +273,32 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
 	}
 }
 
+// InvalidateGitReferences deletes the cached Git references of the repository the given web URL points to, so that the
+// repo server resolves the pushed revision instead of waiting for the cached references to expire. References are
+// cached per repository URL, so the web URL with and without .git suffix is invalidated together with each of the given
+// repository URLs which refers to the same repository, whether or not a refreshed application uses it.
+func InvalidateGitReferences(repoCache *cache.Cache, webURL string, repoURLs []string) {
+	if repoCache == nil {
+		return
+	}
+	repoRegexp, err := getWebUrlRegex(webURL)
+	if err != nil {
+		log.Warnf("Failed to get repoRegexp: %s", err)
+		return
+	}
+	invalidate := map[string]bool{webURL: true, webURL + ".git": true}
+	for _, repoURL := range repoURLs {
+		if repoRegexp.MatchString(repoURL) {
+			invalidate[repoURL] = true
+		}
+	}
+	for repoURL := range invalidate {
+		if err := repoCache.InvalidateGitReferences(repoURL); err != nil {
+			log.Warnf("Failed to invalidate cached Git references of repo '%s': %v", repoURL, err)
+		}
+	}
+}
+
 // getWebUrlRegex compiles a regex that will match any targetRevision referring to the same repo as the given webURL.
 // webURL is expected to be a URL from an SCM webhook payload pointing to the web page for the repo.
 func getWebUrlRegex(webURL string) (*regexp.Regexp, error) {
@@ -406,73 +376,3 @@ func sourceUsesURL(source v1alpha1.ApplicationSource, webURL string, repoRegexp
 	log.Debugf("%s uses repoURL %s", source.RepoURL, webURL)
 	return true
 }
-
-func (a *ArgoCDWebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
-	var payload interface{}
-	var err error
-
-	r.Body = http.MaxBytesReader(w, r.Body, a.maxWebhookPayloadSizeB)
-
-	switch {
-	case r.Header.Get("X-Vss-Activityid") != "":
-		payload, err = a.azuredevops.Parse(r, azuredevops.GitPushEventType)
-		if errors.Is(err, azuredevops.ErrBasicAuthVerificationFailed) {
-			log.WithField(common.SecurityField, common.SecurityHigh).Infof("Azure DevOps webhook basic auth verification failed")
-		}
-	// Gogs needs to be checked before GitHub since it carries both Gogs and (incompatible) GitHub headers
-	case r.Header.Get("X-Gogs-Event") != "":
-		payload, err = a.gogs.Parse(r, gogs.PushEvent)
-		if errors.Is(err, gogs.ErrHMACVerificationFailed) {
-			log.WithField(common.SecurityField, common.SecurityHigh).Infof("Gogs webhook HMAC verification failed")
-		}
-	case r.Header.Get("X-GitHub-Event") != "":
-		payload, err = a.github.Parse(r, github.PushEvent, github.PingEvent)
-		if errors.Is(err, github.ErrHMACVerificationFailed) {
-			log.WithField(common.SecurityField, common.SecurityHigh).Infof("GitHub webhook HMAC verification failed")
-		}
-	case r.Header.Get("X-Gitlab-Event") != "":
-		payload, err = a.gitlab.Parse(r, gitlab.PushEvents, gitlab.TagEvents, gitlab.SystemHookEvents)
-		if errors.Is(err, gitlab.ErrGitLabTokenVerificationFailed) {
-			log.WithField(common.SecurityField, common.SecurityHigh).Infof("GitLab webhook token verification failed")
-		}
-	case r.Header.Get("X-Hook-UUID") != "":
-		payload, err = a.bitbucket.Parse(r, bitbucket.RepoPushEvent)
-		if errors.Is(err, bitbucket.ErrUUIDVerificationFailed) {
-			log.WithField(common.SecurityField, common.SecurityHigh).Infof("BitBucket webhook UUID verification failed")
-		}
-	case r.Header.Get("X-Event-Key") != "":
-		payload, err = a.bitbucketserver.Parse(r, bitbucketserver.RepositoryReferenceChangedEvent, bitbucketserver.DiagnosticsPingEvent)
-		if errors.Is(err, bitbucketserver.ErrHMACVerificationFailed) {
-			log.WithField(common.SecurityField, common.SecurityHigh).Infof("BitBucket webhook HMAC verification failed")
-		}
-	default:
-		log.Debug("Ignoring unknown webhook event")
-		http.Error(w, "Unknown webhook event", http.StatusBadRequest)
-		return
-	}
-
-	if err != nil {
-		// If the error is due to a large payload, return a more user-friendly error message
-		if err.Error() == "error parsing payload" {
-			msg := fmt.Sprintf("Webhook processing failed: The payload is either too large or corrupted. Please check the payload size (must be under %v MB) and ensure it is valid JSON", a.maxWebhookPayloadSizeB/1024/1024)
-			log.WithField(common.SecurityField, common.SecurityHigh).Warn(msg)
-			http.Error(w, msg, http.StatusBadRequest)
-			return
-		}
-
-		log.Infof("Webhook processing failed: %s", err)
-		status := http.StatusBadRequest
-		if r.Method != http.MethodPost {
-			status = http.StatusMethodNotAllowed
-		}
-		http.Error(w, fmt.Sprintf("Webhook processing failed: %s", html.EscapeString(err.Error())), status)
-		return
-	}
-
-	select {
-	case a.queue <- payload:
-	default:
-		log.Info("Queue is full, discarding webhook payload")
-		http.Error(w, "Queue is full, discarding webhook payload", http.StatusServiceUnavailable)
-	}
-}
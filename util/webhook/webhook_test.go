@@ -13,6 +13,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-playground/webhooks/v6/bitbucket"
 	bitbucketserver "github.com/go-playground/webhooks/v6/bitbucket-server"
 	"github.com/go-playground/webhooks/v6/github"
@@ -178,6 +179,51 @@ func TestGitHubCommitEvent_MultiSource_Refresh(t *testing.T) {
 	hook.Reset()
 }
 
+// TestGitHubCommitEvent_InvalidatesGitReferences makes sure that a webhook deletes the cached references of every URL
+// of the pushed repository, whether or not an application is refreshed, so that the pushed revision is resolved next time.
+func TestGitHubCommitEvent_InvalidatesGitReferences(t *testing.T) {
+	h := NewMockHandler(nil, []string{}, &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-tracking-other-revision",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSpec{
+			Source: &v1alpha1.ApplicationSource{
+				RepoURL:        "git@github.com:jessesuen/test-repo.git",
+				Path:           ".",
+				TargetRevision: "v1.0",
+			},
+		},
+	})
+	refs := []*plumbing.Reference{plumbing.NewReferenceFromStrings("refs/heads/master", "abcdef")}
+	invalidatedRepoURLs := []string{"https://github.com/jessesuen/test-repo", "https://github.com/jessesuen/test-repo.git", "git@github.com:jessesuen/test-repo.git"}
+	for _, repoURL := range append(invalidatedRepoURLs, "https://github.com/some/unrelated-repo") {
+		require.NoError(t, h.repoCache.SetGitReferences(repoURL, refs))
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/webhook", nil)
+	req.Header.Set("X-GitHub-Event", "push")
+	eventJSON, err := os.ReadFile("testdata/github-commit-event.json")
+	require.NoError(t, err)
+	req.Body = io.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	close(h.queue)
+	h.Wait()
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	for _, repoURL := range invalidatedRepoURLs {
+		var cachedRefs []*plumbing.Reference
+		_, err = h.repoCache.GetGitReferences(repoURL, &cachedRefs)
+		require.NoError(t, err)
+		assert.Empty(t, cachedRefs, repoURL)
+	}
+	var cachedRefs []*plumbing.Reference
+	_, err = h.repoCache.GetGitReferences("https://github.com/some/unrelated-repo", &cachedRefs)
+	require.NoError(t, err)
+	assert.Len(t, cachedRefs, 1)
+}
+
 // TestGitHubCommitEvent_AppsInOtherNamespaces makes sure that webhooks properly find apps in the configured set of
 // allowed namespaces when Apps are allowed in any namespace
 func TestGitHubCommitEvent_AppsInOtherNamespaces(t *testing.T) {